@@ -31,13 +31,14 @@ func (s *Server) PostContent(_ context.Context, req *pb.PostContentRequest) (*pb
 	}
 
 	newContent := models.Content{
-		Type:    req.Content.Type,
-		Name:    req.Content.Name,
-		Authors: req.Content.Authors,
-		Cover:   req.Content.Cover,
-		Text:    req.Content.Text,
-		Views:   int(req.Content.Views),
-		Images:  string(imagesJSON),
+		Type:         req.Content.Type,
+		Name:         req.Content.Name,
+		Authors:      req.Content.Authors,
+		Cover:        req.Content.Cover,
+		CoverMediaID: req.Content.CoverMediaId,
+		Text:         req.Content.Text,
+		Views:        int(req.Content.Views),
+		Images:       string(imagesJSON),
 	}
 
 	contentID, err := s.ContentService.AddContent(newContent)
@@ -58,11 +59,12 @@ func (s *Server) EditContent(_ context.Context, req *pb.EditContentRequest) (*pb
 	}
 
 	content := models.Content{
-		Name:    req.Name,
-		Authors: req.Authors,
-		Cover:   req.Cover,
-		Text:    req.Text,
-		Images:  string(imagesJSON),
+		Name:         req.Name,
+		Authors:      req.Authors,
+		Cover:        req.Cover,
+		CoverMediaID: req.CoverMediaId,
+		Text:         req.Text,
+		Images:       string(imagesJSON),
 	}
 
 	if err := s.ContentService.UpdateContent(uint(req.ContentId), content); err != nil {
@@ -96,14 +98,15 @@ func (s *Server) GetContent(_ context.Context, req *pb.GetContentRequest) (*pb.G
 
 	return &pb.GetContentResponse{
 		Content: &pb.Content{
-			Type:    content.Type,
-			Name:    content.Name,
-			Date:    timestamppb.New(content.CreatedAt),
-			Authors: content.Authors,
-			Cover:   content.Cover,
-			Text:    content.Text,
-			Views:   int32(content.Views) + 1,
-			Images:  strings.Split(content.Images, ","),
+			Type:         content.Type,
+			Name:         content.Name,
+			Date:         timestamppb.New(content.CreatedAt),
+			Authors:      content.Authors,
+			Cover:        content.Cover,
+			CoverMediaId: content.CoverMediaID,
+			Text:         content.Text,
+			Views:        int32(content.Views) + 1,
+			Images:       strings.Split(content.Images, ","),
 		},
 	}, nil
 }
@@ -119,14 +122,15 @@ func (s *Server) GetAllContent(_ context.Context, req *pb.GetAllContentRequest)
 	for i := range getContentRes {
 		c := content[i]
 		getContentRes[i] = &pb.Content{
-			ContentId: int32(c.ID),
-			Name:      c.Name,
-			Date:      timestamppb.New(c.CreatedAt),
-			Authors:   c.Authors,
-			Cover:     c.Cover,
-			Text:      c.Text,
-			Views:     int32(c.Views),
-			Images:    strings.Split(c.Images, ","),
+			ContentId:    int32(c.ID),
+			Name:         c.Name,
+			Date:         timestamppb.New(c.CreatedAt),
+			Authors:      c.Authors,
+			Cover:        c.Cover,
+			CoverMediaId: c.CoverMediaID,
+			Text:         c.Text,
+			Views:        int32(c.Views),
+			Images:       strings.Split(c.Images, ","),
 		}
 	}
 
@@ -64,6 +64,9 @@ func (svc *ContentService) UpdateContent(contentID uint, content models.Content)
 	if content.Cover != "" {
 		newContent.Cover = content.Cover
 	}
+	if content.CoverMediaID != "" {
+		newContent.CoverMediaID = content.CoverMediaID
+	}
 	if content.Text != "" {
 		newContent.Text = content.Text
 	}
@@ -0,0 +1,189 @@
+package rpc
+
+import (
+	"context"
+
+	"github.com/catness812/faf-hub-backend/event_service/internal/models"
+	"github.com/catness812/faf-hub-backend/event_service/internal/pb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *Server) CreateSpeaker(_ context.Context, req *pb.CreateSpeakerRequest) (*pb.CreateSpeakerResponse, error) {
+	speakerID, err := s.AgendaService.CreateSpeaker(speakerFromPb(req.Speaker))
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.CreateSpeakerResponse{
+		Message:   "speaker created successfully",
+		SpeakerId: int32(speakerID),
+	}, nil
+}
+
+func (s *Server) EditSpeaker(_ context.Context, req *pb.EditSpeakerRequest) (*pb.EditSpeakerResponse, error) {
+	if err := s.AgendaService.UpdateSpeaker(speakerFromPb(req.Speaker)); err != nil {
+		return nil, err
+	}
+
+	return &pb.EditSpeakerResponse{
+		Message: "speaker updated successfully",
+	}, nil
+}
+
+func (s *Server) DeleteSpeaker(_ context.Context, req *pb.DeleteSpeakerRequest) (*pb.DeleteSpeakerResponse, error) {
+	if err := s.AgendaService.DeleteSpeaker(uint(req.SpeakerId)); err != nil {
+		return nil, err
+	}
+
+	return &pb.DeleteSpeakerResponse{
+		Message: "speaker deleted successfully",
+	}, nil
+}
+
+func (s *Server) CreateSession(_ context.Context, req *pb.CreateSessionRequest) (*pb.CreateSessionResponse, error) {
+	sessionID, err := s.AgendaService.CreateSession(sessionFromPb(req.Session))
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.CreateSessionResponse{
+		Message:   "session created successfully",
+		SessionId: int32(sessionID),
+	}, nil
+}
+
+func (s *Server) EditSession(_ context.Context, req *pb.EditSessionRequest) (*pb.EditSessionResponse, error) {
+	if err := s.AgendaService.UpdateSession(sessionFromPb(req.Session)); err != nil {
+		return nil, err
+	}
+
+	return &pb.EditSessionResponse{
+		Message: "session updated successfully",
+	}, nil
+}
+
+func (s *Server) DeleteSession(_ context.Context, req *pb.DeleteSessionRequest) (*pb.DeleteSessionResponse, error) {
+	if err := s.AgendaService.DeleteSession(uint(req.SessionId)); err != nil {
+		return nil, err
+	}
+
+	return &pb.DeleteSessionResponse{
+		Message: "session deleted successfully",
+	}, nil
+}
+
+func (s *Server) GetEventAgenda(_ context.Context, req *pb.GetEventAgendaRequest) (*pb.GetEventAgendaResponse, error) {
+	sessions, speakers, err := s.AgendaService.GetEventAgenda(uint(req.EventId))
+	if err != nil {
+		return nil, err
+	}
+
+	pbSpeakers := make([]*pb.Speaker, len(speakers))
+
+	for i := range pbSpeakers {
+		pbSpeakers[i] = speakerToPb(speakers[i])
+	}
+
+	return &pb.GetEventAgendaResponse{
+		Sessions: sessionsToPb(sessions),
+		Speakers: pbSpeakers,
+	}, nil
+}
+
+func (s *Server) BookmarkSession(_ context.Context, req *pb.BookmarkSessionRequest) (*pb.BookmarkSessionResponse, error) {
+	if err := s.AgendaService.BookmarkSession(uint(req.SessionId), uint(req.UserId)); err != nil {
+		return nil, err
+	}
+
+	return &pb.BookmarkSessionResponse{
+		Message: "session added to agenda successfully",
+	}, nil
+}
+
+func (s *Server) RemoveBookmark(_ context.Context, req *pb.RemoveBookmarkRequest) (*pb.RemoveBookmarkResponse, error) {
+	if err := s.AgendaService.RemoveBookmark(uint(req.SessionId), uint(req.UserId)); err != nil {
+		return nil, err
+	}
+
+	return &pb.RemoveBookmarkResponse{
+		Message: "session removed from agenda successfully",
+	}, nil
+}
+
+func (s *Server) GetUserAgenda(_ context.Context, req *pb.GetUserAgendaRequest) (*pb.GetUserAgendaResponse, error) {
+	sessions, err := s.AgendaService.GetUserAgenda(uint(req.EventId), uint(req.UserId))
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.GetUserAgendaResponse{
+		Sessions: sessionsToPb(sessions),
+	}, nil
+}
+
+func speakerFromPb(speaker *pb.Speaker) models.Speaker {
+	newSpeaker := models.Speaker{
+		EventID: uint(speaker.EventId),
+		Name:    speaker.Name,
+		Title:   speaker.Title,
+		Bio:     speaker.Bio,
+		Photo:   speaker.Photo,
+		Links:   speaker.Links,
+	}
+	newSpeaker.ID = uint(speaker.SpeakerId)
+	return newSpeaker
+}
+
+func speakerToPb(speaker models.Speaker) *pb.Speaker {
+	return &pb.Speaker{
+		SpeakerId: int32(speaker.ID),
+		EventId:   int32(speaker.EventID),
+		Name:      speaker.Name,
+		Title:     speaker.Title,
+		Bio:       speaker.Bio,
+		Photo:     speaker.Photo,
+		Links:     speaker.Links,
+	}
+}
+
+func sessionFromPb(session *pb.Session) models.Session {
+	newSession := models.Session{
+		EventID:     uint(session.EventId),
+		Title:       session.Title,
+		Description: session.Desc,
+		Room:        session.Room,
+	}
+	newSession.ID = uint(session.SessionId)
+	if session.SpeakerId != 0 {
+		speakerID := uint(session.SpeakerId)
+		newSession.SpeakerID = &speakerID
+	}
+	if session.Start != nil {
+		newSession.StartTime = session.Start.AsTime()
+	}
+	if session.End != nil {
+		newSession.EndTime = session.End.AsTime()
+	}
+	return newSession
+}
+
+func sessionsToPb(sessions []models.Session) []*pb.Session {
+	pbSessions := make([]*pb.Session, len(sessions))
+
+	for i, session := range sessions {
+		pbSessions[i] = &pb.Session{
+			SessionId: int32(session.ID),
+			EventId:   int32(session.EventID),
+			SpeakerId: int32(uintOrZero(session.SpeakerID)),
+			Title:     session.Title,
+			Desc:      session.Description,
+			Room:      session.Room,
+			Start:     timestamppb.New(session.StartTime),
+			End:       timestamppb.New(session.EndTime),
+		}
+		if session.Speaker != nil {
+			pbSessions[i].Speaker = speakerToPb(*session.Speaker)
+		}
+	}
+	return pbSessions
+}
@@ -0,0 +1,77 @@
+package rpc
+
+import (
+	"context"
+
+	"github.com/catness812/faf-hub-backend/event_service/internal/pb"
+)
+
+func (s *Server) RecordNewsletterSend(_ context.Context, req *pb.RecordNewsletterSendRequest) (*pb.RecordNewsletterSendResponse, error) {
+	if err := s.AnalyticsService.RecordNewsletterSend(uint(req.EventId), req.Kind, req.Emails); err != nil {
+		return nil, err
+	}
+
+	return &pb.RecordNewsletterSendResponse{
+		Message: "newsletter send recorded successfully",
+	}, nil
+}
+
+func (s *Server) GetEventAnalytics(_ context.Context, req *pb.GetEventAnalyticsRequest) (*pb.GetEventAnalyticsResponse, error) {
+	analytics, err := s.AnalyticsService.GetEventAnalytics(uint(req.EventId))
+	if err != nil {
+		return nil, err
+	}
+
+	timeline := make([]*pb.RegistrationTimelinePoint, len(analytics.Timeline))
+	for i, point := range analytics.Timeline {
+		timeline[i] = &pb.RegistrationTimelinePoint{
+			DaysBeforeDeadline: int32(point.DaysBeforeDeadline),
+			Registrations:      point.Registrations,
+			Cumulative:         point.Cumulative,
+		}
+	}
+
+	return &pb.GetEventAnalyticsResponse{
+		Analytics: &pb.EventAnalytics{
+			EventId:              int32(analytics.EventID),
+			Registrations:        analytics.Registrations,
+			Timeline:             timeline,
+			NewsletterSends:      analytics.NewsletterSends,
+			NewsletterRecipients: analytics.NewsletterRecipients,
+			NewsletterConverted:  analytics.NewsletterConverted,
+			ConversionRate:       analytics.ConversionRate,
+			Confirmed:            analytics.Confirmed,
+			CheckedIn:            analytics.CheckedIn,
+			AttendanceRate:       analytics.AttendanceRate,
+			RepeatAttendees:      analytics.RepeatAttendees,
+			FeedbackResponses:    analytics.FeedbackResponses,
+			AvgContent:           analytics.AvgContent,
+			AvgOrganization:      analytics.AvgOrganization,
+			AvgVenue:             analytics.AvgVenue,
+		},
+	}, nil
+}
+
+func (s *Server) GetAnalyticsOverview(_ context.Context, req *pb.GetAnalyticsOverviewRequest) (*pb.GetAnalyticsOverviewResponse, error) {
+	periods, err := s.AnalyticsService.GetAnalyticsOverview(timeFromPb(req.From), timeFromPb(req.To))
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*pb.AnalyticsPeriod, len(periods))
+	for i, period := range periods {
+		res[i] = &pb.AnalyticsPeriod{
+			Period:          period.Period,
+			Events:          period.Events,
+			Registrations:   period.Registrations,
+			CheckedIn:       period.CheckedIn,
+			AttendanceRate:  period.AttendanceRate,
+			RepeatAttendees: period.RepeatAttendees,
+			AvgRating:       period.AvgRating,
+		}
+	}
+
+	return &pb.GetAnalyticsOverviewResponse{
+		Periods: res,
+	}, nil
+}
@@ -0,0 +1,82 @@
+package rpc
+
+import (
+	"context"
+
+	"github.com/catness812/faf-hub-backend/event_service/internal/models"
+	"github.com/catness812/faf-hub-backend/event_service/internal/pb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *Server) IssueCertificates(_ context.Context, req *pb.IssueCertificatesRequest) (*pb.IssueCertificatesResponse, error) {
+	certificates, event, err := s.CertificateService.IssueCertificates(uint(req.EventId))
+	if err != nil {
+		return nil, err
+	}
+
+	issueCertificatesRes := make([]*pb.Certificate, len(certificates))
+
+	for i := range issueCertificatesRes {
+		issueCertificatesRes[i] = certificateToPb(certificates[i], event)
+	}
+
+	return &pb.IssueCertificatesResponse{
+		Message:      "certificates issued successfully",
+		Certificates: issueCertificatesRes,
+	}, nil
+}
+
+func (s *Server) GetUserCertificate(_ context.Context, req *pb.GetUserCertificateRequest) (*pb.GetUserCertificateResponse, error) {
+	certificate, pdf, err := s.CertificateService.GetUserCertificate(uint(req.EventId), uint(req.UserId))
+	if err != nil {
+		return nil, err
+	}
+
+	event, err := s.EventService.GetEventByID(certificate.EventID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.GetUserCertificateResponse{
+		Certificate: certificateToPb(certificate, event),
+		Pdf:         pdf,
+	}, nil
+}
+
+func (s *Server) GetCertificate(_ context.Context, req *pb.GetCertificateRequest) (*pb.GetCertificateResponse, error) {
+	certificate, event, pdf, err := s.CertificateService.GetCertificate(req.Code)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.GetCertificateResponse{
+		Certificate: certificateToPb(certificate, event),
+		Pdf:         pdf,
+	}, nil
+}
+
+func (s *Server) VerifyCertificate(_ context.Context, req *pb.VerifyCertificateRequest) (*pb.VerifyCertificateResponse, error) {
+	certificate, event, err := s.CertificateService.VerifyCertificate(req.Code)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.VerifyCertificateResponse{
+		Certificate: certificateToPb(certificate, event),
+	}, nil
+}
+
+func certificateToPb(certificate models.Certificate, event models.Event) *pb.Certificate {
+	return &pb.Certificate{
+		Code:       certificate.Code,
+		EventId:    int32(certificate.EventID),
+		UserId:     int32(certificate.UserID),
+		FirstName:  certificate.FirstName,
+		LastName:   certificate.LastName,
+		Email:      certificate.Email,
+		EventName:  event.Name,
+		EventStart: timestamppb.New(event.StartDateTime),
+		EventEnd:   timestamppb.New(event.EndDateTime),
+		IssuedAt:   timestamppb.New(certificate.IssuedAt),
+	}
+}
@@ -0,0 +1,71 @@
+package rpc
+
+import (
+	"context"
+
+	"github.com/catness812/faf-hub-backend/event_service/internal/models"
+	"github.com/catness812/faf-hub-backend/event_service/internal/pb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *Server) SubmitFeedback(_ context.Context, req *pb.SubmitFeedbackRequest) (*pb.SubmitFeedbackResponse, error) {
+	feedback := models.Feedback{
+		EventID:            uint(req.EventId),
+		UserID:             uint(req.UserId),
+		ContentRating:      int(req.ContentRating),
+		OrganizationRating: int(req.OrganizationRating),
+		VenueRating:        int(req.VenueRating),
+		Comment:            req.Comment,
+		Anonymous:          req.Anonymous,
+	}
+
+	if err := s.FeedbackService.SubmitFeedback(feedback); err != nil {
+		return nil, err
+	}
+
+	return &pb.SubmitFeedbackResponse{
+		Message: "event feedback added successfully",
+	}, nil
+}
+
+func (s *Server) GetEventFeedback(_ context.Context, req *pb.GetEventFeedbackRequest) (*pb.GetEventFeedbackResponse, error) {
+	summary, feedback, err := s.FeedbackService.GetEventFeedback(uint(req.EventId))
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]*pb.FeedbackEntry, len(feedback))
+
+	for i := range entries {
+		entries[i] = feedbackToPb(feedback[i])
+	}
+
+	return &pb.GetEventFeedbackResponse{
+		EventId:             int32(summary.EventID),
+		Responses:           summary.Responses,
+		AvgContent:          summary.AvgContent,
+		AvgOrganization:     summary.AvgOrganization,
+		AvgVenue:            summary.AvgVenue,
+		ContentRatings:      countsToPb(summary.ContentRatings),
+		OrganizationRatings: countsToPb(summary.OrganizationRatings),
+		VenueRatings:        countsToPb(summary.VenueRatings),
+		Entries:             entries,
+	}, nil
+}
+
+// feedbackToPb leaves out the author of anonymous feedback.
+func feedbackToPb(feedback models.Feedback) *pb.FeedbackEntry {
+	entry := &pb.FeedbackEntry{
+		ContentRating:      int32(feedback.ContentRating),
+		OrganizationRating: int32(feedback.OrganizationRating),
+		VenueRating:        int32(feedback.VenueRating),
+		Comment:            feedback.Comment,
+		Anonymous:          feedback.Anonymous,
+		SubmittedAt:        timestamppb.New(feedback.CreatedAt),
+	}
+	if !feedback.Anonymous {
+		entry.FirstName = feedback.Registration.FirstName
+		entry.LastName = feedback.Registration.LastName
+	}
+	return entry
+}
@@ -0,0 +1,55 @@
+package rpc
+
+import (
+	"context"
+
+	"github.com/catness812/faf-hub-backend/event_service/internal/pb"
+)
+
+func (s *Server) SetGuestRegistration(_ context.Context, req *pb.SetGuestRegistrationRequest) (*pb.SetGuestRegistrationResponse, error) {
+	if err := s.EventService.SetAllowGuests(uint(req.EventId), req.AllowGuests); err != nil {
+		return nil, err
+	}
+
+	return &pb.SetGuestRegistrationResponse{
+		Message: "guest registrations updated successfully",
+	}, nil
+}
+
+func (s *Server) RegisterGuest(_ context.Context, req *pb.RegisterGuestRequest) (*pb.RegisterGuestResponse, error) {
+	token, err := s.RegistrationService.RegisterGuest(registrationFromPb(req.Registration))
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.RegisterGuestResponse{
+		Message: "check your email to confirm the registration",
+		Token:   token,
+	}, nil
+}
+
+func (s *Server) ConfirmGuestRegistration(_ context.Context, req *pb.ConfirmGuestRegistrationRequest) (*pb.ConfirmGuestRegistrationResponse, error) {
+	registration, ticket, confirmed, err := s.RegistrationService.ConfirmGuestRegistration(req.Token)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ConfirmGuestRegistrationResponse{
+		Message:      "guest registration confirmed successfully",
+		Ticket:       ticket,
+		Registration: registrationToPb(registration),
+		Confirmed:    confirmed,
+	}, nil
+}
+
+func (s *Server) ClaimGuestRegistrations(_ context.Context, req *pb.ClaimGuestRegistrationsRequest) (*pb.ClaimGuestRegistrationsResponse, error) {
+	claimed, err := s.RegistrationService.ClaimGuestRegistrations(uint(req.UserId), req.Email)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ClaimGuestRegistrationsResponse{
+		Message: "guest registrations claimed successfully",
+		Claimed: int32(claimed),
+	}, nil
+}
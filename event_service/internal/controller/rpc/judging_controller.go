@@ -0,0 +1,240 @@
+package rpc
+
+import (
+	"context"
+
+	"github.com/catness812/faf-hub-backend/event_service/internal/models"
+	"github.com/catness812/faf-hub-backend/event_service/internal/pb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *Server) SubmitProject(_ context.Context, req *pb.SubmitProjectRequest) (*pb.SubmitProjectResponse, error) {
+	submission := models.Submission{
+		EventID:     uint(req.Submission.GetEventId()),
+		Title:       req.Submission.GetTitle(),
+		Description: req.Submission.GetDesc(),
+		RepoURL:     req.Submission.GetRepoUrl(),
+		DemoURL:     req.Submission.GetDemoUrl(),
+		SubmittedBy: uint(req.Submission.GetSubmittedBy()),
+	}
+
+	submissionID, err := s.JudgingService.SubmitProject(submission)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.SubmitProjectResponse{
+		Message:      "project submitted successfully",
+		SubmissionId: int32(submissionID),
+	}, nil
+}
+
+func (s *Server) GetEventSubmissions(_ context.Context, req *pb.GetEventSubmissionsRequest) (*pb.GetEventSubmissionsResponse, error) {
+	submissions, err := s.JudgingService.GetEventSubmissions(uint(req.EventId))
+	if err != nil {
+		return nil, err
+	}
+
+	pbSubmissions := make([]*pb.Submission, len(submissions))
+
+	for i := range submissions {
+		pbSubmissions[i] = submissionToPb(submissions[i])
+	}
+
+	return &pb.GetEventSubmissionsResponse{
+		Submissions: pbSubmissions,
+	}, nil
+}
+
+func (s *Server) SetSubmissionContent(_ context.Context, req *pb.SetSubmissionContentRequest) (*pb.SetSubmissionContentResponse, error) {
+	if err := s.JudgingService.SetSubmissionContent(uint(req.SubmissionId), uint(req.ContentId)); err != nil {
+		return nil, err
+	}
+
+	return &pb.SetSubmissionContentResponse{
+		Message: "submission updated successfully",
+	}, nil
+}
+
+func (s *Server) AddJudge(_ context.Context, req *pb.AddJudgeRequest) (*pb.AddJudgeResponse, error) {
+	judge := models.Judge{
+		EventID: uint(req.EventId),
+		UserID:  uint(req.UserId),
+		AddedBy: uint(req.AddedBy),
+	}
+
+	if err := s.JudgingService.AddJudge(judge); err != nil {
+		return nil, err
+	}
+
+	return &pb.AddJudgeResponse{
+		Message: "judge added successfully",
+	}, nil
+}
+
+func (s *Server) RemoveJudge(_ context.Context, req *pb.RemoveJudgeRequest) (*pb.RemoveJudgeResponse, error) {
+	if err := s.JudgingService.RemoveJudge(uint(req.EventId), uint(req.UserId)); err != nil {
+		return nil, err
+	}
+
+	return &pb.RemoveJudgeResponse{
+		Message: "judge removed successfully",
+	}, nil
+}
+
+func (s *Server) GetEventJudges(_ context.Context, req *pb.GetEventJudgesRequest) (*pb.GetEventJudgesResponse, error) {
+	judges, err := s.JudgingService.GetEventJudges(uint(req.EventId))
+	if err != nil {
+		return nil, err
+	}
+
+	pbJudges := make([]*pb.Judge, len(judges))
+
+	for i, judge := range judges {
+		pbJudges[i] = &pb.Judge{
+			EventId: int32(judge.EventID),
+			UserId:  int32(judge.UserID),
+			AddedBy: int32(judge.AddedBy),
+			AddedAt: timestamppb.New(judge.CreatedAt),
+		}
+	}
+
+	return &pb.GetEventJudgesResponse{
+		Judges: pbJudges,
+	}, nil
+}
+
+func (s *Server) CreateCriterion(_ context.Context, req *pb.CreateCriterionRequest) (*pb.CreateCriterionResponse, error) {
+	criterionID, err := s.JudgingService.CreateCriterion(criterionFromPb(req.Criterion))
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.CreateCriterionResponse{
+		Message:     "criterion created successfully",
+		CriterionId: int32(criterionID),
+	}, nil
+}
+
+func (s *Server) EditCriterion(_ context.Context, req *pb.EditCriterionRequest) (*pb.EditCriterionResponse, error) {
+	if err := s.JudgingService.UpdateCriterion(criterionFromPb(req.Criterion)); err != nil {
+		return nil, err
+	}
+
+	return &pb.EditCriterionResponse{
+		Message: "criterion updated successfully",
+	}, nil
+}
+
+func (s *Server) DeleteCriterion(_ context.Context, req *pb.DeleteCriterionRequest) (*pb.DeleteCriterionResponse, error) {
+	if err := s.JudgingService.DeleteCriterion(uint(req.EventId), uint(req.CriterionId)); err != nil {
+		return nil, err
+	}
+
+	return &pb.DeleteCriterionResponse{
+		Message: "criterion deleted successfully",
+	}, nil
+}
+
+func (s *Server) GetEventCriteria(_ context.Context, req *pb.GetEventCriteriaRequest) (*pb.GetEventCriteriaResponse, error) {
+	criteria, err := s.JudgingService.GetEventCriteria(uint(req.EventId))
+	if err != nil {
+		return nil, err
+	}
+
+	pbCriteria := make([]*pb.Criterion, len(criteria))
+
+	for i, criterion := range criteria {
+		pbCriteria[i] = &pb.Criterion{
+			CriterionId: int32(criterion.ID),
+			EventId:     int32(criterion.EventID),
+			Name:        criterion.Name,
+			Desc:        criterion.Description,
+			Weight:      criterion.Weight,
+			MaxScore:    int32(criterion.MaxScore),
+		}
+	}
+
+	return &pb.GetEventCriteriaResponse{
+		Criteria: pbCriteria,
+	}, nil
+}
+
+func (s *Server) ScoreSubmission(_ context.Context, req *pb.ScoreSubmissionRequest) (*pb.ScoreSubmissionResponse, error) {
+	scores := make([]models.Score, len(req.Scores))
+
+	for i, score := range req.Scores {
+		scores[i] = models.Score{
+			CriterionID: uint(score.CriterionId),
+			Value:       int(score.Value),
+			Comment:     score.Comment,
+		}
+	}
+
+	if err := s.JudgingService.ScoreSubmission(uint(req.SubmissionId), uint(req.JudgeId), scores); err != nil {
+		return nil, err
+	}
+
+	return &pb.ScoreSubmissionResponse{
+		Message: "submission scored successfully",
+	}, nil
+}
+
+func (s *Server) GetLeaderboard(_ context.Context, req *pb.GetLeaderboardRequest) (*pb.GetLeaderboardResponse, error) {
+	entries, err := s.JudgingService.GetLeaderboard(uint(req.EventId))
+	if err != nil {
+		return nil, err
+	}
+
+	pbEntries := make([]*pb.LeaderboardEntry, len(entries))
+
+	for i, entry := range entries {
+		pbEntries[i] = &pb.LeaderboardEntry{
+			Rank:       int32(entry.Rank),
+			Submission: submissionToPb(entry.Submission),
+			Score:      entry.Score,
+			Judges:     int32(entry.Judges),
+		}
+	}
+
+	return &pb.GetLeaderboardResponse{
+		Entries: pbEntries,
+	}, nil
+}
+
+func criterionFromPb(criterion *pb.Criterion) models.Criterion {
+	if criterion == nil {
+		return models.Criterion{}
+	}
+
+	newCriterion := models.Criterion{
+		EventID:     uint(criterion.EventId),
+		Name:        criterion.Name,
+		Description: criterion.Desc,
+		Weight:      criterion.Weight,
+		MaxScore:    int(criterion.MaxScore),
+	}
+	newCriterion.ID = uint(criterion.CriterionId)
+	return newCriterion
+}
+
+func submissionToPb(submission models.Submission) *pb.Submission {
+	var teamName string
+	if submission.Team != nil {
+		teamName = submission.Team.Name
+	}
+
+	return &pb.Submission{
+		SubmissionId: int32(submission.ID),
+		EventId:      int32(submission.EventID),
+		TeamId:       int32(submission.TeamID),
+		TeamName:     teamName,
+		Title:        submission.Title,
+		Desc:         submission.Description,
+		RepoUrl:      submission.RepoURL,
+		DemoUrl:      submission.DemoURL,
+		SubmittedBy:  int32(submission.SubmittedBy),
+		SubmittedAt:  timestamppb.New(submission.UpdatedAt),
+		ContentId:    int32(uintOrZero(submission.ContentID)),
+	}
+}
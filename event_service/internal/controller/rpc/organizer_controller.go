@@ -0,0 +1,85 @@
+package rpc
+
+import (
+	"context"
+
+	"github.com/catness812/faf-hub-backend/event_service/internal/models"
+	"github.com/catness812/faf-hub-backend/event_service/internal/pb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *Server) AddEventOrganizer(_ context.Context, req *pb.AddEventOrganizerRequest) (*pb.AddEventOrganizerResponse, error) {
+	organizer := models.EventOrganizer{
+		EventID: uint(req.EventId),
+		UserID:  uint(req.UserId),
+		AddedBy: uint(req.AddedBy),
+	}
+
+	if err := s.OrganizerService.AddOrganizer(organizer); err != nil {
+		return nil, err
+	}
+
+	return &pb.AddEventOrganizerResponse{
+		Message: "organizer added successfully",
+	}, nil
+}
+
+func (s *Server) RemoveEventOrganizer(_ context.Context, req *pb.RemoveEventOrganizerRequest) (*pb.RemoveEventOrganizerResponse, error) {
+	if err := s.OrganizerService.RemoveOrganizer(uint(req.EventId), uint(req.UserId)); err != nil {
+		return nil, err
+	}
+
+	return &pb.RemoveEventOrganizerResponse{
+		Message: "organizer removed successfully",
+	}, nil
+}
+
+func (s *Server) GetEventOrganizers(_ context.Context, req *pb.GetEventOrganizersRequest) (*pb.GetEventOrganizersResponse, error) {
+	organizers, err := s.OrganizerService.GetEventOrganizers(uint(req.EventId))
+	if err != nil {
+		return nil, err
+	}
+
+	pbOrganizers := make([]*pb.EventOrganizer, len(organizers))
+
+	for i, organizer := range organizers {
+		pbOrganizers[i] = &pb.EventOrganizer{
+			EventId: int32(organizer.EventID),
+			UserId:  int32(organizer.UserID),
+			AddedBy: int32(organizer.AddedBy),
+			AddedAt: timestamppb.New(organizer.CreatedAt),
+		}
+	}
+
+	return &pb.GetEventOrganizersResponse{
+		Organizers: pbOrganizers,
+	}, nil
+}
+
+func (s *Server) CheckEventOrganizer(_ context.Context, req *pb.CheckEventOrganizerRequest) (*pb.CheckEventOrganizerResponse, error) {
+	organizer, err := s.OrganizerService.IsEventOrganizer(uint(req.EventId), uint(req.UserId))
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.CheckEventOrganizerResponse{
+		Organizer: organizer,
+	}, nil
+}
+
+func (s *Server) GetOrganizedEvents(_ context.Context, req *pb.GetOrganizedEventsRequest) (*pb.GetOrganizedEventsResponse, error) {
+	events, err := s.OrganizerService.GetOrganizedEvents(uint(req.UserId))
+	if err != nil {
+		return nil, err
+	}
+
+	pbEvents := make([]*pb.Event, len(events))
+
+	for i := range pbEvents {
+		pbEvents[i] = eventToPb(events[i])
+	}
+
+	return &pb.GetOrganizedEventsResponse{
+		Events: pbEvents,
+	}, nil
+}
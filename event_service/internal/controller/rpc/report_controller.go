@@ -0,0 +1,39 @@
+package rpc
+
+import (
+	"context"
+
+	"github.com/catness812/faf-hub-backend/event_service/internal/models"
+	"github.com/catness812/faf-hub-backend/event_service/internal/pb"
+)
+
+func (s *Server) GetEventReport(_ context.Context, req *pb.GetEventReportRequest) (*pb.GetEventReportResponse, error) {
+	report, err := s.ReportService.GetEventReport(uint(req.EventId))
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.GetEventReportResponse{
+		EventId:          int32(report.EventID),
+		Registrations:    report.Registrations,
+		ByStatus:         countsToPb(report.ByStatus),
+		ShirtSizes:       countsToPb(report.ShirtSizes),
+		FoodPreferences:  countsToPb(report.FoodPreferences),
+		AcademicGroups:   countsToPb(report.AcademicGroups),
+		Teams:            report.Teams,
+		CompleteTeams:    report.CompleteTeams,
+		TeamMembers:      report.TeamMembers,
+		SoloParticipants: report.SoloParticipants,
+	}, nil
+}
+
+func countsToPb(counts []models.Count) []*pb.Count {
+	res := make([]*pb.Count, len(counts))
+	for i := range res {
+		res[i] = &pb.Count{
+			Value: counts[i].Value,
+			Count: counts[i].Count,
+		}
+	}
+	return res
+}
@@ -2,10 +2,10 @@ package rpc
 
 import (
 	"context"
+	"time"
 
 	"github.com/catness812/faf-hub-backend/event_service/internal/models"
 	"github.com/catness812/faf-hub-backend/event_service/internal/pb"
-	"github.com/golang/protobuf/ptypes/empty"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -13,36 +13,161 @@ type IEventService interface {
 	CreateEvent(event models.Event) (uint, error)
 	UpdateEvent(eventID uint, event models.Event) error
 	DeleteEvent(eventID uint) error
+	CancelEvent(eventID uint, reason string) (int, error)
+	SetAllowGuests(eventID uint, allowGuests bool) error
 	GetEventByID(eventID uint) (models.Event, error)
-	GetAllEvents() ([]models.Event, error)
+	GetAllEvents(filter *models.EventFilter) ([]models.Event, int64, string, error)
+	SearchEvents(search *models.EventSearch) ([]models.EventSearchResult, int64, error)
+}
+
+type IOrganizerService interface {
+	AddOrganizer(organizer models.EventOrganizer) error
+	RemoveOrganizer(eventID uint, userID uint) error
+	GetEventOrganizers(eventID uint) ([]models.EventOrganizer, error)
+	IsEventOrganizer(eventID uint, userID uint) (bool, error)
+	GetOrganizedEvents(userID uint) ([]models.Event, error)
+}
+
+type ITemplateService interface {
+	DuplicateEvent(eventID uint, start time.Time, name string) (uint, error)
+	CreateTemplate(template models.EventTemplate, eventID uint) (uint, error)
+	GetTemplates() ([]models.EventTemplate, error)
+	DeleteTemplate(templateID uint) error
+	CreateEventFromTemplate(templateID uint, start time.Time, name string) (uint, error)
+}
+
+type IVenueService interface {
+	CreateVenue(venue models.Venue) (uint, error)
+	GetVenues() ([]models.Venue, error)
+	DeleteVenue(venueID uint) error
+	CreateRoom(room models.Room) (uint, error)
+	UpdateRoom(room models.Room) error
+	DeleteRoom(roomID uint) error
+	GetRoomAvailability(roomID uint, from time.Time, to time.Time) (models.RoomAvailability, error)
+}
+
+type IVolunteerService interface {
+	CreateRole(role models.VolunteerRole) (uint, error)
+	DeleteRole(eventID uint, roleID uint) error
+	CreateShift(shift models.VolunteerShift) (uint, error)
+	UpdateShift(shift models.VolunteerShift) error
+	DeleteShift(eventID uint, shiftID uint) error
+	GetEventShifts(eventID uint) ([]models.VolunteerRole, []models.VolunteerShift, error)
+	SignUpForShift(signup models.VolunteerSignup) error
+	CancelSignup(shiftID uint, userID uint) error
+}
+
+type IJudgingService interface {
+	SubmitProject(submission models.Submission) (uint, error)
+	GetEventSubmissions(eventID uint) ([]models.Submission, error)
+	SetSubmissionContent(submissionID uint, contentID uint) error
+	AddJudge(judge models.Judge) error
+	RemoveJudge(eventID uint, userID uint) error
+	GetEventJudges(eventID uint) ([]models.Judge, error)
+	CreateCriterion(criterion models.Criterion) (uint, error)
+	UpdateCriterion(criterion models.Criterion) error
+	DeleteCriterion(eventID uint, criterionID uint) error
+	GetEventCriteria(eventID uint) ([]models.Criterion, error)
+	ScoreSubmission(submissionID uint, judgeID uint, scores []models.Score) error
+	GetLeaderboard(eventID uint) ([]models.LeaderboardEntry, error)
+}
+
+type IRecommendationService interface {
+	RecommendEvents(userID uint, limit int) ([]models.Event, []int, error)
 }
 
 type IRegistrationService interface {
-	RegisterForEvent(registration models.Registration) error
-	GetEventRegistrations(eventID uint) ([]models.Registration, error)
+	RegisterForEvent(registration models.Registration) (string, error)
+	RegisterGuest(registration models.Registration) (string, error)
+	ConfirmGuestRegistration(token string) (models.Registration, string, bool, error)
+	ClaimGuestRegistrations(userID uint, email string) (int, error)
+	GetEventRegistrations(eventID uint, filter *models.RegistrationFilter) ([]models.Registration, int64, error)
+	ExportEventRegistrations(eventID uint, filter models.RegistrationFilter, format string) ([]byte, string, error)
 	GetUserEventRegistration(eventID uint, userID uint) (models.Registration, error)
 	GetUserEvents(userID uint) ([]models.Event, error)
 	UpdateRegistration(registration models.Registration) error
+	GetTicket(eventID uint, userID uint) (string, error)
+	CheckIn(eventID uint, ticket string) (models.Registration, error)
+}
+
+type ITeamService interface {
+	CreateTeam(eventID uint, captainID uint, name string) (uint, error)
+	InviteTeamMember(teamID uint, captainID uint, inviteeID uint, inviteeEmail string) error
+	RespondTeamInvite(memberID uint, userID uint, accept bool) error
+	TransferTeamCaptain(teamID uint, captainID uint, newCaptainID uint) error
+	DisbandTeam(teamID uint, captainID uint) error
+	GetUserTeam(eventID uint, userID uint) (models.Team, error)
+	GetUserTeamInvites(userID uint) ([]models.TeamMember, error)
+	GetEventTeams(eventID uint) ([]models.Team, []models.Registration, error)
+	OptInTeamMatching(profile models.MatchingProfile) error
+	OptOutTeamMatching(eventID uint, userID uint) error
+	MatchTeams(eventID uint) ([]models.Team, error)
+}
+
+type ICertificateService interface {
+	IssueCertificates(eventID uint) ([]models.Certificate, models.Event, error)
+	GetUserCertificate(eventID uint, userID uint) (models.Certificate, []byte, error)
+	GetCertificate(code string) (models.Certificate, models.Event, []byte, error)
+	VerifyCertificate(code string) (models.Certificate, models.Event, error)
+}
+
+type ISeriesService interface {
+	CreateSeries(series models.EventSeries, start time.Time, end time.Time) (models.EventSeries, error)
+	GetSeries(seriesID uint) (models.EventSeries, error)
+	CancelOccurrence(eventID uint, reason string) (int, error)
+	RegisterForSeries(seriesID uint, registration models.Registration, allOccurrences bool, eventIDs []uint) ([]models.OccurrenceTicket, error)
+	GetSeriesAttendance(seriesID uint) ([]models.OccurrenceAttendance, error)
+}
+
+type IAgendaService interface {
+	CreateSpeaker(speaker models.Speaker) (uint, error)
+	UpdateSpeaker(speaker models.Speaker) error
+	DeleteSpeaker(speakerID uint) error
+	CreateSession(session models.Session) (uint, error)
+	UpdateSession(session models.Session) error
+	DeleteSession(sessionID uint) error
+	GetEventAgenda(eventID uint) ([]models.Session, []models.Speaker, error)
+	BookmarkSession(sessionID uint, userID uint) error
+	RemoveBookmark(sessionID uint, userID uint) error
+	GetUserAgenda(eventID uint, userID uint) ([]models.Session, error)
+}
+
+type IReportService interface {
+	GetEventReport(eventID uint) (models.EventReport, error)
+}
+
+type IAnalyticsService interface {
+	RecordNewsletterSend(eventID uint, kind string, emails []string) error
+	GetEventAnalytics(eventID uint) (models.EventAnalytics, error)
+	GetAnalyticsOverview(from time.Time, to time.Time) ([]models.AnalyticsPeriod, error)
+}
+
+type IFeedbackService interface {
+	SubmitFeedback(feedback models.Feedback) error
+	GetEventFeedback(eventID uint) (models.FeedbackSummary, []models.Feedback, error)
 }
 
 type Server struct {
 	pb.EventServiceServer
-	EventService        IEventService
-	RegistrationService IRegistrationService
+	EventService          IEventService
+	RegistrationService   IRegistrationService
+	TeamService           ITeamService
+	CertificateService    ICertificateService
+	ReportService         IReportService
+	FeedbackService       IFeedbackService
+	SeriesService         ISeriesService
+	AgendaService         IAgendaService
+	RecommendationService IRecommendationService
+	OrganizerService      IOrganizerService
+	TemplateService       ITemplateService
+	VenueService          IVenueService
+	VolunteerService      IVolunteerService
+	JudgingService        IJudgingService
+	AnalyticsService      IAnalyticsService
 }
 
 func (s *Server) CreateEvent(_ context.Context, req *pb.CreateEventRequest) (*pb.CreateEventResponse, error) {
-	newEvent := models.Event{
-		Name:                req.Event.Name,
-		StartDateTime:       req.Event.Start.AsTime(),
-		EndDateTime:         req.Event.End.AsTime(),
-		Location:            req.Event.Location,
-		ApplicationDeadline: req.Event.Deadline.AsTime(),
-		Cover:               req.Event.Cover,
-		Description:         req.Event.Desc,
-	}
-
-	eventID, err := s.EventService.CreateEvent(newEvent)
+	eventID, err := s.EventService.CreateEvent(eventFromPb(req.Event))
 	if err != nil {
 		return nil, err
 	}
@@ -54,17 +179,7 @@ func (s *Server) CreateEvent(_ context.Context, req *pb.CreateEventRequest) (*pb
 }
 
 func (s *Server) EditEvent(_ context.Context, req *pb.EditEventRequest) (*pb.EditEventResponse, error) {
-	event := models.Event{
-		Name:                req.Event.Name,
-		StartDateTime:       req.Event.Start.AsTime(),
-		EndDateTime:         req.Event.End.AsTime(),
-		Location:            req.Event.Location,
-		ApplicationDeadline: req.Event.Deadline.AsTime(),
-		Cover:               req.Event.Cover,
-		Description:         req.Event.Desc,
-	}
-
-	if err := s.EventService.UpdateEvent(uint(req.Event.EventId), event); err != nil {
+	if err := s.EventService.UpdateEvent(uint(req.Event.EventId), eventFromPb(req.Event)); err != nil {
 		return nil, err
 	}
 
@@ -83,6 +198,18 @@ func (s *Server) DeleteEvent(_ context.Context, req *pb.DeleteEventRequest) (*pb
 	}, nil
 }
 
+func (s *Server) CancelEvent(_ context.Context, req *pb.CancelEventRequest) (*pb.CancelEventResponse, error) {
+	notified, err := s.EventService.CancelEvent(uint(req.EventId), req.Reason)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.CancelEventResponse{
+		Message:  "event cancelled successfully",
+		Notified: int32(notified),
+	}, nil
+}
+
 func (s *Server) GetEvent(_ context.Context, req *pb.GetEventRequest) (*pb.GetEventResponse, error) {
 	event, err := s.EventService.GetEventByID(uint(req.EventId))
 	if err != nil {
@@ -90,21 +217,30 @@ func (s *Server) GetEvent(_ context.Context, req *pb.GetEventRequest) (*pb.GetEv
 	}
 
 	return &pb.GetEventResponse{
-		Event: &pb.Event{
-			EventId:  int32(event.ID),
-			Name:     event.Name,
-			Start:    timestamppb.New(event.StartDateTime),
-			End:      timestamppb.New(event.EndDateTime),
-			Location: event.Location,
-			Deadline: timestamppb.New(event.ApplicationDeadline),
-			Cover:    event.Cover,
-			Desc:     event.Description,
-		},
+		Event: eventToPb(event),
 	}, nil
 }
 
-func (s *Server) GetEvents(_ context.Context, req *empty.Empty) (*pb.GetEventsResponse, error) {
-	events, err := s.EventService.GetAllEvents()
+func (s *Server) GetEvents(_ context.Context, req *pb.GetEventsRequest) (*pb.GetEventsResponse, error) {
+	filter := models.EventFilter{
+		Page:     int(req.Page),
+		PageSize: int(req.PageSize),
+		Cursor:   req.Cursor,
+		When:     req.When,
+		Location: req.Location,
+		Query:    req.Query,
+		Category: req.Category,
+		Tags:     req.Tags,
+		Sort:     req.Sort,
+	}
+	if req.From != nil {
+		filter.From = req.From.AsTime()
+	}
+	if req.To != nil {
+		filter.To = req.To.AsTime()
+	}
+
+	events, total, nextCursor, err := s.EventService.GetAllEvents(&filter)
 	if err != nil {
 		return nil, err
 	}
@@ -112,51 +248,91 @@ func (s *Server) GetEvents(_ context.Context, req *empty.Empty) (*pb.GetEventsRe
 	getEventsRes := make([]*pb.Event, len(events))
 
 	for i := range getEventsRes {
-		event := events[i]
-		getEventsRes[i] = &pb.Event{
-			EventId:  int32(event.ID),
-			Name:     event.Name,
-			Start:    timestamppb.New(event.StartDateTime),
-			End:      timestamppb.New(event.EndDateTime),
-			Location: event.Location,
-			Deadline: timestamppb.New(event.ApplicationDeadline),
-			Cover:    event.Cover,
-			Desc:     event.Description,
-		}
+		getEventsRes[i] = eventToPb(events[i])
 	}
 
 	return &pb.GetEventsResponse{
-		Events: getEventsRes,
+		Events:     getEventsRes,
+		Total:      total,
+		Page:       int32(filter.Page),
+		PageSize:   int32(filter.PageSize),
+		NextCursor: nextCursor,
 	}, nil
 }
 
-func (s *Server) RegisterForEvent(_ context.Context, req *pb.RegisterForEventRequest) (*pb.RegisterForEventResponse, error) {
-	newReg := models.Registration{
-		EventID:         uint(req.Registration.EventId),
-		UserID:          uint(req.Registration.UserId),
-		FirstName:       req.Registration.FirstName,
-		LastName:        req.Registration.LastName,
-		Email:           req.Registration.Email,
-		PhoneNumber:     int(req.Registration.PhoneNumber),
-		AcademicGroup:   req.Registration.AcademicGroup,
-		TeamMembers:     req.Registration.TeamMembers,
-		ShirtSize:       req.Registration.ShirtSize,
-		FoodPreferences: req.Registration.FoodPref,
-		Motivation:      req.Registration.Motivation,
-		Questions:       req.Registration.Questions,
+func (s *Server) SearchEvents(_ context.Context, req *pb.SearchEventsRequest) (*pb.SearchEventsResponse, error) {
+	search := models.EventSearch{
+		Query:    req.Query,
+		Language: req.Language,
+		Page:     int(req.Page),
+		PageSize: int(req.PageSize),
+	}
+
+	results, total, err := s.EventService.SearchEvents(&search)
+	if err != nil {
+		return nil, err
 	}
 
-	if err := s.RegistrationService.RegisterForEvent(newReg); err != nil {
+	searchRes := make([]*pb.EventSearchResult, len(results))
+	for i, result := range results {
+		searchRes[i] = &pb.EventSearchResult{
+			Event:         eventToPb(result.Event),
+			Rank:          result.Rank,
+			NameHighlight: result.NameHighlight,
+			DescHighlight: result.DescHighlight,
+		}
+	}
+
+	return &pb.SearchEventsResponse{
+		Results:  searchRes,
+		Total:    total,
+		Page:     int32(search.Page),
+		PageSize: int32(search.PageSize),
+		Language: search.Language,
+	}, nil
+}
+
+func (s *Server) GetRecommendedEvents(_ context.Context, req *pb.GetRecommendedEventsRequest) (*pb.GetRecommendedEventsResponse, error) {
+	events, scores, err := s.RecommendationService.RecommendEvents(uint(req.UserId), int(req.Limit))
+	if err != nil {
+		return nil, err
+	}
+
+	recommended := make([]*pb.RecommendedEvent, len(events))
+
+	for i := range recommended {
+		recommended[i] = &pb.RecommendedEvent{
+			Event: eventToPb(events[i]),
+			Score: int32(scores[i]),
+		}
+	}
+
+	return &pb.GetRecommendedEventsResponse{
+		Events: recommended,
+	}, nil
+}
+
+func (s *Server) RegisterForEvent(_ context.Context, req *pb.RegisterForEventRequest) (*pb.RegisterForEventResponse, error) {
+	ticket, err := s.RegistrationService.RegisterForEvent(registrationFromPb(req.Registration))
+	if err != nil {
 		return nil, err
 	}
 
 	return &pb.RegisterForEventResponse{
 		Message: "event registration completed successfully",
+		Ticket:  ticket,
 	}, nil
 }
 
 func (s *Server) GetEventRegistrations(_ context.Context, req *pb.GetEventRegistrationsRequest) (*pb.GetEventRegistrationsResponse, error) {
-	regs, err := s.RegistrationService.GetEventRegistrations(uint(req.EventId))
+	filter := models.RegistrationFilter{
+		Page:     int(req.Page),
+		PageSize: int(req.PageSize),
+		Query:    req.Query,
+		Status:   req.Status,
+	}
+
+	regs, total, err := s.RegistrationService.GetEventRegistrations(uint(req.EventId), &filter)
 	if err != nil {
 		return nil, err
 	}
@@ -164,53 +340,42 @@ func (s *Server) GetEventRegistrations(_ context.Context, req *pb.GetEventRegist
 	getEventRegsRes := make([]*pb.EventRegistration, len(regs))
 
 	for i := range getEventRegsRes {
-		reg := regs[i]
-		getEventRegsRes[i] = &pb.EventRegistration{
-			EventId:       int32(reg.EventID),
-			UserId:        int32(reg.UserID),
-			FirstName:     reg.FirstName,
-			LastName:      reg.LastName,
-			Email:         reg.Email,
-			PhoneNumber:   int32(reg.PhoneNumber),
-			AcademicGroup: reg.AcademicGroup,
-			TeamMembers:   reg.TeamMembers,
-			ShirtSize:     reg.ShirtSize,
-			FoodPref:      reg.FoodPreferences,
-			Motivation:    reg.Motivation,
-			Questions:     reg.Questions,
-			Feedback:      reg.Feedback,
-		}
+		getEventRegsRes[i] = registrationToPb(regs[i])
 	}
 
 	return &pb.GetEventRegistrationsResponse{
 		Registrations: getEventRegsRes,
+		Total:         total,
+		Page:          int32(filter.Page),
+		PageSize:      int32(filter.PageSize),
 	}, nil
 }
 
-func (s *Server) GetEventUserRegistration(_ context.Context, req *pb.GetEventUserRegistrationRequest) (*pb.GetEventUserRegistrationResponse, error) {
-	reg, err := s.RegistrationService.GetUserEventRegistration(uint(req.EventId), uint(req.UserId))
+func (s *Server) ExportEventRegistrations(_ context.Context, req *pb.ExportEventRegistrationsRequest) (*pb.ExportEventRegistrationsResponse, error) {
+	filter := models.RegistrationFilter{
+		Query:  req.Query,
+		Status: req.Status,
+	}
+
+	file, fileName, err := s.RegistrationService.ExportEventRegistrations(uint(req.EventId), filter, req.Format)
 	if err != nil {
 		return nil, err
 	}
 
-	registration := &pb.EventRegistration{
-		EventId:       int32(reg.EventID),
-		UserId:        int32(reg.UserID),
-		FirstName:     reg.FirstName,
-		LastName:      reg.LastName,
-		Email:         reg.Email,
-		PhoneNumber:   int32(reg.PhoneNumber),
-		AcademicGroup: reg.AcademicGroup,
-		TeamMembers:   reg.TeamMembers,
-		ShirtSize:     reg.ShirtSize,
-		FoodPref:      reg.FoodPreferences,
-		Motivation:    reg.Motivation,
-		Questions:     reg.Questions,
-		Feedback:      reg.Feedback,
+	return &pb.ExportEventRegistrationsResponse{
+		File:     file,
+		FileName: fileName,
+	}, nil
+}
+
+func (s *Server) GetEventUserRegistration(_ context.Context, req *pb.GetEventUserRegistrationRequest) (*pb.GetEventUserRegistrationResponse, error) {
+	reg, err := s.RegistrationService.GetUserEventRegistration(uint(req.EventId), uint(req.UserId))
+	if err != nil {
+		return nil, err
 	}
 
 	return &pb.GetEventUserRegistrationResponse{
-		Registration: registration,
+		Registration: registrationToPb(reg),
 	}, nil
 }
 
@@ -223,17 +388,7 @@ func (s *Server) GetUserEvents(_ context.Context, req *pb.GetUserEventsRequest)
 	getUserEvents := make([]*pb.Event, len(events))
 
 	for i := range getUserEvents {
-		event := events[i]
-		getUserEvents[i] = &pb.Event{
-			EventId:  int32(event.ID),
-			Name:     event.Name,
-			Start:    timestamppb.New(event.StartDateTime),
-			End:      timestamppb.New(event.EndDateTime),
-			Location: event.Location,
-			Deadline: timestamppb.New(event.ApplicationDeadline),
-			Cover:    event.Cover,
-			Desc:     event.Description,
-		}
+		getUserEvents[i] = eventToPb(events[i])
 	}
 
 	return &pb.GetUserEventsResponse{
@@ -266,3 +421,142 @@ func (s *Server) EditRegistration(_ context.Context, req *pb.EditRegistrationReq
 		Message: "user registration updated successfully",
 	}, nil
 }
+
+func (s *Server) GetTicket(_ context.Context, req *pb.GetTicketRequest) (*pb.GetTicketResponse, error) {
+	ticket, err := s.RegistrationService.GetTicket(uint(req.EventId), uint(req.UserId))
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.GetTicketResponse{
+		Ticket: ticket,
+	}, nil
+}
+
+func (s *Server) CheckIn(_ context.Context, req *pb.CheckInRequest) (*pb.CheckInResponse, error) {
+	reg, err := s.RegistrationService.CheckIn(uint(req.EventId), req.Ticket)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.CheckInResponse{
+		Message:      "checked in successfully",
+		Registration: registrationToPb(reg),
+	}, nil
+}
+
+func eventFromPb(event *pb.Event) models.Event {
+	newEvent := models.Event{
+		Name:                event.Name,
+		StartDateTime:       event.Start.AsTime(),
+		EndDateTime:         event.End.AsTime(),
+		Timezone:            event.Timezone,
+		Location:            event.Location,
+		ApplicationDeadline: event.Deadline.AsTime(),
+		Cover:               event.Cover,
+		CoverMediaID:        event.CoverMediaId,
+		Description:         event.Desc,
+		MinTeamSize:         int(event.MinTeamSize),
+		MaxTeamSize:         int(event.MaxTeamSize),
+		Category:            event.Category,
+		Tags:                tagsFromPb(event.Tags),
+		AllowGuests:         event.AllowGuests,
+	}
+	if event.RoomId != 0 {
+		roomID := uint(event.RoomId)
+		newEvent.RoomID = &roomID
+	}
+	return newEvent
+}
+
+func eventToPb(event models.Event) *pb.Event {
+	return &pb.Event{
+		EventId:            int32(event.ID),
+		Name:               event.Name,
+		Start:              timestamppb.New(event.StartDateTime),
+		End:                timestamppb.New(event.EndDateTime),
+		Timezone:           event.Timezone,
+		Location:           event.Location,
+		Deadline:           timestamppb.New(event.ApplicationDeadline),
+		Cover:              event.Cover,
+		CoverMediaId:       event.CoverMediaID,
+		Desc:               event.Description,
+		MinTeamSize:        int32(event.MinTeamSize),
+		MaxTeamSize:        int32(event.MaxTeamSize),
+		SeriesId:           int32(uintOrZero(event.SeriesID)),
+		CancelledAt:        timestampOrNil(event.CancelledAt),
+		Category:           event.Category,
+		Tags:               tagsToPb(event.Tags),
+		CancellationReason: event.CancellationReason,
+		RoomId:             int32(uintOrZero(event.RoomID)),
+		AllowGuests:        event.AllowGuests,
+	}
+}
+
+func tagsFromPb(names []string) []models.Tag {
+	tags := make([]models.Tag, len(names))
+	for i, name := range names {
+		tags[i] = models.Tag{Name: name}
+	}
+	return tags
+}
+
+func tagsToPb(tags []models.Tag) []string {
+	names := make([]string, len(tags))
+	for i, tag := range tags {
+		names[i] = tag.Name
+	}
+	return names
+}
+
+func registrationFromPb(reg *pb.EventRegistration) models.Registration {
+	return models.Registration{
+		EventID:         uint(reg.EventId),
+		UserID:          uint(reg.UserId),
+		FirstName:       reg.FirstName,
+		LastName:        reg.LastName,
+		Email:           reg.Email,
+		PhoneNumber:     int(reg.PhoneNumber),
+		AcademicGroup:   reg.AcademicGroup,
+		TeamMembers:     reg.TeamMembers,
+		ShirtSize:       reg.ShirtSize,
+		FoodPreferences: reg.FoodPref,
+		Motivation:      reg.Motivation,
+		Questions:       reg.Questions,
+	}
+}
+
+func registrationToPb(reg models.Registration) *pb.EventRegistration {
+	return &pb.EventRegistration{
+		EventId:       int32(reg.EventID),
+		UserId:        int32(reg.UserID),
+		FirstName:     reg.FirstName,
+		LastName:      reg.LastName,
+		Email:         reg.Email,
+		PhoneNumber:   int32(reg.PhoneNumber),
+		AcademicGroup: reg.AcademicGroup,
+		TeamMembers:   reg.TeamMembers,
+		ShirtSize:     reg.ShirtSize,
+		FoodPref:      reg.FoodPreferences,
+		Motivation:    reg.Motivation,
+		Questions:     reg.Questions,
+		Feedback:      reg.Feedback,
+		Status:        reg.Status,
+		CheckedInAt:   timestampOrNil(reg.CheckedInAt),
+		Guest:         reg.Guest,
+	}
+}
+
+func uintOrZero(n *uint) uint {
+	if n == nil {
+		return 0
+	}
+	return *n
+}
+
+func timestampOrNil(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+	return timestamppb.New(*t)
+}
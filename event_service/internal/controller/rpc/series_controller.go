@@ -0,0 +1,120 @@
+package rpc
+
+import (
+	"context"
+
+	"github.com/catness812/faf-hub-backend/event_service/internal/models"
+	"github.com/catness812/faf-hub-backend/event_service/internal/pb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *Server) CreateEventSeries(_ context.Context, req *pb.CreateEventSeriesRequest) (*pb.CreateEventSeriesResponse, error) {
+	series := models.EventSeries{
+		Name:         req.Series.Name,
+		Description:  req.Series.Desc,
+		Location:     req.Series.Location,
+		Cover:        req.Series.Cover,
+		CoverMediaID: req.Series.CoverMediaId,
+		Timezone:     req.Series.Timezone,
+		RRule:        req.Series.Rrule,
+	}
+
+	series, err := s.SeriesService.CreateSeries(series, req.Start.AsTime(), req.End.AsTime())
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.CreateEventSeriesResponse{
+		Message:     "event series created successfully",
+		SeriesId:    int32(series.ID),
+		Occurrences: int32(len(series.Occurrences)),
+	}, nil
+}
+
+func (s *Server) GetEventSeries(_ context.Context, req *pb.GetEventSeriesRequest) (*pb.GetEventSeriesResponse, error) {
+	series, err := s.SeriesService.GetSeries(uint(req.SeriesId))
+	if err != nil {
+		return nil, err
+	}
+
+	occurrences := make([]*pb.Event, len(series.Occurrences))
+
+	for i := range occurrences {
+		occurrences[i] = eventToPb(series.Occurrences[i])
+	}
+
+	return &pb.GetEventSeriesResponse{
+		Series: &pb.EventSeries{
+			SeriesId:     int32(series.ID),
+			Name:         series.Name,
+			Desc:         series.Description,
+			Location:     series.Location,
+			Cover:        series.Cover,
+			CoverMediaId: series.CoverMediaID,
+			Timezone:     series.Timezone,
+			Rrule:        series.RRule,
+			Occurrences:  occurrences,
+		},
+	}, nil
+}
+
+func (s *Server) CancelOccurrence(_ context.Context, req *pb.CancelOccurrenceRequest) (*pb.CancelOccurrenceResponse, error) {
+	notified, err := s.SeriesService.CancelOccurrence(uint(req.EventId), req.Reason)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.CancelOccurrenceResponse{
+		Message:  "occurrence cancelled successfully",
+		Notified: int32(notified),
+	}, nil
+}
+
+func (s *Server) RegisterForSeries(_ context.Context, req *pb.RegisterForSeriesRequest) (*pb.RegisterForSeriesResponse, error) {
+	eventIDs := make([]uint, len(req.EventIds))
+	for i, id := range req.EventIds {
+		eventIDs[i] = uint(id)
+	}
+
+	tickets, err := s.SeriesService.RegisterForSeries(uint(req.SeriesId), registrationFromPb(req.Registration), req.AllOccurrences, eventIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	pbTickets := make([]*pb.OccurrenceTicket, len(tickets))
+	for i, ticket := range tickets {
+		pbTickets[i] = &pb.OccurrenceTicket{
+			EventId: int32(ticket.EventID),
+			Ticket:  ticket.Ticket,
+		}
+	}
+
+	return &pb.RegisterForSeriesResponse{
+		Message:    "event series registration completed successfully",
+		Registered: int32(len(tickets)),
+		Tickets:    pbTickets,
+	}, nil
+}
+
+func (s *Server) GetSeriesAttendance(_ context.Context, req *pb.GetSeriesAttendanceRequest) (*pb.GetSeriesAttendanceResponse, error) {
+	attendance, err := s.SeriesService.GetSeriesAttendance(uint(req.SeriesId))
+	if err != nil {
+		return nil, err
+	}
+
+	occurrences := make([]*pb.OccurrenceAttendance, len(attendance))
+
+	for i, a := range attendance {
+		occurrences[i] = &pb.OccurrenceAttendance{
+			EventId:     int32(a.EventID),
+			Start:       timestamppb.New(a.StartDateTime),
+			CancelledAt: timestampOrNil(a.CancelledAt),
+			Registered:  a.Registered,
+			Attended:    a.Attended,
+		}
+	}
+
+	return &pb.GetSeriesAttendanceResponse{
+		Occurrences: occurrences,
+	}, nil
+}
@@ -0,0 +1,189 @@
+package rpc
+
+import (
+	"context"
+
+	"github.com/catness812/faf-hub-backend/event_service/internal/models"
+	"github.com/catness812/faf-hub-backend/event_service/internal/pb"
+)
+
+func (s *Server) CreateTeam(_ context.Context, req *pb.CreateTeamRequest) (*pb.CreateTeamResponse, error) {
+	teamID, err := s.TeamService.CreateTeam(uint(req.EventId), uint(req.UserId), req.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.CreateTeamResponse{
+		Message: "team created successfully",
+		TeamId:  int32(teamID),
+	}, nil
+}
+
+func (s *Server) InviteTeamMember(_ context.Context, req *pb.InviteTeamMemberRequest) (*pb.InviteTeamMemberResponse, error) {
+	if err := s.TeamService.InviteTeamMember(uint(req.TeamId), uint(req.UserId), uint(req.InviteeId), req.InviteeEmail); err != nil {
+		return nil, err
+	}
+
+	return &pb.InviteTeamMemberResponse{
+		Message: "team invite sent successfully",
+	}, nil
+}
+
+func (s *Server) RespondTeamInvite(_ context.Context, req *pb.RespondTeamInviteRequest) (*pb.RespondTeamInviteResponse, error) {
+	if err := s.TeamService.RespondTeamInvite(uint(req.MemberId), uint(req.UserId), req.Accept); err != nil {
+		return nil, err
+	}
+
+	message := "team invite declined successfully"
+	if req.Accept {
+		message = "team invite accepted successfully"
+	}
+
+	return &pb.RespondTeamInviteResponse{
+		Message: message,
+	}, nil
+}
+
+func (s *Server) TransferTeamCaptain(_ context.Context, req *pb.TransferTeamCaptainRequest) (*pb.TransferTeamCaptainResponse, error) {
+	if err := s.TeamService.TransferTeamCaptain(uint(req.TeamId), uint(req.UserId), uint(req.NewCaptainId)); err != nil {
+		return nil, err
+	}
+
+	return &pb.TransferTeamCaptainResponse{
+		Message: "team captain transferred successfully",
+	}, nil
+}
+
+func (s *Server) DisbandTeam(_ context.Context, req *pb.DisbandTeamRequest) (*pb.DisbandTeamResponse, error) {
+	if err := s.TeamService.DisbandTeam(uint(req.TeamId), uint(req.UserId)); err != nil {
+		return nil, err
+	}
+
+	return &pb.DisbandTeamResponse{
+		Message: "team disbanded successfully",
+	}, nil
+}
+
+func (s *Server) GetUserTeam(_ context.Context, req *pb.GetUserTeamRequest) (*pb.GetUserTeamResponse, error) {
+	team, err := s.TeamService.GetUserTeam(uint(req.EventId), uint(req.UserId))
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.GetUserTeamResponse{
+		Team: teamToPb(team),
+	}, nil
+}
+
+func (s *Server) GetUserTeamInvites(_ context.Context, req *pb.GetUserTeamInvitesRequest) (*pb.GetUserTeamInvitesResponse, error) {
+	invites, err := s.TeamService.GetUserTeamInvites(uint(req.UserId))
+	if err != nil {
+		return nil, err
+	}
+
+	getInvitesRes := make([]*pb.TeamMember, len(invites))
+
+	for i := range getInvitesRes {
+		getInvitesRes[i] = teamMemberToPb(invites[i])
+	}
+
+	return &pb.GetUserTeamInvitesResponse{
+		Invites: getInvitesRes,
+	}, nil
+}
+
+func (s *Server) GetEventTeams(_ context.Context, req *pb.GetEventTeamsRequest) (*pb.GetEventTeamsResponse, error) {
+	teams, solo, err := s.TeamService.GetEventTeams(uint(req.EventId))
+	if err != nil {
+		return nil, err
+	}
+
+	getTeamsRes := make([]*pb.Team, len(teams))
+
+	for i := range getTeamsRes {
+		getTeamsRes[i] = teamToPb(teams[i])
+	}
+
+	getSoloRes := make([]*pb.EventRegistration, len(solo))
+
+	for i := range getSoloRes {
+		getSoloRes[i] = registrationToPb(solo[i])
+	}
+
+	return &pb.GetEventTeamsResponse{
+		Teams:            getTeamsRes,
+		SoloParticipants: getSoloRes,
+	}, nil
+}
+
+func (s *Server) OptInTeamMatching(_ context.Context, req *pb.OptInTeamMatchingRequest) (*pb.OptInTeamMatchingResponse, error) {
+	profile := models.MatchingProfile{
+		EventID:   uint(req.EventId),
+		UserID:    uint(req.UserId),
+		Skills:    req.Skills,
+		Interests: req.Interests,
+	}
+
+	if err := s.TeamService.OptInTeamMatching(profile); err != nil {
+		return nil, err
+	}
+
+	return &pb.OptInTeamMatchingResponse{
+		Message: "opted in to team matching successfully",
+	}, nil
+}
+
+func (s *Server) OptOutTeamMatching(_ context.Context, req *pb.OptOutTeamMatchingRequest) (*pb.OptOutTeamMatchingResponse, error) {
+	if err := s.TeamService.OptOutTeamMatching(uint(req.EventId), uint(req.UserId)); err != nil {
+		return nil, err
+	}
+
+	return &pb.OptOutTeamMatchingResponse{
+		Message: "opted out of team matching successfully",
+	}, nil
+}
+
+func (s *Server) MatchTeams(_ context.Context, req *pb.MatchTeamsRequest) (*pb.MatchTeamsResponse, error) {
+	teams, err := s.TeamService.MatchTeams(uint(req.EventId))
+	if err != nil {
+		return nil, err
+	}
+
+	matchTeamsRes := make([]*pb.Team, len(teams))
+
+	for i := range matchTeamsRes {
+		matchTeamsRes[i] = teamToPb(teams[i])
+	}
+
+	return &pb.MatchTeamsResponse{
+		Message: "teams proposed successfully",
+		Teams:   matchTeamsRes,
+	}, nil
+}
+
+func teamToPb(team models.Team) *pb.Team {
+	members := make([]*pb.TeamMember, len(team.Members))
+	for i := range members {
+		members[i] = teamMemberToPb(team.Members[i])
+	}
+
+	return &pb.Team{
+		TeamId:    int32(team.ID),
+		EventId:   int32(team.EventID),
+		Name:      team.Name,
+		CaptainId: int32(team.CaptainID),
+		Members:   members,
+		Complete:  team.Complete,
+		Matched:   team.Matched,
+	}
+}
+
+func teamMemberToPb(member models.TeamMember) *pb.TeamMember {
+	return &pb.TeamMember{
+		MemberId: int32(member.ID),
+		TeamId:   int32(member.TeamID),
+		UserId:   int32(member.UserID),
+		Email:    member.Email,
+		Status:   member.Status,
+	}
+}
@@ -0,0 +1,131 @@
+package rpc
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/catness812/faf-hub-backend/event_service/internal/models"
+	"github.com/catness812/faf-hub-backend/event_service/internal/pb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *Server) DuplicateEvent(_ context.Context, req *pb.DuplicateEventRequest) (*pb.DuplicateEventResponse, error) {
+	eventID, err := s.TemplateService.DuplicateEvent(uint(req.EventId), timeFromPb(req.Start), req.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.DuplicateEventResponse{
+		Message: "event duplicated successfully",
+		EventId: int32(eventID),
+	}, nil
+}
+
+func (s *Server) CreateEventTemplate(_ context.Context, req *pb.CreateEventTemplateRequest) (*pb.CreateEventTemplateResponse, error) {
+	template := templateFromPb(req.Template)
+
+	templateID, err := s.TemplateService.CreateTemplate(template, uint(req.EventId))
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.CreateEventTemplateResponse{
+		Message:    "event template created successfully",
+		TemplateId: int32(templateID),
+	}, nil
+}
+
+func (s *Server) GetEventTemplates(_ context.Context, _ *pb.GetEventTemplatesRequest) (*pb.GetEventTemplatesResponse, error) {
+	templates, err := s.TemplateService.GetTemplates()
+	if err != nil {
+		return nil, err
+	}
+
+	pbTemplates := make([]*pb.EventTemplate, len(templates))
+
+	for i, template := range templates {
+		pbTemplates[i] = templateToPb(template)
+	}
+
+	return &pb.GetEventTemplatesResponse{
+		Templates: pbTemplates,
+	}, nil
+}
+
+func (s *Server) DeleteEventTemplate(_ context.Context, req *pb.DeleteEventTemplateRequest) (*pb.DeleteEventTemplateResponse, error) {
+	if err := s.TemplateService.DeleteTemplate(uint(req.TemplateId)); err != nil {
+		return nil, err
+	}
+
+	return &pb.DeleteEventTemplateResponse{
+		Message: "event template deleted successfully",
+	}, nil
+}
+
+func (s *Server) CreateEventFromTemplate(_ context.Context, req *pb.CreateEventFromTemplateRequest) (*pb.CreateEventFromTemplateResponse, error) {
+	eventID, err := s.TemplateService.CreateEventFromTemplate(uint(req.TemplateId), timeFromPb(req.Start), req.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.CreateEventFromTemplateResponse{
+		Message: "event created successfully",
+		EventId: int32(eventID),
+	}, nil
+}
+
+// timeFromPb keeps a missing timestamp as the zero time rather than the Unix
+// epoch, so the services can tell it apart.
+func timeFromPb(ts *timestamppb.Timestamp) time.Time {
+	if ts == nil {
+		return time.Time{}
+	}
+	return ts.AsTime()
+}
+
+func templateFromPb(template *pb.EventTemplate) models.EventTemplate {
+	if template == nil {
+		return models.EventTemplate{}
+	}
+
+	return models.EventTemplate{
+		Name:                  template.Name,
+		EventName:             template.EventName,
+		Description:           template.Desc,
+		Location:              template.Location,
+		Cover:                 template.Cover,
+		CoverMediaID:          template.CoverMediaId,
+		Timezone:              template.Timezone,
+		Category:              template.Category,
+		Tags:                  strings.Join(template.Tags, ","),
+		MinTeamSize:           int(template.MinTeamSize),
+		MaxTeamSize:           int(template.MaxTeamSize),
+		DurationMinutes:       int(template.DurationMinutes),
+		DeadlineMinutesBefore: int(template.DeadlineMinutesBefore),
+	}
+}
+
+func templateToPb(template models.EventTemplate) *pb.EventTemplate {
+	var tags []string
+	if template.Tags != "" {
+		tags = strings.Split(template.Tags, ",")
+	}
+
+	return &pb.EventTemplate{
+		TemplateId:            int32(template.ID),
+		Name:                  template.Name,
+		EventName:             template.EventName,
+		Desc:                  template.Description,
+		Location:              template.Location,
+		Cover:                 template.Cover,
+		CoverMediaId:          template.CoverMediaID,
+		Timezone:              template.Timezone,
+		Category:              template.Category,
+		Tags:                  tags,
+		MinTeamSize:           int32(template.MinTeamSize),
+		MaxTeamSize:           int32(template.MaxTeamSize),
+		DurationMinutes:       int32(template.DurationMinutes),
+		DeadlineMinutesBefore: int32(template.DeadlineMinutesBefore),
+	}
+}
@@ -0,0 +1,158 @@
+package rpc
+
+import (
+	"context"
+	"strings"
+
+	"github.com/catness812/faf-hub-backend/event_service/internal/models"
+	"github.com/catness812/faf-hub-backend/event_service/internal/pb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *Server) CreateVenue(_ context.Context, req *pb.CreateVenueRequest) (*pb.CreateVenueResponse, error) {
+	venue := models.Venue{
+		Name:    req.Venue.GetName(),
+		Address: req.Venue.GetAddress(),
+	}
+
+	venueID, err := s.VenueService.CreateVenue(venue)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.CreateVenueResponse{
+		Message: "venue created successfully",
+		VenueId: int32(venueID),
+	}, nil
+}
+
+func (s *Server) GetVenues(_ context.Context, _ *pb.GetVenuesRequest) (*pb.GetVenuesResponse, error) {
+	venues, err := s.VenueService.GetVenues()
+	if err != nil {
+		return nil, err
+	}
+
+	pbVenues := make([]*pb.Venue, len(venues))
+
+	for i, venue := range venues {
+		rooms := make([]*pb.Room, len(venue.Rooms))
+		for j, room := range venue.Rooms {
+			room.Venue = &venue
+			rooms[j] = roomToPb(room)
+		}
+		pbVenues[i] = &pb.Venue{
+			VenueId: int32(venue.ID),
+			Name:    venue.Name,
+			Address: venue.Address,
+			Rooms:   rooms,
+		}
+	}
+
+	return &pb.GetVenuesResponse{
+		Venues: pbVenues,
+	}, nil
+}
+
+func (s *Server) DeleteVenue(_ context.Context, req *pb.DeleteVenueRequest) (*pb.DeleteVenueResponse, error) {
+	if err := s.VenueService.DeleteVenue(uint(req.VenueId)); err != nil {
+		return nil, err
+	}
+
+	return &pb.DeleteVenueResponse{
+		Message: "venue deleted successfully",
+	}, nil
+}
+
+func (s *Server) CreateRoom(_ context.Context, req *pb.CreateRoomRequest) (*pb.CreateRoomResponse, error) {
+	roomID, err := s.VenueService.CreateRoom(roomFromPb(req.Room))
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.CreateRoomResponse{
+		Message: "room created successfully",
+		RoomId:  int32(roomID),
+	}, nil
+}
+
+func (s *Server) EditRoom(_ context.Context, req *pb.EditRoomRequest) (*pb.EditRoomResponse, error) {
+	if err := s.VenueService.UpdateRoom(roomFromPb(req.Room)); err != nil {
+		return nil, err
+	}
+
+	return &pb.EditRoomResponse{
+		Message: "room updated successfully",
+	}, nil
+}
+
+func (s *Server) DeleteRoom(_ context.Context, req *pb.DeleteRoomRequest) (*pb.DeleteRoomResponse, error) {
+	if err := s.VenueService.DeleteRoom(uint(req.RoomId)); err != nil {
+		return nil, err
+	}
+
+	return &pb.DeleteRoomResponse{
+		Message: "room deleted successfully",
+	}, nil
+}
+
+func (s *Server) GetRoomAvailability(_ context.Context, req *pb.GetRoomAvailabilityRequest) (*pb.GetRoomAvailabilityResponse, error) {
+	availability, err := s.VenueService.GetRoomAvailability(uint(req.RoomId), timeFromPb(req.From), timeFromPb(req.To))
+	if err != nil {
+		return nil, err
+	}
+
+	bookings := make([]*pb.Event, len(availability.Bookings))
+	for i := range bookings {
+		bookings[i] = eventToPb(availability.Bookings[i])
+	}
+
+	free := make([]*pb.TimeSlot, len(availability.Free))
+	for i, slot := range availability.Free {
+		free[i] = &pb.TimeSlot{
+			Start: timestamppb.New(slot.Start),
+			End:   timestamppb.New(slot.End),
+		}
+	}
+
+	return &pb.GetRoomAvailabilityResponse{
+		Room:     roomToPb(availability.Room),
+		Bookings: bookings,
+		Free:     free,
+	}, nil
+}
+
+func roomFromPb(room *pb.Room) models.Room {
+	if room == nil {
+		return models.Room{}
+	}
+
+	newRoom := models.Room{
+		VenueID:   uint(room.VenueId),
+		Name:      room.Name,
+		Capacity:  int(room.Capacity),
+		Equipment: strings.Join(room.Equipment, ","),
+	}
+	newRoom.ID = uint(room.RoomId)
+	return newRoom
+}
+
+func roomToPb(room models.Room) *pb.Room {
+	var equipment []string
+	if room.Equipment != "" {
+		equipment = strings.Split(room.Equipment, ",")
+	}
+
+	var venueName string
+	if room.Venue != nil {
+		venueName = room.Venue.Name
+	}
+
+	return &pb.Room{
+		RoomId:    int32(room.ID),
+		VenueId:   int32(room.VenueID),
+		Name:      room.Name,
+		Capacity:  int32(room.Capacity),
+		Equipment: equipment,
+		VenueName: venueName,
+	}
+}
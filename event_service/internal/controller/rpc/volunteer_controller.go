@@ -0,0 +1,188 @@
+package rpc
+
+import (
+	"context"
+
+	"github.com/catness812/faf-hub-backend/event_service/internal/models"
+	"github.com/catness812/faf-hub-backend/event_service/internal/pb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *Server) CreateVolunteerRole(_ context.Context, req *pb.CreateVolunteerRoleRequest) (*pb.CreateVolunteerRoleResponse, error) {
+	role := models.VolunteerRole{
+		EventID:     uint(req.Role.GetEventId()),
+		Name:        req.Role.GetName(),
+		Description: req.Role.GetDesc(),
+	}
+
+	roleID, err := s.VolunteerService.CreateRole(role)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.CreateVolunteerRoleResponse{
+		Message: "volunteer role created successfully",
+		RoleId:  int32(roleID),
+	}, nil
+}
+
+func (s *Server) DeleteVolunteerRole(_ context.Context, req *pb.DeleteVolunteerRoleRequest) (*pb.DeleteVolunteerRoleResponse, error) {
+	if err := s.VolunteerService.DeleteRole(uint(req.EventId), uint(req.RoleId)); err != nil {
+		return nil, err
+	}
+
+	return &pb.DeleteVolunteerRoleResponse{
+		Message: "volunteer role deleted successfully",
+	}, nil
+}
+
+func (s *Server) CreateVolunteerShift(_ context.Context, req *pb.CreateVolunteerShiftRequest) (*pb.CreateVolunteerShiftResponse, error) {
+	shiftID, err := s.VolunteerService.CreateShift(shiftFromPb(req.Shift))
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.CreateVolunteerShiftResponse{
+		Message: "volunteer shift created successfully",
+		ShiftId: int32(shiftID),
+	}, nil
+}
+
+func (s *Server) EditVolunteerShift(_ context.Context, req *pb.EditVolunteerShiftRequest) (*pb.EditVolunteerShiftResponse, error) {
+	if err := s.VolunteerService.UpdateShift(shiftFromPb(req.Shift)); err != nil {
+		return nil, err
+	}
+
+	return &pb.EditVolunteerShiftResponse{
+		Message: "volunteer shift updated successfully",
+	}, nil
+}
+
+func (s *Server) DeleteVolunteerShift(_ context.Context, req *pb.DeleteVolunteerShiftRequest) (*pb.DeleteVolunteerShiftResponse, error) {
+	if err := s.VolunteerService.DeleteShift(uint(req.EventId), uint(req.ShiftId)); err != nil {
+		return nil, err
+	}
+
+	return &pb.DeleteVolunteerShiftResponse{
+		Message: "volunteer shift deleted successfully",
+	}, nil
+}
+
+func (s *Server) GetVolunteerShifts(_ context.Context, req *pb.GetVolunteerShiftsRequest) (*pb.GetVolunteerShiftsResponse, error) {
+	roles, shifts, err := s.VolunteerService.GetEventShifts(uint(req.EventId))
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.GetVolunteerShiftsResponse{
+		Roles:  rolesToPb(roles),
+		Shifts: shiftsToPb(shifts, false),
+	}, nil
+}
+
+func (s *Server) GetVolunteerRoster(_ context.Context, req *pb.GetVolunteerRosterRequest) (*pb.GetVolunteerRosterResponse, error) {
+	roles, shifts, err := s.VolunteerService.GetEventShifts(uint(req.EventId))
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.GetVolunteerRosterResponse{
+		Roles:  rolesToPb(roles),
+		Shifts: shiftsToPb(shifts, true),
+	}, nil
+}
+
+func (s *Server) SignUpForShift(_ context.Context, req *pb.SignUpForShiftRequest) (*pb.SignUpForShiftResponse, error) {
+	signup := models.VolunteerSignup{
+		ShiftID: uint(req.Signup.GetShiftId()),
+		UserID:  uint(req.Signup.GetUserId()),
+		Name:    req.Signup.GetName(),
+		Email:   req.Signup.GetEmail(),
+	}
+
+	if err := s.VolunteerService.SignUpForShift(signup); err != nil {
+		return nil, err
+	}
+
+	return &pb.SignUpForShiftResponse{
+		Message: "signed up for volunteer shift successfully",
+	}, nil
+}
+
+func (s *Server) CancelShiftSignup(_ context.Context, req *pb.CancelShiftSignupRequest) (*pb.CancelShiftSignupResponse, error) {
+	if err := s.VolunteerService.CancelSignup(uint(req.ShiftId), uint(req.UserId)); err != nil {
+		return nil, err
+	}
+
+	return &pb.CancelShiftSignupResponse{
+		Message: "volunteer signup cancelled successfully",
+	}, nil
+}
+
+func shiftFromPb(shift *pb.VolunteerShift) models.VolunteerShift {
+	if shift == nil {
+		return models.VolunteerShift{}
+	}
+
+	newShift := models.VolunteerShift{
+		EventID:     uint(shift.EventId),
+		RoleID:      uint(shift.RoleId),
+		Description: shift.Desc,
+		StartTime:   timeFromPb(shift.Start),
+		EndTime:     timeFromPb(shift.End),
+		Slots:       int(shift.Slots),
+	}
+	newShift.ID = uint(shift.ShiftId)
+	return newShift
+}
+
+func rolesToPb(roles []models.VolunteerRole) []*pb.VolunteerRole {
+	pbRoles := make([]*pb.VolunteerRole, len(roles))
+	for i, role := range roles {
+		pbRoles[i] = &pb.VolunteerRole{
+			RoleId:  int32(role.ID),
+			EventId: int32(role.EventID),
+			Name:    role.Name,
+			Desc:    role.Description,
+		}
+	}
+	return pbRoles
+}
+
+// shiftsToPb only includes who signed up when withSignups is set, since the
+// public list of shifts shouldn't expose the volunteers' contact details.
+func shiftsToPb(shifts []models.VolunteerShift, withSignups bool) []*pb.VolunteerShift {
+	pbShifts := make([]*pb.VolunteerShift, len(shifts))
+	for i, shift := range shifts {
+		pbShifts[i] = &pb.VolunteerShift{
+			ShiftId:  int32(shift.ID),
+			EventId:  int32(shift.EventID),
+			RoleId:   int32(shift.RoleID),
+			RoleName: roleNameOf(shift),
+			Desc:     shift.Description,
+			Start:    timestamppb.New(shift.StartTime),
+			End:      timestamppb.New(shift.EndTime),
+			Slots:    int32(shift.Slots),
+			Taken:    int32(len(shift.Signups)),
+		}
+		if withSignups {
+			for _, signup := range shift.Signups {
+				pbShifts[i].Signups = append(pbShifts[i].Signups, &pb.VolunteerSignup{
+					ShiftId:    int32(signup.ShiftID),
+					UserId:     int32(signup.UserID),
+					Name:       signup.Name,
+					Email:      signup.Email,
+					SignedUpAt: timestamppb.New(signup.CreatedAt),
+				})
+			}
+		}
+	}
+	return pbShifts
+}
+
+func roleNameOf(shift models.VolunteerShift) string {
+	if shift.Role == nil {
+		return ""
+	}
+	return shift.Role.Name
+}
@@ -0,0 +1,35 @@
+package models
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type Speaker struct {
+	gorm.Model
+	EventID uint   `gorm:"not null;index" json:"event_id"`
+	Name    string `gorm:"not null" json:"name"`
+	Title   string `gorm:"not null" json:"title"`
+	Bio     string `gorm:"not null" json:"bio"`
+	Photo   string `gorm:"not null" json:"photo"`
+	Links   string `gorm:"not null" json:"links"`
+}
+
+type Session struct {
+	gorm.Model
+	EventID     uint      `gorm:"not null;index" json:"event_id"`
+	SpeakerID   *uint     `gorm:"index" json:"speaker_id"`
+	Title       string    `gorm:"not null" json:"title"`
+	Description string    `gorm:"not null" json:"desc"`
+	Room        string    `gorm:"not null" json:"room"`
+	StartTime   time.Time `gorm:"type:timestamptz;not null" json:"start"`
+	EndTime     time.Time `gorm:"type:timestamptz;not null" json:"end"`
+	Speaker     *Speaker  `json:"speaker"`
+}
+
+type SessionBookmark struct {
+	gorm.Model
+	SessionID uint `gorm:"not null;uniqueIndex:idx_bookmark_session_user" json:"session_id"`
+	UserID    uint `gorm:"not null;uniqueIndex:idx_bookmark_session_user" json:"user_id"`
+}
@@ -0,0 +1,66 @@
+package models
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const (
+	NewsletterAnnouncement     = "announcement"
+	NewsletterDeadlineReminder = "deadline_reminder"
+)
+
+// DefaultAnalyticsRange is the range of the overview when no start is given.
+const DefaultAnalyticsRange = 365 * 24 * time.Hour
+
+// NewsletterSend records a newsletter that went out about an event, so
+// registrations can be traced back to it.
+type NewsletterSend struct {
+	gorm.Model
+	EventID    uint      `gorm:"not null;index" json:"event_id"`
+	Kind       string    `gorm:"not null" json:"kind"`
+	Recipients int       `gorm:"not null" json:"recipients"`
+	SentAt     time.Time `gorm:"not null" json:"sent_at"`
+}
+
+// NewsletterRecipient is a lowercased email a newsletter send went out to.
+type NewsletterRecipient struct {
+	SendID uint   `gorm:"primaryKey" json:"send_id"`
+	Email  string `gorm:"primaryKey" json:"email"`
+}
+
+type RegistrationTimelinePoint struct {
+	DaysBeforeDeadline int   `json:"days_before_deadline"`
+	Registrations      int64 `json:"registrations"`
+	Cumulative         int64 `json:"cumulative"`
+}
+
+type EventAnalytics struct {
+	EventID              uint                        `json:"event_id"`
+	Registrations        int64                       `json:"registrations"`
+	Timeline             []RegistrationTimelinePoint `json:"timeline"`
+	NewsletterSends      int64                       `json:"newsletter_sends"`
+	NewsletterRecipients int64                       `json:"newsletter_recipients"`
+	NewsletterConverted  int64                       `json:"newsletter_converted"`
+	ConversionRate       float64                     `json:"conversion_rate"`
+	Confirmed            int64                       `json:"confirmed"`
+	CheckedIn            int64                       `json:"checked_in"`
+	AttendanceRate       float64                     `json:"attendance_rate"`
+	RepeatAttendees      int64                       `json:"repeat_attendees"`
+	FeedbackResponses    int64                       `json:"feedback_responses"`
+	AvgContent           float64                     `json:"avg_content"`
+	AvgOrganization      float64                     `json:"avg_organization"`
+	AvgVenue             float64                     `json:"avg_venue"`
+}
+
+// AnalyticsPeriod sums up the events that started in a month.
+type AnalyticsPeriod struct {
+	Period          string  `json:"period"`
+	Events          int64   `json:"events"`
+	Registrations   int64   `json:"registrations"`
+	CheckedIn       int64   `json:"checked_in"`
+	AttendanceRate  float64 `json:"attendance_rate"`
+	RepeatAttendees int64   `json:"repeat_attendees"`
+	AvgRating       float64 `json:"avg_rating"`
+}
@@ -0,0 +1,19 @@
+package models
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type Certificate struct {
+	gorm.Model
+	EventID        uint      `gorm:"not null" json:"event_id"`
+	RegistrationID uint      `gorm:"not null;uniqueIndex" json:"registration_id"`
+	UserID         uint      `gorm:"not null" json:"user_id"`
+	Code           string    `gorm:"not null;uniqueIndex" json:"code"`
+	FirstName      string    `gorm:"not null" json:"first_name"`
+	LastName       string    `gorm:"not null" json:"last_name"`
+	Email          string    `gorm:"not null" json:"email"`
+	IssuedAt       time.Time `gorm:"not null" json:"issued_at"`
+}
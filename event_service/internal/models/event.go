@@ -6,13 +6,41 @@ import (
 	"gorm.io/gorm"
 )
 
+const DefaultTimezone = "Europe/Chisinau"
+
+const (
+	CategoryHackathon = "hackathon"
+	CategoryWorkshop  = "workshop"
+	CategoryMeetup    = "meetup"
+	CategoryTrip      = "trip"
+	CategoryLecture   = "lecture"
+)
+
+var Categories = []string{CategoryHackathon, CategoryWorkshop, CategoryMeetup, CategoryTrip, CategoryLecture}
+
 type Event struct {
 	gorm.Model
-	Name                string    `gorm:"not null" json:"name"`
-	StartDateTime       time.Time `gorm:"type:timestamp without time zone; not null" json:"start"`
-	EndDateTime         time.Time `gorm:"type:timestamp without time zone; not null" json:"end"`
-	Location            string    `gorm:"not null" json:"location"`
-	ApplicationDeadline time.Time `gorm:"type:date; not null" json:"deadline"`
-	Cover               string    `gorm:"not null" json:"cover"`
-	Description         string    `gorm:"not null" json:"desc"`
+	Name                string     `gorm:"not null" json:"name"`
+	StartDateTime       time.Time  `gorm:"type:timestamptz; not null" json:"start"`
+	EndDateTime         time.Time  `gorm:"type:timestamptz; not null" json:"end"`
+	Timezone            string     `gorm:"not null;default:Europe/Chisinau" json:"timezone"`
+	Location            string     `gorm:"not null" json:"location"`
+	ApplicationDeadline time.Time  `gorm:"type:timestamptz; not null" json:"deadline"`
+	Cover               string     `gorm:"not null" json:"cover"`
+	CoverMediaID        string     `gorm:"not null;default:''" json:"cover_media_id"`
+	Description         string     `gorm:"not null" json:"desc"`
+	MinTeamSize         int        `gorm:"not null;default:1" json:"min_team_size"`
+	MaxTeamSize         int        `gorm:"not null;default:1" json:"max_team_size"`
+	SeriesID            *uint      `gorm:"index" json:"series_id"`
+	CancelledAt         *time.Time `json:"cancelled_at"`
+	CancellationReason  string     `gorm:"not null;default:''" json:"cancellation_reason"`
+	Category            string     `gorm:"not null;default:'';index" json:"category"`
+	Tags                []Tag      `gorm:"many2many:event_tags" json:"tags"`
+	RoomID              *uint      `gorm:"index" json:"room_id"`
+	AllowGuests         bool       `gorm:"not null;default:false" json:"allow_guests"`
+}
+
+type Tag struct {
+	ID   uint   `gorm:"primarykey" json:"-"`
+	Name string `gorm:"not null;uniqueIndex" json:"name"`
 }
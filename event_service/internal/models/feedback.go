@@ -0,0 +1,32 @@
+package models
+
+import "gorm.io/gorm"
+
+const (
+	MinRating = 1
+	MaxRating = 5
+)
+
+type Feedback struct {
+	gorm.Model
+	EventID            uint         `gorm:"not null;index" json:"event_id"`
+	RegistrationID     uint         `gorm:"not null;uniqueIndex" json:"registration_id"`
+	UserID             uint         `gorm:"not null" json:"user_id"`
+	ContentRating      int          `gorm:"not null" json:"content_rating"`
+	OrganizationRating int          `gorm:"not null" json:"organization_rating"`
+	VenueRating        int          `gorm:"not null" json:"venue_rating"`
+	Comment            string       `gorm:"not null" json:"comment"`
+	Anonymous          bool         `gorm:"not null;default:false" json:"anonymous"`
+	Registration       Registration `json:"-"`
+}
+
+type FeedbackSummary struct {
+	EventID             uint    `json:"event_id"`
+	Responses           int64   `json:"responses"`
+	AvgContent          float64 `json:"avg_content"`
+	AvgOrganization     float64 `json:"avg_organization"`
+	AvgVenue            float64 `json:"avg_venue"`
+	ContentRatings      []Count `json:"content_ratings"`
+	OrganizationRatings []Count `json:"organization_ratings"`
+	VenueRatings        []Count `json:"venue_ratings"`
+}
@@ -0,0 +1,29 @@
+package models
+
+import "time"
+
+const (
+	DefaultPageSize = 20
+	MaxPageSize     = 100
+)
+
+type EventFilter struct {
+	Page     int
+	PageSize int
+	Cursor   string
+	From     time.Time
+	To       time.Time
+	When     string
+	Location string
+	Query    string
+	Category string
+	Tags     []string
+	Sort     string
+}
+
+type RegistrationFilter struct {
+	Page     int
+	PageSize int
+	Query    string
+	Status   string
+}
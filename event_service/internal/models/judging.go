@@ -0,0 +1,53 @@
+package models
+
+import "gorm.io/gorm"
+
+const DefaultCriterionMaxScore = 10
+
+// Submission is the project a team presents at the end of a hackathon. Each
+// team has at most one, which it can update until the event ends.
+type Submission struct {
+	gorm.Model
+	EventID     uint   `gorm:"not null;index" json:"event_id"`
+	TeamID      uint   `gorm:"not null;uniqueIndex" json:"team_id"`
+	Title       string `gorm:"not null" json:"title"`
+	Description string `gorm:"not null" json:"desc"`
+	RepoURL     string `gorm:"not null" json:"repo_url"`
+	DemoURL     string `gorm:"not null" json:"demo_url"`
+	SubmittedBy uint   `gorm:"not null" json:"submitted_by"`
+	ContentID   *uint  `json:"content_id"`
+	Team        *Team  `json:"team"`
+}
+
+type Judge struct {
+	gorm.Model
+	EventID uint `gorm:"not null;uniqueIndex:idx_judge_event_user" json:"event_id"`
+	UserID  uint `gorm:"not null;uniqueIndex:idx_judge_event_user" json:"user_id"`
+	AddedBy uint `gorm:"not null" json:"added_by"`
+}
+
+type Criterion struct {
+	gorm.Model
+	EventID     uint    `gorm:"not null;index" json:"event_id"`
+	Name        string  `gorm:"not null" json:"name"`
+	Description string  `gorm:"not null" json:"desc"`
+	Weight      float64 `gorm:"not null;default:1" json:"weight"`
+	MaxScore    int     `gorm:"not null;default:10" json:"max_score"`
+}
+
+type Score struct {
+	gorm.Model
+	SubmissionID uint   `gorm:"not null;uniqueIndex:idx_score_submission_judge_criterion" json:"submission_id"`
+	JudgeID      uint   `gorm:"not null;uniqueIndex:idx_score_submission_judge_criterion" json:"judge_id"`
+	CriterionID  uint   `gorm:"not null;uniqueIndex:idx_score_submission_judge_criterion;index" json:"criterion_id"`
+	Value        int    `gorm:"not null" json:"value"`
+	Comment      string `gorm:"not null;default:''" json:"comment"`
+}
+
+// LeaderboardEntry ranks a submission by its weighted score, from 0 to 100.
+type LeaderboardEntry struct {
+	Rank       int
+	Submission Submission
+	Score      float64
+	Judges     int
+}
@@ -0,0 +1,10 @@
+package models
+
+import "gorm.io/gorm"
+
+type EventOrganizer struct {
+	gorm.Model
+	EventID uint `gorm:"not null;uniqueIndex:idx_organizer_event_user" json:"event_id"`
+	UserID  uint `gorm:"not null;uniqueIndex:idx_organizer_event_user;index" json:"user_id"`
+	AddedBy uint `gorm:"not null" json:"added_by"`
+}
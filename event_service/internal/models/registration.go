@@ -1,20 +1,38 @@
 package models
 
-import "gorm.io/gorm"
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const (
+	RegistrationPending   = "pending"
+	RegistrationConfirmed = "confirmed"
+	RegistrationCancelled = "cancelled"
+)
+
+// GuestLinkTTL is how long guests have to confirm their registration through
+// the link emailed to them.
+const GuestLinkTTL = 48 * time.Hour
 
 type Registration struct {
 	gorm.Model
-	EventID         uint   `gorm:"not null" json:"event_id"`
-	UserID          uint   `gorm:"not null" json:"user_id"`
-	FirstName       string `gorm:"not null" json:"first_name"`
-	LastName        string `gorm:"not null" json:"last_name"`
-	Email           string `gorm:"not null" json:"email"`
-	PhoneNumber     int    `gorm:"not null" json:"phone_number"`
-	AcademicGroup   string `gorm:"not null" json:"academic_group"`
-	TeamMembers     string `gorm:"not null" json:"team_members"`
-	ShirtSize       string `gorm:"not null" json:"shirt_size"`
-	FoodPreferences string `gorm:"not null" json:"food_pref"`
-	Motivation      string `gorm:"not null" json:"motivation"`
-	Questions       string `gorm:"not null" json:"questions"`
-	Feedback        string `gorm:"not null" json:"feedback"`
+	EventID         uint       `gorm:"not null" json:"event_id"`
+	UserID          uint       `gorm:"not null" json:"user_id"`
+	FirstName       string     `gorm:"not null" json:"first_name"`
+	LastName        string     `gorm:"not null" json:"last_name"`
+	Email           string     `gorm:"not null" json:"email"`
+	PhoneNumber     int        `gorm:"not null" json:"phone_number"`
+	AcademicGroup   string     `gorm:"not null" json:"academic_group"`
+	TeamMembers     string     `gorm:"not null" json:"team_members"`
+	ShirtSize       string     `gorm:"not null" json:"shirt_size"`
+	FoodPreferences string     `gorm:"not null" json:"food_pref"`
+	Motivation      string     `gorm:"not null" json:"motivation"`
+	Questions       string     `gorm:"not null" json:"questions"`
+	Feedback        string     `gorm:"not null" json:"feedback"`
+	Status          string     `gorm:"not null;default:confirmed" json:"status"`
+	CheckedInAt     *time.Time `json:"checked_in_at"`
+	Guest           bool       `gorm:"not null;default:false" json:"guest"`
+	GuestToken      string     `gorm:"index" json:"-"`
 }
@@ -0,0 +1,16 @@
+package models
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// ReminderLog records that a reminder was sent for an event, so it isn't
+// sent again after a restart or by another replica.
+type ReminderLog struct {
+	gorm.Model
+	EventID uint      `gorm:"not null;uniqueIndex:idx_reminder_event_kind" json:"event_id"`
+	Kind    string    `gorm:"not null;uniqueIndex:idx_reminder_event_kind" json:"kind"`
+	SentAt  time.Time `gorm:"not null" json:"sent_at"`
+}
@@ -0,0 +1,19 @@
+package models
+
+type Count struct {
+	Value string `json:"value"`
+	Count int64  `json:"count"`
+}
+
+type EventReport struct {
+	EventID          uint    `json:"event_id"`
+	Registrations    int64   `json:"registrations"`
+	ByStatus         []Count `json:"by_status"`
+	ShirtSizes       []Count `json:"shirt_sizes"`
+	FoodPreferences  []Count `json:"food_preferences"`
+	AcademicGroups   []Count `json:"academic_groups"`
+	Teams            int64   `json:"teams"`
+	CompleteTeams    int64   `json:"complete_teams"`
+	TeamMembers      int64   `json:"team_members"`
+	SoloParticipants int64   `json:"solo_participants"`
+}
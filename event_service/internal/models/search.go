@@ -0,0 +1,27 @@
+package models
+
+// SearchConfigs maps the search languages to their Postgres text search
+// configurations. Every language has its own indexed tsvector column.
+var SearchConfigs = map[string]string{
+	"en": "english",
+	"ro": "romanian",
+}
+
+const (
+	DefaultSearchLanguage = "en"
+	MaxSearchTerms        = 10
+)
+
+type EventSearch struct {
+	Query    string
+	Language string
+	Page     int
+	PageSize int
+}
+
+type EventSearchResult struct {
+	Event         Event   `json:"event"`
+	Rank          float64 `json:"rank"`
+	NameHighlight string  `json:"name_highlight"`
+	DescHighlight string  `json:"desc_highlight"`
+}
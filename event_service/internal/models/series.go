@@ -0,0 +1,33 @@
+package models
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type EventSeries struct {
+	gorm.Model
+	Name         string  `gorm:"not null" json:"name"`
+	Description  string  `gorm:"not null" json:"desc"`
+	Location     string  `gorm:"not null" json:"location"`
+	Cover        string  `gorm:"not null" json:"cover"`
+	CoverMediaID string  `gorm:"not null;default:''" json:"cover_media_id"`
+	Timezone     string  `gorm:"not null;default:Europe/Chisinau" json:"timezone"`
+	RRule        string  `gorm:"not null" json:"rrule"`
+	Occurrences  []Event `gorm:"foreignKey:SeriesID" json:"occurrences"`
+}
+
+type OccurrenceAttendance struct {
+	EventID       uint       `json:"event_id"`
+	StartDateTime time.Time  `json:"start"`
+	CancelledAt   *time.Time `json:"cancelled_at"`
+	Registered    int64      `json:"registered"`
+	Attended      int64      `json:"attended"`
+}
+
+// OccurrenceTicket is the ticket of a registration made for an occurrence.
+type OccurrenceTicket struct {
+	EventID uint   `json:"event_id"`
+	Ticket  string `json:"ticket"`
+}
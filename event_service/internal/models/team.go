@@ -0,0 +1,35 @@
+package models
+
+import "gorm.io/gorm"
+
+const (
+	TeamMemberPending  = "pending"
+	TeamMemberAccepted = "accepted"
+	TeamMemberDeclined = "declined"
+)
+
+type Team struct {
+	gorm.Model
+	EventID   uint         `gorm:"not null" json:"event_id"`
+	Name      string       `gorm:"not null" json:"name"`
+	CaptainID uint         `gorm:"not null" json:"captain_id"`
+	Matched   bool         `gorm:"not null;default:false" json:"matched"`
+	Members   []TeamMember `json:"members"`
+	Complete  bool         `gorm:"-" json:"complete"`
+}
+
+type TeamMember struct {
+	gorm.Model
+	TeamID uint   `gorm:"not null" json:"team_id"`
+	UserID uint   `gorm:"not null" json:"user_id"`
+	Email  string `gorm:"not null" json:"email"`
+	Status string `gorm:"not null" json:"status"`
+}
+
+type MatchingProfile struct {
+	gorm.Model
+	EventID   uint   `gorm:"not null;uniqueIndex:idx_matching_event_user" json:"event_id"`
+	UserID    uint   `gorm:"not null;uniqueIndex:idx_matching_event_user" json:"user_id"`
+	Skills    string `gorm:"not null" json:"skills"`
+	Interests string `gorm:"not null" json:"interests"`
+}
@@ -0,0 +1,22 @@
+package models
+
+import "gorm.io/gorm"
+
+// EventTemplate keeps the details of a recurring kind of event. The times are
+// stored relative to the start, since a template has no date of its own.
+type EventTemplate struct {
+	gorm.Model
+	Name                  string `gorm:"not null;uniqueIndex" json:"name"`
+	EventName             string `gorm:"not null" json:"event_name"`
+	Description           string `gorm:"not null" json:"desc"`
+	Location              string `gorm:"not null" json:"location"`
+	Cover                 string `gorm:"not null" json:"cover"`
+	CoverMediaID          string `gorm:"not null;default:''" json:"cover_media_id"`
+	Timezone              string `gorm:"not null;default:Europe/Chisinau" json:"timezone"`
+	Category              string `gorm:"not null;default:''" json:"category"`
+	Tags                  string `gorm:"not null;default:''" json:"tags"`
+	MinTeamSize           int    `gorm:"not null;default:1" json:"min_team_size"`
+	MaxTeamSize           int    `gorm:"not null;default:1" json:"max_team_size"`
+	DurationMinutes       int    `gorm:"not null" json:"duration_minutes"`
+	DeadlineMinutesBefore int    `gorm:"not null;default:0" json:"deadline_minutes_before"`
+}
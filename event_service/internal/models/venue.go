@@ -0,0 +1,44 @@
+package models
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const (
+	DefaultAvailabilityRange = 7 * 24 * time.Hour
+	MaxAvailabilityRange     = 90 * 24 * time.Hour
+)
+
+// RoomBookingConstraint keeps events that aren't cancelled from being booked
+// in the same room at overlapping times.
+const RoomBookingConstraint = "events_room_booking"
+
+// Venue is a building with rooms that events can be booked in.
+type Venue struct {
+	gorm.Model
+	Name    string `gorm:"not null" json:"name"`
+	Address string `gorm:"not null" json:"address"`
+	Rooms   []Room `json:"rooms"`
+}
+
+type Room struct {
+	gorm.Model
+	VenueID   uint   `gorm:"not null;index" json:"venue_id"`
+	Name      string `gorm:"not null" json:"name"`
+	Capacity  int    `gorm:"not null;default:0" json:"capacity"`
+	Equipment string `gorm:"not null;default:''" json:"equipment"`
+	Venue     *Venue `json:"venue"`
+}
+
+type TimeSlot struct {
+	Start time.Time `json:"start"`
+	End   time.Time `json:"end"`
+}
+
+type RoomAvailability struct {
+	Room     Room
+	Bookings []Event
+	Free     []TimeSlot
+}
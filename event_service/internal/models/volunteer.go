@@ -0,0 +1,36 @@
+package models
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type VolunteerRole struct {
+	gorm.Model
+	EventID     uint   `gorm:"not null;index" json:"event_id"`
+	Name        string `gorm:"not null" json:"name"`
+	Description string `gorm:"not null" json:"desc"`
+}
+
+type VolunteerShift struct {
+	gorm.Model
+	EventID     uint              `gorm:"not null;index" json:"event_id"`
+	RoleID      uint              `gorm:"not null;index" json:"role_id"`
+	Description string            `gorm:"not null" json:"desc"`
+	StartTime   time.Time         `gorm:"type:timestamptz;not null" json:"start"`
+	EndTime     time.Time         `gorm:"type:timestamptz;not null" json:"end"`
+	Slots       int               `gorm:"not null;default:1" json:"slots"`
+	Role        *VolunteerRole    `json:"role"`
+	Signups     []VolunteerSignup `gorm:"foreignKey:ShiftID" json:"signups"`
+}
+
+type VolunteerSignup struct {
+	gorm.Model
+	ShiftID    uint            `gorm:"not null;uniqueIndex:idx_volunteer_shift_user" json:"shift_id"`
+	UserID     uint            `gorm:"not null;uniqueIndex:idx_volunteer_shift_user;index" json:"user_id"`
+	Name       string          `gorm:"not null" json:"name"`
+	Email      string          `gorm:"not null" json:"email"`
+	RemindedAt *time.Time      `json:"reminded_at"`
+	Shift      *VolunteerShift `json:"shift"`
+}
@@ -9,7 +9,6 @@ package pb
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
-	emptypb "google.golang.org/protobuf/types/known/emptypb"
 	reflect "reflect"
 )
 
@@ -25,110 +24,882 @@ var File_event_svc_proto protoreflect.FileDescriptor
 var file_event_svc_proto_rawDesc = []byte{
 	0x0a, 0x0f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x76, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74,
 	0x6f, 0x12, 0x05, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f,
-	0x6d, 0x73, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x32, 0x9d, 0x06, 0x0a, 0x0c, 0x45, 0x76, 0x65, 0x6e, 0x74,
-	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x44, 0x0a, 0x0b, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a,
-	0x09, 0x45, 0x64, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x17, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x2e, 0x45, 0x64, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x64, 0x69, 0x74,
-	0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a,
-	0x0b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x19, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12,
-	0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x3d, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x16, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65,
-	0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x53, 0x0a, 0x10, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x46, 0x6f, 0x72, 0x45, 0x76,
-	0x65, 0x6e, 0x74, 0x12, 0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x67, 0x69,
-	0x73, 0x74, 0x65, 0x72, 0x46, 0x6f, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x67, 0x69,
-	0x73, 0x74, 0x65, 0x72, 0x46, 0x6f, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x62, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74,
-	0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x23, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65,
-	0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x24, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x76,
-	0x65, 0x6e, 0x74, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6b, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x45,
-	0x76, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x26, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74,
-	0x45, 0x76, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65,
-	0x72, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72,
-	0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47,
-	0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x55,
-	0x73, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x53, 0x0a, 0x10, 0x45, 0x64, 0x69, 0x74, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x64,
-	0x69, 0x74, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x64,
-	0x69, 0x74, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x06, 0x5a, 0x04, 0x2e, 0x2f, 0x70, 0x62, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6d, 0x73, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x32, 0x81, 0x3d, 0x0a, 0x0c, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x44, 0x0a, 0x0b, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x3e, 0x0a, 0x09, 0x45, 0x64, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x17, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x64, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45,
+	0x64, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x44, 0x0a, 0x0b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12,
+	0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x0b, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x61,
+	0x6e, 0x63, 0x65, 0x6c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x08,
+	0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x09, 0x47, 0x65, 0x74,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47,
+	0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5f, 0x0a, 0x14, 0x47, 0x65, 0x74,
+	0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x73, 0x12, 0x22, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65,
+	0x74, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x10, 0x52, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x46, 0x6f, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1e,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x46,
+	0x6f, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x46,
+	0x6f, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x56, 0x0a, 0x11, 0x41, 0x64, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4f, 0x72, 0x67, 0x61, 0x6e,
+	0x69, 0x7a, 0x65, 0x72, 0x12, 0x1f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x64, 0x64,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x65, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x64,
+	0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x65, 0x72, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5f, 0x0a, 0x14, 0x52, 0x65, 0x6d, 0x6f, 0x76,
+	0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x65, 0x72, 0x12,
+	0x22, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x6d, 0x6f,
+	0x76, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x65, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x59, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x65, 0x72, 0x73, 0x12, 0x20,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4f,
+	0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x21, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a, 0x13, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x65, 0x72, 0x12, 0x21, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4f, 0x72, 0x67,
+	0x61, 0x6e, 0x69, 0x7a, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x59, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x65,
+	0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x20, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x47, 0x65, 0x74, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x65, 0x64, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x0e,
+	0x44, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1c,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a, 0x13, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61,
+	0x74, 0x65, 0x12, 0x21, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a, 0x11, 0x47, 0x65, 0x74,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x12, 0x1f,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54,
+	0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x20, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x5c, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x21, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x65, 0x6d, 0x70,
+	0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54,
+	0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x68, 0x0a, 0x17, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x46, 0x72,
+	0x6f, 0x6d, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x25, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x46, 0x72,
+	0x6f, 0x6d, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x26, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x46, 0x72, 0x6f, 0x6d, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x0b, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x56, 0x65, 0x6e, 0x75, 0x65, 0x12, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x65, 0x6e, 0x75, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x56, 0x65, 0x6e, 0x75, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x3e, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x56, 0x65, 0x6e, 0x75, 0x65, 0x73, 0x12, 0x17, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x65, 0x6e, 0x75, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65,
+	0x74, 0x56, 0x65, 0x6e, 0x75, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x44, 0x0a, 0x0b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x56, 0x65, 0x6e, 0x75, 0x65, 0x12, 0x19,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x56, 0x65, 0x6e,
+	0x75, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x56, 0x65, 0x6e, 0x75, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52,
+	0x6f, 0x6f, 0x6d, 0x12, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6f, 0x6d,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x08, 0x45, 0x64, 0x69, 0x74,
+	0x52, 0x6f, 0x6f, 0x6d, 0x12, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x64, 0x69,
+	0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x64, 0x69, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52,
+	0x6f, 0x6f, 0x6d, 0x12, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x6f, 0x6f, 0x6d,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x52,
+	0x6f, 0x6f, 0x6d, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12,
+	0x21, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x41,
+	0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x22, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x6f,
+	0x6f, 0x6d, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x56, 0x6f, 0x6c, 0x75, 0x6e, 0x74, 0x65, 0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x21, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x6f, 0x6c, 0x75,
+	0x6e, 0x74, 0x65, 0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x22, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56,
+	0x6f, 0x6c, 0x75, 0x6e, 0x74, 0x65, 0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x56, 0x6f,
+	0x6c, 0x75, 0x6e, 0x74, 0x65, 0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x21, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x56, 0x6f, 0x6c, 0x75, 0x6e, 0x74,
+	0x65, 0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x56, 0x6f, 0x6c,
+	0x75, 0x6e, 0x74, 0x65, 0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x5f, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x6f, 0x6c, 0x75,
+	0x6e, 0x74, 0x65, 0x65, 0x72, 0x53, 0x68, 0x69, 0x66, 0x74, 0x12, 0x22, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x6f, 0x6c, 0x75, 0x6e, 0x74, 0x65,
+	0x65, 0x72, 0x53, 0x68, 0x69, 0x66, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x6f, 0x6c,
+	0x75, 0x6e, 0x74, 0x65, 0x65, 0x72, 0x53, 0x68, 0x69, 0x66, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x59, 0x0a, 0x12, 0x45, 0x64, 0x69, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6e,
+	0x74, 0x65, 0x65, 0x72, 0x53, 0x68, 0x69, 0x66, 0x74, 0x12, 0x20, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x45, 0x64, 0x69, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6e, 0x74, 0x65, 0x65, 0x72, 0x53,
+	0x68, 0x69, 0x66, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x64, 0x69, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6e, 0x74, 0x65, 0x65,
+	0x72, 0x53, 0x68, 0x69, 0x66, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5f,
+	0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x56, 0x6f, 0x6c, 0x75, 0x6e, 0x74, 0x65, 0x65,
+	0x72, 0x53, 0x68, 0x69, 0x66, 0x74, 0x12, 0x22, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x56, 0x6f, 0x6c, 0x75, 0x6e, 0x74, 0x65, 0x65, 0x72, 0x53, 0x68,
+	0x69, 0x66, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x56, 0x6f, 0x6c, 0x75, 0x6e, 0x74, 0x65,
+	0x65, 0x72, 0x53, 0x68, 0x69, 0x66, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x59, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6e, 0x74, 0x65, 0x65, 0x72, 0x53,
+	0x68, 0x69, 0x66, 0x74, 0x73, 0x12, 0x20, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65,
+	0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6e, 0x74, 0x65, 0x65, 0x72, 0x53, 0x68, 0x69, 0x66, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x47, 0x65, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6e, 0x74, 0x65, 0x65, 0x72, 0x53, 0x68, 0x69, 0x66,
+	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x59, 0x0a, 0x12, 0x47, 0x65,
+	0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6e, 0x74, 0x65, 0x65, 0x72, 0x52, 0x6f, 0x73, 0x74, 0x65, 0x72,
+	0x12, 0x20, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x6f, 0x6c, 0x75,
+	0x6e, 0x74, 0x65, 0x65, 0x72, 0x52, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x21, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x6f,
+	0x6c, 0x75, 0x6e, 0x74, 0x65, 0x65, 0x72, 0x52, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x0e, 0x53, 0x69, 0x67, 0x6e, 0x55, 0x70, 0x46,
+	0x6f, 0x72, 0x53, 0x68, 0x69, 0x66, 0x74, 0x12, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x53, 0x69, 0x67, 0x6e, 0x55, 0x70, 0x46, 0x6f, 0x72, 0x53, 0x68, 0x69, 0x66, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x69,
+	0x67, 0x6e, 0x55, 0x70, 0x46, 0x6f, 0x72, 0x53, 0x68, 0x69, 0x66, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a, 0x11, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x68,
+	0x69, 0x66, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x75, 0x70, 0x12, 0x1f, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x68, 0x69, 0x66, 0x74, 0x53, 0x69, 0x67,
+	0x6e, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x68, 0x69, 0x66, 0x74, 0x53, 0x69,
+	0x67, 0x6e, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x0d,
+	0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x1b, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x50, 0x72, 0x6f, 0x6a,
+	0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x21, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x22, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5f, 0x0a, 0x14, 0x53, 0x65, 0x74, 0x53, 0x75, 0x62,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x22,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x65, 0x74, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x23, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x65, 0x74, 0x53, 0x75,
+	0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x08, 0x41, 0x64, 0x64, 0x4a, 0x75,
+	0x64, 0x67, 0x65, 0x12, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x64, 0x64, 0x4a,
+	0x75, 0x64, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x64, 0x64, 0x4a, 0x75, 0x64, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x0b, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4a, 0x75,
+	0x64, 0x67, 0x65, 0x12, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x6d, 0x6f,
+	0x76, 0x65, 0x4a, 0x75, 0x64, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4a, 0x75, 0x64,
+	0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x0e, 0x47, 0x65,
+	0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4a, 0x75, 0x64, 0x67, 0x65, 0x73, 0x12, 0x1c, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4a, 0x75, 0x64,
+	0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4a, 0x75, 0x64, 0x67, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x0f, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x72, 0x69, 0x74, 0x65,
+	0x72, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x0d, 0x45,
+	0x64, 0x69, 0x74, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x64, 0x69, 0x74, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x45, 0x64, 0x69, 0x74, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x0f, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x10, 0x47, 0x65, 0x74,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x12, 0x1e, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x43, 0x72,
+	0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x43, 0x72,
+	0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50,
+	0x0a, 0x0f, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x53,
+	0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x53, 0x75,
+	0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x4d, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61,
+	0x72, 0x64, 0x12, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x5f, 0x0a, 0x14, 0x53, 0x65, 0x74, 0x47, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x67, 0x69, 0x73,
+	0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x53, 0x65, 0x74, 0x47, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x65, 0x74, 0x47, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x67, 0x69,
+	0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x4a, 0x0a, 0x0d, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x47, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74,
+	0x65, 0x72, 0x47, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x47,
+	0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6b, 0x0a, 0x18,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x47, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x67, 0x69,
+	0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x26, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x47, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x67,
+	0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x27, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d,
+	0x47, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x68, 0x0a, 0x17, 0x43, 0x6c, 0x61,
+	0x69, 0x6d, 0x47, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x25, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6c, 0x61,
+	0x69, 0x6d, 0x47, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x47, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x5f, 0x0a, 0x14, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x4e, 0x65, 0x77,
+	0x73, 0x6c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x53, 0x65, 0x6e, 0x64, 0x12, 0x22, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x4e, 0x65, 0x77, 0x73, 0x6c, 0x65,
+	0x74, 0x74, 0x65, 0x72, 0x53, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x23, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x4e, 0x65,
+	0x77, 0x73, 0x6c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x53, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x41, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x12, 0x1f, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x74,
+	0x69, 0x63, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x41, 0x6e, 0x61, 0x6c, 0x79,
+	0x74, 0x69, 0x63, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5f, 0x0a, 0x14,
+	0x47, 0x65, 0x74, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x4f, 0x76, 0x65, 0x72,
+	0x76, 0x69, 0x65, 0x77, 0x12, 0x22, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74,
+	0x41, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x4f, 0x76, 0x65, 0x72, 0x76, 0x69, 0x65,
+	0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x47, 0x65, 0x74, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x4f, 0x76, 0x65,
+	0x72, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a,
+	0x0c, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1a, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x62, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x23, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6b, 0x0a, 0x18, 0x45, 0x78,
+	0x70, 0x6f, 0x72, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x26, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45,
+	0x78, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x0e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74,
+	0x46, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x46, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53,
+	0x75, 0x62, 0x6d, 0x69, 0x74, 0x46, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x46, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x46, 0x65, 0x65, 0x64, 0x62, 0x61,
+	0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x46, 0x65, 0x65, 0x64, 0x62, 0x61,
+	0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a, 0x11, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x12,
+	0x1f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x20, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x4d, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x65,
+	0x72, 0x69, 0x65, 0x73, 0x12, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x53, 0x0a, 0x10, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4f, 0x63, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x61,
+	0x6e, 0x63, 0x65, 0x6c, 0x4f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x61,
+	0x6e, 0x63, 0x65, 0x6c, 0x4f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a, 0x11, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74,
+	0x65, 0x72, 0x46, 0x6f, 0x72, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x12, 0x1f, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x46, 0x6f, 0x72, 0x53,
+	0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x46, 0x6f, 0x72,
+	0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c,
+	0x0a, 0x13, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x41, 0x74, 0x74, 0x65, 0x6e,
+	0x64, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x21, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65,
+	0x74, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x41, 0x74, 0x74, 0x65, 0x6e, 0x64, 0x61, 0x6e, 0x63,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x41, 0x74, 0x74, 0x65, 0x6e, 0x64,
+	0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x0d,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x70, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x12, 0x1b, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x70, 0x65, 0x61,
+	0x6b, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x70, 0x65, 0x61, 0x6b, 0x65, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x0b, 0x45, 0x64, 0x69, 0x74,
+	0x53, 0x70, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x12, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x45, 0x64, 0x69, 0x74, 0x53, 0x70, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x64, 0x69, 0x74, 0x53,
+	0x70, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a,
+	0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x70, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x12,
+	0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x70,
+	0x65, 0x61, 0x6b, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x70, 0x65, 0x61, 0x6b,
+	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x0d, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x0b, 0x45, 0x64, 0x69, 0x74, 0x53, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x64,
+	0x69, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x64, 0x69, 0x74, 0x53, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x0d,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x41, 0x67, 0x65, 0x6e, 0x64, 0x61, 0x12, 0x1c, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x41, 0x67, 0x65, 0x6e, 0x64,
+	0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x41, 0x67, 0x65, 0x6e, 0x64, 0x61, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x0f, 0x42, 0x6f, 0x6f, 0x6b, 0x6d,
+	0x61, 0x72, 0x6b, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x42, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x53, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x42, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x0e, 0x52, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x42, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x12, 0x1c, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x42, 0x6f, 0x6f, 0x6b, 0x6d, 0x61,
+	0x72, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x42, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x55,
+	0x73, 0x65, 0x72, 0x41, 0x67, 0x65, 0x6e, 0x64, 0x61, 0x12, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x41, 0x67, 0x65, 0x6e, 0x64, 0x61, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47,
+	0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x41, 0x67, 0x65, 0x6e, 0x64, 0x61, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6b, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x26, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x67,
+	0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x4a, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x73, 0x12, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73,
+	0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a,
+	0x10, 0x45, 0x64, 0x69, 0x74, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x64, 0x69, 0x74, 0x52, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x64, 0x69, 0x74, 0x52, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x3e, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x12,
+	0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x69, 0x63, 0x6b, 0x65,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x47, 0x65, 0x74, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x38, 0x0a, 0x07, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x49, 0x6e, 0x12, 0x15, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x49, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x68, 0x65,
+	0x63, 0x6b, 0x49, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a, 0x11,
+	0x49, 0x73, 0x73, 0x75, 0x65, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65,
+	0x73, 0x12, 0x1f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x49, 0x73, 0x73, 0x75, 0x65, 0x43,
+	0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x20, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x49, 0x73, 0x73, 0x75, 0x65,
+	0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x59, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x43,
+	0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x12, 0x20, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x43, 0x65, 0x72, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x4d, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x65, 0x12, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x65, 0x72,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x65, 0x72, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56,
+	0x0a, 0x11, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x65, 0x12, 0x1f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x56, 0x65, 0x72, 0x69,
+	0x66, 0x79, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x56, 0x65, 0x72,
+	0x69, 0x66, 0x79, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x54, 0x65, 0x61, 0x6d, 0x12, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x54, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x65, 0x61,
+	0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x10, 0x49, 0x6e, 0x76,
+	0x69, 0x74, 0x65, 0x54, 0x65, 0x61, 0x6d, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1e, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x54, 0x65, 0x61, 0x6d,
+	0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x54, 0x65, 0x61, 0x6d,
+	0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56,
+	0x0a, 0x11, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x54, 0x65, 0x61, 0x6d, 0x49, 0x6e, 0x76,
+	0x69, 0x74, 0x65, 0x12, 0x1f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x64, 0x54, 0x65, 0x61, 0x6d, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x64, 0x54, 0x65, 0x61, 0x6d, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a, 0x13, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66,
+	0x65, 0x72, 0x54, 0x65, 0x61, 0x6d, 0x43, 0x61, 0x70, 0x74, 0x61, 0x69, 0x6e, 0x12, 0x21, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x54, 0x65,
+	0x61, 0x6d, 0x43, 0x61, 0x70, 0x74, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x22, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65,
+	0x72, 0x54, 0x65, 0x61, 0x6d, 0x43, 0x61, 0x70, 0x74, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x0b, 0x44, 0x69, 0x73, 0x62, 0x61, 0x6e, 0x64, 0x54,
+	0x65, 0x61, 0x6d, 0x12, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x69, 0x73, 0x62,
+	0x61, 0x6e, 0x64, 0x54, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x69, 0x73, 0x62, 0x61, 0x6e, 0x64, 0x54, 0x65,
+	0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x0b, 0x47, 0x65,
+	0x74, 0x55, 0x73, 0x65, 0x72, 0x54, 0x65, 0x61, 0x6d, 0x12, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x54, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74,
+	0x55, 0x73, 0x65, 0x72, 0x54, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x59, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x54, 0x65, 0x61, 0x6d, 0x49,
+	0x6e, 0x76, 0x69, 0x74, 0x65, 0x73, 0x12, 0x20, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47,
+	0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x54, 0x65, 0x61, 0x6d, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x54, 0x65, 0x61, 0x6d, 0x49, 0x6e, 0x76, 0x69,
+	0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x0d, 0x47,
+	0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x65, 0x61, 0x6d, 0x73, 0x12, 0x1b, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x65, 0x61,
+	0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x65, 0x61, 0x6d, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a, 0x11, 0x4f, 0x70, 0x74, 0x49, 0x6e,
+	0x54, 0x65, 0x61, 0x6d, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x12, 0x1f, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4f, 0x70, 0x74, 0x49, 0x6e, 0x54, 0x65, 0x61, 0x6d, 0x4d, 0x61,
+	0x74, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4f, 0x70, 0x74, 0x49, 0x6e, 0x54, 0x65, 0x61, 0x6d, 0x4d,
+	0x61, 0x74, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x59, 0x0a, 0x12, 0x4f, 0x70, 0x74, 0x4f, 0x75, 0x74, 0x54, 0x65, 0x61, 0x6d, 0x4d, 0x61, 0x74,
+	0x63, 0x68, 0x69, 0x6e, 0x67, 0x12, 0x20, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4f, 0x70,
+	0x74, 0x4f, 0x75, 0x74, 0x54, 0x65, 0x61, 0x6d, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x69, 0x6e, 0x67,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x4f, 0x70, 0x74, 0x4f, 0x75, 0x74, 0x54, 0x65, 0x61, 0x6d, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x69,
+	0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x0a, 0x4d, 0x61,
+	0x74, 0x63, 0x68, 0x54, 0x65, 0x61, 0x6d, 0x73, 0x12, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x54, 0x65, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68,
+	0x54, 0x65, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x06, 0x5a,
+	0x04, 0x2e, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var file_event_svc_proto_goTypes = []interface{}{
 	(*CreateEventRequest)(nil),               // 0: proto.CreateEventRequest
 	(*EditEventRequest)(nil),                 // 1: proto.EditEventRequest
 	(*DeleteEventRequest)(nil),               // 2: proto.DeleteEventRequest
-	(*GetEventRequest)(nil),                  // 3: proto.GetEventRequest
-	(*emptypb.Empty)(nil),                    // 4: google.protobuf.Empty
-	(*RegisterForEventRequest)(nil),          // 5: proto.RegisterForEventRequest
-	(*GetEventRegistrationsRequest)(nil),     // 6: proto.GetEventRegistrationsRequest
-	(*GetEventUserRegistrationRequest)(nil),  // 7: proto.GetEventUserRegistrationRequest
-	(*GetUserEventsRequest)(nil),             // 8: proto.GetUserEventsRequest
-	(*EditRegistrationRequest)(nil),          // 9: proto.EditRegistrationRequest
-	(*CreateEventResponse)(nil),              // 10: proto.CreateEventResponse
-	(*EditEventResponse)(nil),                // 11: proto.EditEventResponse
-	(*DeleteEventResponse)(nil),              // 12: proto.DeleteEventResponse
-	(*GetEventResponse)(nil),                 // 13: proto.GetEventResponse
-	(*GetEventsResponse)(nil),                // 14: proto.GetEventsResponse
-	(*RegisterForEventResponse)(nil),         // 15: proto.RegisterForEventResponse
-	(*GetEventRegistrationsResponse)(nil),    // 16: proto.GetEventRegistrationsResponse
-	(*GetEventUserRegistrationResponse)(nil), // 17: proto.GetEventUserRegistrationResponse
-	(*GetUserEventsResponse)(nil),            // 18: proto.GetUserEventsResponse
-	(*EditRegistrationResponse)(nil),         // 19: proto.EditRegistrationResponse
+	(*CancelEventRequest)(nil),               // 3: proto.CancelEventRequest
+	(*GetEventRequest)(nil),                  // 4: proto.GetEventRequest
+	(*GetEventsRequest)(nil),                 // 5: proto.GetEventsRequest
+	(*GetRecommendedEventsRequest)(nil),      // 6: proto.GetRecommendedEventsRequest
+	(*RegisterForEventRequest)(nil),          // 7: proto.RegisterForEventRequest
+	(*AddEventOrganizerRequest)(nil),         // 8: proto.AddEventOrganizerRequest
+	(*RemoveEventOrganizerRequest)(nil),      // 9: proto.RemoveEventOrganizerRequest
+	(*GetEventOrganizersRequest)(nil),        // 10: proto.GetEventOrganizersRequest
+	(*CheckEventOrganizerRequest)(nil),       // 11: proto.CheckEventOrganizerRequest
+	(*GetOrganizedEventsRequest)(nil),        // 12: proto.GetOrganizedEventsRequest
+	(*DuplicateEventRequest)(nil),            // 13: proto.DuplicateEventRequest
+	(*CreateEventTemplateRequest)(nil),       // 14: proto.CreateEventTemplateRequest
+	(*GetEventTemplatesRequest)(nil),         // 15: proto.GetEventTemplatesRequest
+	(*DeleteEventTemplateRequest)(nil),       // 16: proto.DeleteEventTemplateRequest
+	(*CreateEventFromTemplateRequest)(nil),   // 17: proto.CreateEventFromTemplateRequest
+	(*CreateVenueRequest)(nil),               // 18: proto.CreateVenueRequest
+	(*GetVenuesRequest)(nil),                 // 19: proto.GetVenuesRequest
+	(*DeleteVenueRequest)(nil),               // 20: proto.DeleteVenueRequest
+	(*CreateRoomRequest)(nil),                // 21: proto.CreateRoomRequest
+	(*EditRoomRequest)(nil),                  // 22: proto.EditRoomRequest
+	(*DeleteRoomRequest)(nil),                // 23: proto.DeleteRoomRequest
+	(*GetRoomAvailabilityRequest)(nil),       // 24: proto.GetRoomAvailabilityRequest
+	(*CreateVolunteerRoleRequest)(nil),       // 25: proto.CreateVolunteerRoleRequest
+	(*DeleteVolunteerRoleRequest)(nil),       // 26: proto.DeleteVolunteerRoleRequest
+	(*CreateVolunteerShiftRequest)(nil),      // 27: proto.CreateVolunteerShiftRequest
+	(*EditVolunteerShiftRequest)(nil),        // 28: proto.EditVolunteerShiftRequest
+	(*DeleteVolunteerShiftRequest)(nil),      // 29: proto.DeleteVolunteerShiftRequest
+	(*GetVolunteerShiftsRequest)(nil),        // 30: proto.GetVolunteerShiftsRequest
+	(*GetVolunteerRosterRequest)(nil),        // 31: proto.GetVolunteerRosterRequest
+	(*SignUpForShiftRequest)(nil),            // 32: proto.SignUpForShiftRequest
+	(*CancelShiftSignupRequest)(nil),         // 33: proto.CancelShiftSignupRequest
+	(*SubmitProjectRequest)(nil),             // 34: proto.SubmitProjectRequest
+	(*GetEventSubmissionsRequest)(nil),       // 35: proto.GetEventSubmissionsRequest
+	(*SetSubmissionContentRequest)(nil),      // 36: proto.SetSubmissionContentRequest
+	(*AddJudgeRequest)(nil),                  // 37: proto.AddJudgeRequest
+	(*RemoveJudgeRequest)(nil),               // 38: proto.RemoveJudgeRequest
+	(*GetEventJudgesRequest)(nil),            // 39: proto.GetEventJudgesRequest
+	(*CreateCriterionRequest)(nil),           // 40: proto.CreateCriterionRequest
+	(*EditCriterionRequest)(nil),             // 41: proto.EditCriterionRequest
+	(*DeleteCriterionRequest)(nil),           // 42: proto.DeleteCriterionRequest
+	(*GetEventCriteriaRequest)(nil),          // 43: proto.GetEventCriteriaRequest
+	(*ScoreSubmissionRequest)(nil),           // 44: proto.ScoreSubmissionRequest
+	(*GetLeaderboardRequest)(nil),            // 45: proto.GetLeaderboardRequest
+	(*SetGuestRegistrationRequest)(nil),      // 46: proto.SetGuestRegistrationRequest
+	(*RegisterGuestRequest)(nil),             // 47: proto.RegisterGuestRequest
+	(*ConfirmGuestRegistrationRequest)(nil),  // 48: proto.ConfirmGuestRegistrationRequest
+	(*ClaimGuestRegistrationsRequest)(nil),   // 49: proto.ClaimGuestRegistrationsRequest
+	(*RecordNewsletterSendRequest)(nil),      // 50: proto.RecordNewsletterSendRequest
+	(*GetEventAnalyticsRequest)(nil),         // 51: proto.GetEventAnalyticsRequest
+	(*GetAnalyticsOverviewRequest)(nil),      // 52: proto.GetAnalyticsOverviewRequest
+	(*SearchEventsRequest)(nil),              // 53: proto.SearchEventsRequest
+	(*GetEventRegistrationsRequest)(nil),     // 54: proto.GetEventRegistrationsRequest
+	(*ExportEventRegistrationsRequest)(nil),  // 55: proto.ExportEventRegistrationsRequest
+	(*GetEventReportRequest)(nil),            // 56: proto.GetEventReportRequest
+	(*SubmitFeedbackRequest)(nil),            // 57: proto.SubmitFeedbackRequest
+	(*GetEventFeedbackRequest)(nil),          // 58: proto.GetEventFeedbackRequest
+	(*CreateEventSeriesRequest)(nil),         // 59: proto.CreateEventSeriesRequest
+	(*GetEventSeriesRequest)(nil),            // 60: proto.GetEventSeriesRequest
+	(*CancelOccurrenceRequest)(nil),          // 61: proto.CancelOccurrenceRequest
+	(*RegisterForSeriesRequest)(nil),         // 62: proto.RegisterForSeriesRequest
+	(*GetSeriesAttendanceRequest)(nil),       // 63: proto.GetSeriesAttendanceRequest
+	(*CreateSpeakerRequest)(nil),             // 64: proto.CreateSpeakerRequest
+	(*EditSpeakerRequest)(nil),               // 65: proto.EditSpeakerRequest
+	(*DeleteSpeakerRequest)(nil),             // 66: proto.DeleteSpeakerRequest
+	(*CreateSessionRequest)(nil),             // 67: proto.CreateSessionRequest
+	(*EditSessionRequest)(nil),               // 68: proto.EditSessionRequest
+	(*DeleteSessionRequest)(nil),             // 69: proto.DeleteSessionRequest
+	(*GetEventAgendaRequest)(nil),            // 70: proto.GetEventAgendaRequest
+	(*BookmarkSessionRequest)(nil),           // 71: proto.BookmarkSessionRequest
+	(*RemoveBookmarkRequest)(nil),            // 72: proto.RemoveBookmarkRequest
+	(*GetUserAgendaRequest)(nil),             // 73: proto.GetUserAgendaRequest
+	(*GetEventUserRegistrationRequest)(nil),  // 74: proto.GetEventUserRegistrationRequest
+	(*GetUserEventsRequest)(nil),             // 75: proto.GetUserEventsRequest
+	(*EditRegistrationRequest)(nil),          // 76: proto.EditRegistrationRequest
+	(*GetTicketRequest)(nil),                 // 77: proto.GetTicketRequest
+	(*CheckInRequest)(nil),                   // 78: proto.CheckInRequest
+	(*IssueCertificatesRequest)(nil),         // 79: proto.IssueCertificatesRequest
+	(*GetUserCertificateRequest)(nil),        // 80: proto.GetUserCertificateRequest
+	(*GetCertificateRequest)(nil),            // 81: proto.GetCertificateRequest
+	(*VerifyCertificateRequest)(nil),         // 82: proto.VerifyCertificateRequest
+	(*CreateTeamRequest)(nil),                // 83: proto.CreateTeamRequest
+	(*InviteTeamMemberRequest)(nil),          // 84: proto.InviteTeamMemberRequest
+	(*RespondTeamInviteRequest)(nil),         // 85: proto.RespondTeamInviteRequest
+	(*TransferTeamCaptainRequest)(nil),       // 86: proto.TransferTeamCaptainRequest
+	(*DisbandTeamRequest)(nil),               // 87: proto.DisbandTeamRequest
+	(*GetUserTeamRequest)(nil),               // 88: proto.GetUserTeamRequest
+	(*GetUserTeamInvitesRequest)(nil),        // 89: proto.GetUserTeamInvitesRequest
+	(*GetEventTeamsRequest)(nil),             // 90: proto.GetEventTeamsRequest
+	(*OptInTeamMatchingRequest)(nil),         // 91: proto.OptInTeamMatchingRequest
+	(*OptOutTeamMatchingRequest)(nil),        // 92: proto.OptOutTeamMatchingRequest
+	(*MatchTeamsRequest)(nil),                // 93: proto.MatchTeamsRequest
+	(*CreateEventResponse)(nil),              // 94: proto.CreateEventResponse
+	(*EditEventResponse)(nil),                // 95: proto.EditEventResponse
+	(*DeleteEventResponse)(nil),              // 96: proto.DeleteEventResponse
+	(*CancelEventResponse)(nil),              // 97: proto.CancelEventResponse
+	(*GetEventResponse)(nil),                 // 98: proto.GetEventResponse
+	(*GetEventsResponse)(nil),                // 99: proto.GetEventsResponse
+	(*GetRecommendedEventsResponse)(nil),     // 100: proto.GetRecommendedEventsResponse
+	(*RegisterForEventResponse)(nil),         // 101: proto.RegisterForEventResponse
+	(*AddEventOrganizerResponse)(nil),        // 102: proto.AddEventOrganizerResponse
+	(*RemoveEventOrganizerResponse)(nil),     // 103: proto.RemoveEventOrganizerResponse
+	(*GetEventOrganizersResponse)(nil),       // 104: proto.GetEventOrganizersResponse
+	(*CheckEventOrganizerResponse)(nil),      // 105: proto.CheckEventOrganizerResponse
+	(*GetOrganizedEventsResponse)(nil),       // 106: proto.GetOrganizedEventsResponse
+	(*DuplicateEventResponse)(nil),           // 107: proto.DuplicateEventResponse
+	(*CreateEventTemplateResponse)(nil),      // 108: proto.CreateEventTemplateResponse
+	(*GetEventTemplatesResponse)(nil),        // 109: proto.GetEventTemplatesResponse
+	(*DeleteEventTemplateResponse)(nil),      // 110: proto.DeleteEventTemplateResponse
+	(*CreateEventFromTemplateResponse)(nil),  // 111: proto.CreateEventFromTemplateResponse
+	(*CreateVenueResponse)(nil),              // 112: proto.CreateVenueResponse
+	(*GetVenuesResponse)(nil),                // 113: proto.GetVenuesResponse
+	(*DeleteVenueResponse)(nil),              // 114: proto.DeleteVenueResponse
+	(*CreateRoomResponse)(nil),               // 115: proto.CreateRoomResponse
+	(*EditRoomResponse)(nil),                 // 116: proto.EditRoomResponse
+	(*DeleteRoomResponse)(nil),               // 117: proto.DeleteRoomResponse
+	(*GetRoomAvailabilityResponse)(nil),      // 118: proto.GetRoomAvailabilityResponse
+	(*CreateVolunteerRoleResponse)(nil),      // 119: proto.CreateVolunteerRoleResponse
+	(*DeleteVolunteerRoleResponse)(nil),      // 120: proto.DeleteVolunteerRoleResponse
+	(*CreateVolunteerShiftResponse)(nil),     // 121: proto.CreateVolunteerShiftResponse
+	(*EditVolunteerShiftResponse)(nil),       // 122: proto.EditVolunteerShiftResponse
+	(*DeleteVolunteerShiftResponse)(nil),     // 123: proto.DeleteVolunteerShiftResponse
+	(*GetVolunteerShiftsResponse)(nil),       // 124: proto.GetVolunteerShiftsResponse
+	(*GetVolunteerRosterResponse)(nil),       // 125: proto.GetVolunteerRosterResponse
+	(*SignUpForShiftResponse)(nil),           // 126: proto.SignUpForShiftResponse
+	(*CancelShiftSignupResponse)(nil),        // 127: proto.CancelShiftSignupResponse
+	(*SubmitProjectResponse)(nil),            // 128: proto.SubmitProjectResponse
+	(*GetEventSubmissionsResponse)(nil),      // 129: proto.GetEventSubmissionsResponse
+	(*SetSubmissionContentResponse)(nil),     // 130: proto.SetSubmissionContentResponse
+	(*AddJudgeResponse)(nil),                 // 131: proto.AddJudgeResponse
+	(*RemoveJudgeResponse)(nil),              // 132: proto.RemoveJudgeResponse
+	(*GetEventJudgesResponse)(nil),           // 133: proto.GetEventJudgesResponse
+	(*CreateCriterionResponse)(nil),          // 134: proto.CreateCriterionResponse
+	(*EditCriterionResponse)(nil),            // 135: proto.EditCriterionResponse
+	(*DeleteCriterionResponse)(nil),          // 136: proto.DeleteCriterionResponse
+	(*GetEventCriteriaResponse)(nil),         // 137: proto.GetEventCriteriaResponse
+	(*ScoreSubmissionResponse)(nil),          // 138: proto.ScoreSubmissionResponse
+	(*GetLeaderboardResponse)(nil),           // 139: proto.GetLeaderboardResponse
+	(*SetGuestRegistrationResponse)(nil),     // 140: proto.SetGuestRegistrationResponse
+	(*RegisterGuestResponse)(nil),            // 141: proto.RegisterGuestResponse
+	(*ConfirmGuestRegistrationResponse)(nil), // 142: proto.ConfirmGuestRegistrationResponse
+	(*ClaimGuestRegistrationsResponse)(nil),  // 143: proto.ClaimGuestRegistrationsResponse
+	(*RecordNewsletterSendResponse)(nil),     // 144: proto.RecordNewsletterSendResponse
+	(*GetEventAnalyticsResponse)(nil),        // 145: proto.GetEventAnalyticsResponse
+	(*GetAnalyticsOverviewResponse)(nil),     // 146: proto.GetAnalyticsOverviewResponse
+	(*SearchEventsResponse)(nil),             // 147: proto.SearchEventsResponse
+	(*GetEventRegistrationsResponse)(nil),    // 148: proto.GetEventRegistrationsResponse
+	(*ExportEventRegistrationsResponse)(nil), // 149: proto.ExportEventRegistrationsResponse
+	(*GetEventReportResponse)(nil),           // 150: proto.GetEventReportResponse
+	(*SubmitFeedbackResponse)(nil),           // 151: proto.SubmitFeedbackResponse
+	(*GetEventFeedbackResponse)(nil),         // 152: proto.GetEventFeedbackResponse
+	(*CreateEventSeriesResponse)(nil),        // 153: proto.CreateEventSeriesResponse
+	(*GetEventSeriesResponse)(nil),           // 154: proto.GetEventSeriesResponse
+	(*CancelOccurrenceResponse)(nil),         // 155: proto.CancelOccurrenceResponse
+	(*RegisterForSeriesResponse)(nil),        // 156: proto.RegisterForSeriesResponse
+	(*GetSeriesAttendanceResponse)(nil),      // 157: proto.GetSeriesAttendanceResponse
+	(*CreateSpeakerResponse)(nil),            // 158: proto.CreateSpeakerResponse
+	(*EditSpeakerResponse)(nil),              // 159: proto.EditSpeakerResponse
+	(*DeleteSpeakerResponse)(nil),            // 160: proto.DeleteSpeakerResponse
+	(*CreateSessionResponse)(nil),            // 161: proto.CreateSessionResponse
+	(*EditSessionResponse)(nil),              // 162: proto.EditSessionResponse
+	(*DeleteSessionResponse)(nil),            // 163: proto.DeleteSessionResponse
+	(*GetEventAgendaResponse)(nil),           // 164: proto.GetEventAgendaResponse
+	(*BookmarkSessionResponse)(nil),          // 165: proto.BookmarkSessionResponse
+	(*RemoveBookmarkResponse)(nil),           // 166: proto.RemoveBookmarkResponse
+	(*GetUserAgendaResponse)(nil),            // 167: proto.GetUserAgendaResponse
+	(*GetEventUserRegistrationResponse)(nil), // 168: proto.GetEventUserRegistrationResponse
+	(*GetUserEventsResponse)(nil),            // 169: proto.GetUserEventsResponse
+	(*EditRegistrationResponse)(nil),         // 170: proto.EditRegistrationResponse
+	(*GetTicketResponse)(nil),                // 171: proto.GetTicketResponse
+	(*CheckInResponse)(nil),                  // 172: proto.CheckInResponse
+	(*IssueCertificatesResponse)(nil),        // 173: proto.IssueCertificatesResponse
+	(*GetUserCertificateResponse)(nil),       // 174: proto.GetUserCertificateResponse
+	(*GetCertificateResponse)(nil),           // 175: proto.GetCertificateResponse
+	(*VerifyCertificateResponse)(nil),        // 176: proto.VerifyCertificateResponse
+	(*CreateTeamResponse)(nil),               // 177: proto.CreateTeamResponse
+	(*InviteTeamMemberResponse)(nil),         // 178: proto.InviteTeamMemberResponse
+	(*RespondTeamInviteResponse)(nil),        // 179: proto.RespondTeamInviteResponse
+	(*TransferTeamCaptainResponse)(nil),      // 180: proto.TransferTeamCaptainResponse
+	(*DisbandTeamResponse)(nil),              // 181: proto.DisbandTeamResponse
+	(*GetUserTeamResponse)(nil),              // 182: proto.GetUserTeamResponse
+	(*GetUserTeamInvitesResponse)(nil),       // 183: proto.GetUserTeamInvitesResponse
+	(*GetEventTeamsResponse)(nil),            // 184: proto.GetEventTeamsResponse
+	(*OptInTeamMatchingResponse)(nil),        // 185: proto.OptInTeamMatchingResponse
+	(*OptOutTeamMatchingResponse)(nil),       // 186: proto.OptOutTeamMatchingResponse
+	(*MatchTeamsResponse)(nil),               // 187: proto.MatchTeamsResponse
 }
 var file_event_svc_proto_depIdxs = []int32{
-	0,  // 0: proto.EventService.CreateEvent:input_type -> proto.CreateEventRequest
-	1,  // 1: proto.EventService.EditEvent:input_type -> proto.EditEventRequest
-	2,  // 2: proto.EventService.DeleteEvent:input_type -> proto.DeleteEventRequest
-	3,  // 3: proto.EventService.GetEvent:input_type -> proto.GetEventRequest
-	4,  // 4: proto.EventService.GetEvents:input_type -> google.protobuf.Empty
-	5,  // 5: proto.EventService.RegisterForEvent:input_type -> proto.RegisterForEventRequest
-	6,  // 6: proto.EventService.GetEventRegistrations:input_type -> proto.GetEventRegistrationsRequest
-	7,  // 7: proto.EventService.GetEventUserRegistration:input_type -> proto.GetEventUserRegistrationRequest
-	8,  // 8: proto.EventService.GetUserEvents:input_type -> proto.GetUserEventsRequest
-	9,  // 9: proto.EventService.EditRegistration:input_type -> proto.EditRegistrationRequest
-	10, // 10: proto.EventService.CreateEvent:output_type -> proto.CreateEventResponse
-	11, // 11: proto.EventService.EditEvent:output_type -> proto.EditEventResponse
-	12, // 12: proto.EventService.DeleteEvent:output_type -> proto.DeleteEventResponse
-	13, // 13: proto.EventService.GetEvent:output_type -> proto.GetEventResponse
-	14, // 14: proto.EventService.GetEvents:output_type -> proto.GetEventsResponse
-	15, // 15: proto.EventService.RegisterForEvent:output_type -> proto.RegisterForEventResponse
-	16, // 16: proto.EventService.GetEventRegistrations:output_type -> proto.GetEventRegistrationsResponse
-	17, // 17: proto.EventService.GetEventUserRegistration:output_type -> proto.GetEventUserRegistrationResponse
-	18, // 18: proto.EventService.GetUserEvents:output_type -> proto.GetUserEventsResponse
-	19, // 19: proto.EventService.EditRegistration:output_type -> proto.EditRegistrationResponse
-	10, // [10:20] is the sub-list for method output_type
-	0,  // [0:10] is the sub-list for method input_type
-	0,  // [0:0] is the sub-list for extension type_name
-	0,  // [0:0] is the sub-list for extension extendee
-	0,  // [0:0] is the sub-list for field type_name
+	0,   // 0: proto.EventService.CreateEvent:input_type -> proto.CreateEventRequest
+	1,   // 1: proto.EventService.EditEvent:input_type -> proto.EditEventRequest
+	2,   // 2: proto.EventService.DeleteEvent:input_type -> proto.DeleteEventRequest
+	3,   // 3: proto.EventService.CancelEvent:input_type -> proto.CancelEventRequest
+	4,   // 4: proto.EventService.GetEvent:input_type -> proto.GetEventRequest
+	5,   // 5: proto.EventService.GetEvents:input_type -> proto.GetEventsRequest
+	6,   // 6: proto.EventService.GetRecommendedEvents:input_type -> proto.GetRecommendedEventsRequest
+	7,   // 7: proto.EventService.RegisterForEvent:input_type -> proto.RegisterForEventRequest
+	8,   // 8: proto.EventService.AddEventOrganizer:input_type -> proto.AddEventOrganizerRequest
+	9,   // 9: proto.EventService.RemoveEventOrganizer:input_type -> proto.RemoveEventOrganizerRequest
+	10,  // 10: proto.EventService.GetEventOrganizers:input_type -> proto.GetEventOrganizersRequest
+	11,  // 11: proto.EventService.CheckEventOrganizer:input_type -> proto.CheckEventOrganizerRequest
+	12,  // 12: proto.EventService.GetOrganizedEvents:input_type -> proto.GetOrganizedEventsRequest
+	13,  // 13: proto.EventService.DuplicateEvent:input_type -> proto.DuplicateEventRequest
+	14,  // 14: proto.EventService.CreateEventTemplate:input_type -> proto.CreateEventTemplateRequest
+	15,  // 15: proto.EventService.GetEventTemplates:input_type -> proto.GetEventTemplatesRequest
+	16,  // 16: proto.EventService.DeleteEventTemplate:input_type -> proto.DeleteEventTemplateRequest
+	17,  // 17: proto.EventService.CreateEventFromTemplate:input_type -> proto.CreateEventFromTemplateRequest
+	18,  // 18: proto.EventService.CreateVenue:input_type -> proto.CreateVenueRequest
+	19,  // 19: proto.EventService.GetVenues:input_type -> proto.GetVenuesRequest
+	20,  // 20: proto.EventService.DeleteVenue:input_type -> proto.DeleteVenueRequest
+	21,  // 21: proto.EventService.CreateRoom:input_type -> proto.CreateRoomRequest
+	22,  // 22: proto.EventService.EditRoom:input_type -> proto.EditRoomRequest
+	23,  // 23: proto.EventService.DeleteRoom:input_type -> proto.DeleteRoomRequest
+	24,  // 24: proto.EventService.GetRoomAvailability:input_type -> proto.GetRoomAvailabilityRequest
+	25,  // 25: proto.EventService.CreateVolunteerRole:input_type -> proto.CreateVolunteerRoleRequest
+	26,  // 26: proto.EventService.DeleteVolunteerRole:input_type -> proto.DeleteVolunteerRoleRequest
+	27,  // 27: proto.EventService.CreateVolunteerShift:input_type -> proto.CreateVolunteerShiftRequest
+	28,  // 28: proto.EventService.EditVolunteerShift:input_type -> proto.EditVolunteerShiftRequest
+	29,  // 29: proto.EventService.DeleteVolunteerShift:input_type -> proto.DeleteVolunteerShiftRequest
+	30,  // 30: proto.EventService.GetVolunteerShifts:input_type -> proto.GetVolunteerShiftsRequest
+	31,  // 31: proto.EventService.GetVolunteerRoster:input_type -> proto.GetVolunteerRosterRequest
+	32,  // 32: proto.EventService.SignUpForShift:input_type -> proto.SignUpForShiftRequest
+	33,  // 33: proto.EventService.CancelShiftSignup:input_type -> proto.CancelShiftSignupRequest
+	34,  // 34: proto.EventService.SubmitProject:input_type -> proto.SubmitProjectRequest
+	35,  // 35: proto.EventService.GetEventSubmissions:input_type -> proto.GetEventSubmissionsRequest
+	36,  // 36: proto.EventService.SetSubmissionContent:input_type -> proto.SetSubmissionContentRequest
+	37,  // 37: proto.EventService.AddJudge:input_type -> proto.AddJudgeRequest
+	38,  // 38: proto.EventService.RemoveJudge:input_type -> proto.RemoveJudgeRequest
+	39,  // 39: proto.EventService.GetEventJudges:input_type -> proto.GetEventJudgesRequest
+	40,  // 40: proto.EventService.CreateCriterion:input_type -> proto.CreateCriterionRequest
+	41,  // 41: proto.EventService.EditCriterion:input_type -> proto.EditCriterionRequest
+	42,  // 42: proto.EventService.DeleteCriterion:input_type -> proto.DeleteCriterionRequest
+	43,  // 43: proto.EventService.GetEventCriteria:input_type -> proto.GetEventCriteriaRequest
+	44,  // 44: proto.EventService.ScoreSubmission:input_type -> proto.ScoreSubmissionRequest
+	45,  // 45: proto.EventService.GetLeaderboard:input_type -> proto.GetLeaderboardRequest
+	46,  // 46: proto.EventService.SetGuestRegistration:input_type -> proto.SetGuestRegistrationRequest
+	47,  // 47: proto.EventService.RegisterGuest:input_type -> proto.RegisterGuestRequest
+	48,  // 48: proto.EventService.ConfirmGuestRegistration:input_type -> proto.ConfirmGuestRegistrationRequest
+	49,  // 49: proto.EventService.ClaimGuestRegistrations:input_type -> proto.ClaimGuestRegistrationsRequest
+	50,  // 50: proto.EventService.RecordNewsletterSend:input_type -> proto.RecordNewsletterSendRequest
+	51,  // 51: proto.EventService.GetEventAnalytics:input_type -> proto.GetEventAnalyticsRequest
+	52,  // 52: proto.EventService.GetAnalyticsOverview:input_type -> proto.GetAnalyticsOverviewRequest
+	53,  // 53: proto.EventService.SearchEvents:input_type -> proto.SearchEventsRequest
+	54,  // 54: proto.EventService.GetEventRegistrations:input_type -> proto.GetEventRegistrationsRequest
+	55,  // 55: proto.EventService.ExportEventRegistrations:input_type -> proto.ExportEventRegistrationsRequest
+	56,  // 56: proto.EventService.GetEventReport:input_type -> proto.GetEventReportRequest
+	57,  // 57: proto.EventService.SubmitFeedback:input_type -> proto.SubmitFeedbackRequest
+	58,  // 58: proto.EventService.GetEventFeedback:input_type -> proto.GetEventFeedbackRequest
+	59,  // 59: proto.EventService.CreateEventSeries:input_type -> proto.CreateEventSeriesRequest
+	60,  // 60: proto.EventService.GetEventSeries:input_type -> proto.GetEventSeriesRequest
+	61,  // 61: proto.EventService.CancelOccurrence:input_type -> proto.CancelOccurrenceRequest
+	62,  // 62: proto.EventService.RegisterForSeries:input_type -> proto.RegisterForSeriesRequest
+	63,  // 63: proto.EventService.GetSeriesAttendance:input_type -> proto.GetSeriesAttendanceRequest
+	64,  // 64: proto.EventService.CreateSpeaker:input_type -> proto.CreateSpeakerRequest
+	65,  // 65: proto.EventService.EditSpeaker:input_type -> proto.EditSpeakerRequest
+	66,  // 66: proto.EventService.DeleteSpeaker:input_type -> proto.DeleteSpeakerRequest
+	67,  // 67: proto.EventService.CreateSession:input_type -> proto.CreateSessionRequest
+	68,  // 68: proto.EventService.EditSession:input_type -> proto.EditSessionRequest
+	69,  // 69: proto.EventService.DeleteSession:input_type -> proto.DeleteSessionRequest
+	70,  // 70: proto.EventService.GetEventAgenda:input_type -> proto.GetEventAgendaRequest
+	71,  // 71: proto.EventService.BookmarkSession:input_type -> proto.BookmarkSessionRequest
+	72,  // 72: proto.EventService.RemoveBookmark:input_type -> proto.RemoveBookmarkRequest
+	73,  // 73: proto.EventService.GetUserAgenda:input_type -> proto.GetUserAgendaRequest
+	74,  // 74: proto.EventService.GetEventUserRegistration:input_type -> proto.GetEventUserRegistrationRequest
+	75,  // 75: proto.EventService.GetUserEvents:input_type -> proto.GetUserEventsRequest
+	76,  // 76: proto.EventService.EditRegistration:input_type -> proto.EditRegistrationRequest
+	77,  // 77: proto.EventService.GetTicket:input_type -> proto.GetTicketRequest
+	78,  // 78: proto.EventService.CheckIn:input_type -> proto.CheckInRequest
+	79,  // 79: proto.EventService.IssueCertificates:input_type -> proto.IssueCertificatesRequest
+	80,  // 80: proto.EventService.GetUserCertificate:input_type -> proto.GetUserCertificateRequest
+	81,  // 81: proto.EventService.GetCertificate:input_type -> proto.GetCertificateRequest
+	82,  // 82: proto.EventService.VerifyCertificate:input_type -> proto.VerifyCertificateRequest
+	83,  // 83: proto.EventService.CreateTeam:input_type -> proto.CreateTeamRequest
+	84,  // 84: proto.EventService.InviteTeamMember:input_type -> proto.InviteTeamMemberRequest
+	85,  // 85: proto.EventService.RespondTeamInvite:input_type -> proto.RespondTeamInviteRequest
+	86,  // 86: proto.EventService.TransferTeamCaptain:input_type -> proto.TransferTeamCaptainRequest
+	87,  // 87: proto.EventService.DisbandTeam:input_type -> proto.DisbandTeamRequest
+	88,  // 88: proto.EventService.GetUserTeam:input_type -> proto.GetUserTeamRequest
+	89,  // 89: proto.EventService.GetUserTeamInvites:input_type -> proto.GetUserTeamInvitesRequest
+	90,  // 90: proto.EventService.GetEventTeams:input_type -> proto.GetEventTeamsRequest
+	91,  // 91: proto.EventService.OptInTeamMatching:input_type -> proto.OptInTeamMatchingRequest
+	92,  // 92: proto.EventService.OptOutTeamMatching:input_type -> proto.OptOutTeamMatchingRequest
+	93,  // 93: proto.EventService.MatchTeams:input_type -> proto.MatchTeamsRequest
+	94,  // 94: proto.EventService.CreateEvent:output_type -> proto.CreateEventResponse
+	95,  // 95: proto.EventService.EditEvent:output_type -> proto.EditEventResponse
+	96,  // 96: proto.EventService.DeleteEvent:output_type -> proto.DeleteEventResponse
+	97,  // 97: proto.EventService.CancelEvent:output_type -> proto.CancelEventResponse
+	98,  // 98: proto.EventService.GetEvent:output_type -> proto.GetEventResponse
+	99,  // 99: proto.EventService.GetEvents:output_type -> proto.GetEventsResponse
+	100, // 100: proto.EventService.GetRecommendedEvents:output_type -> proto.GetRecommendedEventsResponse
+	101, // 101: proto.EventService.RegisterForEvent:output_type -> proto.RegisterForEventResponse
+	102, // 102: proto.EventService.AddEventOrganizer:output_type -> proto.AddEventOrganizerResponse
+	103, // 103: proto.EventService.RemoveEventOrganizer:output_type -> proto.RemoveEventOrganizerResponse
+	104, // 104: proto.EventService.GetEventOrganizers:output_type -> proto.GetEventOrganizersResponse
+	105, // 105: proto.EventService.CheckEventOrganizer:output_type -> proto.CheckEventOrganizerResponse
+	106, // 106: proto.EventService.GetOrganizedEvents:output_type -> proto.GetOrganizedEventsResponse
+	107, // 107: proto.EventService.DuplicateEvent:output_type -> proto.DuplicateEventResponse
+	108, // 108: proto.EventService.CreateEventTemplate:output_type -> proto.CreateEventTemplateResponse
+	109, // 109: proto.EventService.GetEventTemplates:output_type -> proto.GetEventTemplatesResponse
+	110, // 110: proto.EventService.DeleteEventTemplate:output_type -> proto.DeleteEventTemplateResponse
+	111, // 111: proto.EventService.CreateEventFromTemplate:output_type -> proto.CreateEventFromTemplateResponse
+	112, // 112: proto.EventService.CreateVenue:output_type -> proto.CreateVenueResponse
+	113, // 113: proto.EventService.GetVenues:output_type -> proto.GetVenuesResponse
+	114, // 114: proto.EventService.DeleteVenue:output_type -> proto.DeleteVenueResponse
+	115, // 115: proto.EventService.CreateRoom:output_type -> proto.CreateRoomResponse
+	116, // 116: proto.EventService.EditRoom:output_type -> proto.EditRoomResponse
+	117, // 117: proto.EventService.DeleteRoom:output_type -> proto.DeleteRoomResponse
+	118, // 118: proto.EventService.GetRoomAvailability:output_type -> proto.GetRoomAvailabilityResponse
+	119, // 119: proto.EventService.CreateVolunteerRole:output_type -> proto.CreateVolunteerRoleResponse
+	120, // 120: proto.EventService.DeleteVolunteerRole:output_type -> proto.DeleteVolunteerRoleResponse
+	121, // 121: proto.EventService.CreateVolunteerShift:output_type -> proto.CreateVolunteerShiftResponse
+	122, // 122: proto.EventService.EditVolunteerShift:output_type -> proto.EditVolunteerShiftResponse
+	123, // 123: proto.EventService.DeleteVolunteerShift:output_type -> proto.DeleteVolunteerShiftResponse
+	124, // 124: proto.EventService.GetVolunteerShifts:output_type -> proto.GetVolunteerShiftsResponse
+	125, // 125: proto.EventService.GetVolunteerRoster:output_type -> proto.GetVolunteerRosterResponse
+	126, // 126: proto.EventService.SignUpForShift:output_type -> proto.SignUpForShiftResponse
+	127, // 127: proto.EventService.CancelShiftSignup:output_type -> proto.CancelShiftSignupResponse
+	128, // 128: proto.EventService.SubmitProject:output_type -> proto.SubmitProjectResponse
+	129, // 129: proto.EventService.GetEventSubmissions:output_type -> proto.GetEventSubmissionsResponse
+	130, // 130: proto.EventService.SetSubmissionContent:output_type -> proto.SetSubmissionContentResponse
+	131, // 131: proto.EventService.AddJudge:output_type -> proto.AddJudgeResponse
+	132, // 132: proto.EventService.RemoveJudge:output_type -> proto.RemoveJudgeResponse
+	133, // 133: proto.EventService.GetEventJudges:output_type -> proto.GetEventJudgesResponse
+	134, // 134: proto.EventService.CreateCriterion:output_type -> proto.CreateCriterionResponse
+	135, // 135: proto.EventService.EditCriterion:output_type -> proto.EditCriterionResponse
+	136, // 136: proto.EventService.DeleteCriterion:output_type -> proto.DeleteCriterionResponse
+	137, // 137: proto.EventService.GetEventCriteria:output_type -> proto.GetEventCriteriaResponse
+	138, // 138: proto.EventService.ScoreSubmission:output_type -> proto.ScoreSubmissionResponse
+	139, // 139: proto.EventService.GetLeaderboard:output_type -> proto.GetLeaderboardResponse
+	140, // 140: proto.EventService.SetGuestRegistration:output_type -> proto.SetGuestRegistrationResponse
+	141, // 141: proto.EventService.RegisterGuest:output_type -> proto.RegisterGuestResponse
+	142, // 142: proto.EventService.ConfirmGuestRegistration:output_type -> proto.ConfirmGuestRegistrationResponse
+	143, // 143: proto.EventService.ClaimGuestRegistrations:output_type -> proto.ClaimGuestRegistrationsResponse
+	144, // 144: proto.EventService.RecordNewsletterSend:output_type -> proto.RecordNewsletterSendResponse
+	145, // 145: proto.EventService.GetEventAnalytics:output_type -> proto.GetEventAnalyticsResponse
+	146, // 146: proto.EventService.GetAnalyticsOverview:output_type -> proto.GetAnalyticsOverviewResponse
+	147, // 147: proto.EventService.SearchEvents:output_type -> proto.SearchEventsResponse
+	148, // 148: proto.EventService.GetEventRegistrations:output_type -> proto.GetEventRegistrationsResponse
+	149, // 149: proto.EventService.ExportEventRegistrations:output_type -> proto.ExportEventRegistrationsResponse
+	150, // 150: proto.EventService.GetEventReport:output_type -> proto.GetEventReportResponse
+	151, // 151: proto.EventService.SubmitFeedback:output_type -> proto.SubmitFeedbackResponse
+	152, // 152: proto.EventService.GetEventFeedback:output_type -> proto.GetEventFeedbackResponse
+	153, // 153: proto.EventService.CreateEventSeries:output_type -> proto.CreateEventSeriesResponse
+	154, // 154: proto.EventService.GetEventSeries:output_type -> proto.GetEventSeriesResponse
+	155, // 155: proto.EventService.CancelOccurrence:output_type -> proto.CancelOccurrenceResponse
+	156, // 156: proto.EventService.RegisterForSeries:output_type -> proto.RegisterForSeriesResponse
+	157, // 157: proto.EventService.GetSeriesAttendance:output_type -> proto.GetSeriesAttendanceResponse
+	158, // 158: proto.EventService.CreateSpeaker:output_type -> proto.CreateSpeakerResponse
+	159, // 159: proto.EventService.EditSpeaker:output_type -> proto.EditSpeakerResponse
+	160, // 160: proto.EventService.DeleteSpeaker:output_type -> proto.DeleteSpeakerResponse
+	161, // 161: proto.EventService.CreateSession:output_type -> proto.CreateSessionResponse
+	162, // 162: proto.EventService.EditSession:output_type -> proto.EditSessionResponse
+	163, // 163: proto.EventService.DeleteSession:output_type -> proto.DeleteSessionResponse
+	164, // 164: proto.EventService.GetEventAgenda:output_type -> proto.GetEventAgendaResponse
+	165, // 165: proto.EventService.BookmarkSession:output_type -> proto.BookmarkSessionResponse
+	166, // 166: proto.EventService.RemoveBookmark:output_type -> proto.RemoveBookmarkResponse
+	167, // 167: proto.EventService.GetUserAgenda:output_type -> proto.GetUserAgendaResponse
+	168, // 168: proto.EventService.GetEventUserRegistration:output_type -> proto.GetEventUserRegistrationResponse
+	169, // 169: proto.EventService.GetUserEvents:output_type -> proto.GetUserEventsResponse
+	170, // 170: proto.EventService.EditRegistration:output_type -> proto.EditRegistrationResponse
+	171, // 171: proto.EventService.GetTicket:output_type -> proto.GetTicketResponse
+	172, // 172: proto.EventService.CheckIn:output_type -> proto.CheckInResponse
+	173, // 173: proto.EventService.IssueCertificates:output_type -> proto.IssueCertificatesResponse
+	174, // 174: proto.EventService.GetUserCertificate:output_type -> proto.GetUserCertificateResponse
+	175, // 175: proto.EventService.GetCertificate:output_type -> proto.GetCertificateResponse
+	176, // 176: proto.EventService.VerifyCertificate:output_type -> proto.VerifyCertificateResponse
+	177, // 177: proto.EventService.CreateTeam:output_type -> proto.CreateTeamResponse
+	178, // 178: proto.EventService.InviteTeamMember:output_type -> proto.InviteTeamMemberResponse
+	179, // 179: proto.EventService.RespondTeamInvite:output_type -> proto.RespondTeamInviteResponse
+	180, // 180: proto.EventService.TransferTeamCaptain:output_type -> proto.TransferTeamCaptainResponse
+	181, // 181: proto.EventService.DisbandTeam:output_type -> proto.DisbandTeamResponse
+	182, // 182: proto.EventService.GetUserTeam:output_type -> proto.GetUserTeamResponse
+	183, // 183: proto.EventService.GetUserTeamInvites:output_type -> proto.GetUserTeamInvitesResponse
+	184, // 184: proto.EventService.GetEventTeams:output_type -> proto.GetEventTeamsResponse
+	185, // 185: proto.EventService.OptInTeamMatching:output_type -> proto.OptInTeamMatchingResponse
+	186, // 186: proto.EventService.OptOutTeamMatching:output_type -> proto.OptOutTeamMatchingResponse
+	187, // 187: proto.EventService.MatchTeams:output_type -> proto.MatchTeamsResponse
+	94,  // [94:188] is the sub-list for method output_type
+	0,   // [0:94] is the sub-list for method input_type
+	0,   // [0:0] is the sub-list for extension type_name
+	0,   // [0:0] is the sub-list for extension extendee
+	0,   // [0:0] is the sub-list for field type_name
 }
 
 func init() { file_event_svc_proto_init() }
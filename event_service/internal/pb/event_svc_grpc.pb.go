@@ -11,7 +11,6 @@ import (
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
-	emptypb "google.golang.org/protobuf/types/known/emptypb"
 )
 
 // This is a compile-time assertion to ensure that this generated file
@@ -23,13 +22,97 @@ const (
 	EventService_CreateEvent_FullMethodName              = "/proto.EventService/CreateEvent"
 	EventService_EditEvent_FullMethodName                = "/proto.EventService/EditEvent"
 	EventService_DeleteEvent_FullMethodName              = "/proto.EventService/DeleteEvent"
+	EventService_CancelEvent_FullMethodName              = "/proto.EventService/CancelEvent"
 	EventService_GetEvent_FullMethodName                 = "/proto.EventService/GetEvent"
 	EventService_GetEvents_FullMethodName                = "/proto.EventService/GetEvents"
+	EventService_GetRecommendedEvents_FullMethodName     = "/proto.EventService/GetRecommendedEvents"
 	EventService_RegisterForEvent_FullMethodName         = "/proto.EventService/RegisterForEvent"
+	EventService_AddEventOrganizer_FullMethodName        = "/proto.EventService/AddEventOrganizer"
+	EventService_RemoveEventOrganizer_FullMethodName     = "/proto.EventService/RemoveEventOrganizer"
+	EventService_GetEventOrganizers_FullMethodName       = "/proto.EventService/GetEventOrganizers"
+	EventService_CheckEventOrganizer_FullMethodName      = "/proto.EventService/CheckEventOrganizer"
+	EventService_GetOrganizedEvents_FullMethodName       = "/proto.EventService/GetOrganizedEvents"
+	EventService_DuplicateEvent_FullMethodName           = "/proto.EventService/DuplicateEvent"
+	EventService_CreateEventTemplate_FullMethodName      = "/proto.EventService/CreateEventTemplate"
+	EventService_GetEventTemplates_FullMethodName        = "/proto.EventService/GetEventTemplates"
+	EventService_DeleteEventTemplate_FullMethodName      = "/proto.EventService/DeleteEventTemplate"
+	EventService_CreateEventFromTemplate_FullMethodName  = "/proto.EventService/CreateEventFromTemplate"
+	EventService_CreateVenue_FullMethodName              = "/proto.EventService/CreateVenue"
+	EventService_GetVenues_FullMethodName                = "/proto.EventService/GetVenues"
+	EventService_DeleteVenue_FullMethodName              = "/proto.EventService/DeleteVenue"
+	EventService_CreateRoom_FullMethodName               = "/proto.EventService/CreateRoom"
+	EventService_EditRoom_FullMethodName                 = "/proto.EventService/EditRoom"
+	EventService_DeleteRoom_FullMethodName               = "/proto.EventService/DeleteRoom"
+	EventService_GetRoomAvailability_FullMethodName      = "/proto.EventService/GetRoomAvailability"
+	EventService_CreateVolunteerRole_FullMethodName      = "/proto.EventService/CreateVolunteerRole"
+	EventService_DeleteVolunteerRole_FullMethodName      = "/proto.EventService/DeleteVolunteerRole"
+	EventService_CreateVolunteerShift_FullMethodName     = "/proto.EventService/CreateVolunteerShift"
+	EventService_EditVolunteerShift_FullMethodName       = "/proto.EventService/EditVolunteerShift"
+	EventService_DeleteVolunteerShift_FullMethodName     = "/proto.EventService/DeleteVolunteerShift"
+	EventService_GetVolunteerShifts_FullMethodName       = "/proto.EventService/GetVolunteerShifts"
+	EventService_GetVolunteerRoster_FullMethodName       = "/proto.EventService/GetVolunteerRoster"
+	EventService_SignUpForShift_FullMethodName           = "/proto.EventService/SignUpForShift"
+	EventService_CancelShiftSignup_FullMethodName        = "/proto.EventService/CancelShiftSignup"
+	EventService_SubmitProject_FullMethodName            = "/proto.EventService/SubmitProject"
+	EventService_GetEventSubmissions_FullMethodName      = "/proto.EventService/GetEventSubmissions"
+	EventService_SetSubmissionContent_FullMethodName     = "/proto.EventService/SetSubmissionContent"
+	EventService_AddJudge_FullMethodName                 = "/proto.EventService/AddJudge"
+	EventService_RemoveJudge_FullMethodName              = "/proto.EventService/RemoveJudge"
+	EventService_GetEventJudges_FullMethodName           = "/proto.EventService/GetEventJudges"
+	EventService_CreateCriterion_FullMethodName          = "/proto.EventService/CreateCriterion"
+	EventService_EditCriterion_FullMethodName            = "/proto.EventService/EditCriterion"
+	EventService_DeleteCriterion_FullMethodName          = "/proto.EventService/DeleteCriterion"
+	EventService_GetEventCriteria_FullMethodName         = "/proto.EventService/GetEventCriteria"
+	EventService_ScoreSubmission_FullMethodName          = "/proto.EventService/ScoreSubmission"
+	EventService_GetLeaderboard_FullMethodName           = "/proto.EventService/GetLeaderboard"
+	EventService_SetGuestRegistration_FullMethodName     = "/proto.EventService/SetGuestRegistration"
+	EventService_RegisterGuest_FullMethodName            = "/proto.EventService/RegisterGuest"
+	EventService_ConfirmGuestRegistration_FullMethodName = "/proto.EventService/ConfirmGuestRegistration"
+	EventService_ClaimGuestRegistrations_FullMethodName  = "/proto.EventService/ClaimGuestRegistrations"
+	EventService_RecordNewsletterSend_FullMethodName     = "/proto.EventService/RecordNewsletterSend"
+	EventService_GetEventAnalytics_FullMethodName        = "/proto.EventService/GetEventAnalytics"
+	EventService_GetAnalyticsOverview_FullMethodName     = "/proto.EventService/GetAnalyticsOverview"
+	EventService_SearchEvents_FullMethodName             = "/proto.EventService/SearchEvents"
 	EventService_GetEventRegistrations_FullMethodName    = "/proto.EventService/GetEventRegistrations"
+	EventService_ExportEventRegistrations_FullMethodName = "/proto.EventService/ExportEventRegistrations"
+	EventService_GetEventReport_FullMethodName           = "/proto.EventService/GetEventReport"
+	EventService_SubmitFeedback_FullMethodName           = "/proto.EventService/SubmitFeedback"
+	EventService_GetEventFeedback_FullMethodName         = "/proto.EventService/GetEventFeedback"
+	EventService_CreateEventSeries_FullMethodName        = "/proto.EventService/CreateEventSeries"
+	EventService_GetEventSeries_FullMethodName           = "/proto.EventService/GetEventSeries"
+	EventService_CancelOccurrence_FullMethodName         = "/proto.EventService/CancelOccurrence"
+	EventService_RegisterForSeries_FullMethodName        = "/proto.EventService/RegisterForSeries"
+	EventService_GetSeriesAttendance_FullMethodName      = "/proto.EventService/GetSeriesAttendance"
+	EventService_CreateSpeaker_FullMethodName            = "/proto.EventService/CreateSpeaker"
+	EventService_EditSpeaker_FullMethodName              = "/proto.EventService/EditSpeaker"
+	EventService_DeleteSpeaker_FullMethodName            = "/proto.EventService/DeleteSpeaker"
+	EventService_CreateSession_FullMethodName            = "/proto.EventService/CreateSession"
+	EventService_EditSession_FullMethodName              = "/proto.EventService/EditSession"
+	EventService_DeleteSession_FullMethodName            = "/proto.EventService/DeleteSession"
+	EventService_GetEventAgenda_FullMethodName           = "/proto.EventService/GetEventAgenda"
+	EventService_BookmarkSession_FullMethodName          = "/proto.EventService/BookmarkSession"
+	EventService_RemoveBookmark_FullMethodName           = "/proto.EventService/RemoveBookmark"
+	EventService_GetUserAgenda_FullMethodName            = "/proto.EventService/GetUserAgenda"
 	EventService_GetEventUserRegistration_FullMethodName = "/proto.EventService/GetEventUserRegistration"
 	EventService_GetUserEvents_FullMethodName            = "/proto.EventService/GetUserEvents"
 	EventService_EditRegistration_FullMethodName         = "/proto.EventService/EditRegistration"
+	EventService_GetTicket_FullMethodName                = "/proto.EventService/GetTicket"
+	EventService_CheckIn_FullMethodName                  = "/proto.EventService/CheckIn"
+	EventService_IssueCertificates_FullMethodName        = "/proto.EventService/IssueCertificates"
+	EventService_GetUserCertificate_FullMethodName       = "/proto.EventService/GetUserCertificate"
+	EventService_GetCertificate_FullMethodName           = "/proto.EventService/GetCertificate"
+	EventService_VerifyCertificate_FullMethodName        = "/proto.EventService/VerifyCertificate"
+	EventService_CreateTeam_FullMethodName               = "/proto.EventService/CreateTeam"
+	EventService_InviteTeamMember_FullMethodName         = "/proto.EventService/InviteTeamMember"
+	EventService_RespondTeamInvite_FullMethodName        = "/proto.EventService/RespondTeamInvite"
+	EventService_TransferTeamCaptain_FullMethodName      = "/proto.EventService/TransferTeamCaptain"
+	EventService_DisbandTeam_FullMethodName              = "/proto.EventService/DisbandTeam"
+	EventService_GetUserTeam_FullMethodName              = "/proto.EventService/GetUserTeam"
+	EventService_GetUserTeamInvites_FullMethodName       = "/proto.EventService/GetUserTeamInvites"
+	EventService_GetEventTeams_FullMethodName            = "/proto.EventService/GetEventTeams"
+	EventService_OptInTeamMatching_FullMethodName        = "/proto.EventService/OptInTeamMatching"
+	EventService_OptOutTeamMatching_FullMethodName       = "/proto.EventService/OptOutTeamMatching"
+	EventService_MatchTeams_FullMethodName               = "/proto.EventService/MatchTeams"
 )
 
 // EventServiceClient is the client API for EventService service.
@@ -39,13 +122,97 @@ type EventServiceClient interface {
 	CreateEvent(ctx context.Context, in *CreateEventRequest, opts ...grpc.CallOption) (*CreateEventResponse, error)
 	EditEvent(ctx context.Context, in *EditEventRequest, opts ...grpc.CallOption) (*EditEventResponse, error)
 	DeleteEvent(ctx context.Context, in *DeleteEventRequest, opts ...grpc.CallOption) (*DeleteEventResponse, error)
+	CancelEvent(ctx context.Context, in *CancelEventRequest, opts ...grpc.CallOption) (*CancelEventResponse, error)
 	GetEvent(ctx context.Context, in *GetEventRequest, opts ...grpc.CallOption) (*GetEventResponse, error)
-	GetEvents(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetEventsResponse, error)
+	GetEvents(ctx context.Context, in *GetEventsRequest, opts ...grpc.CallOption) (*GetEventsResponse, error)
+	GetRecommendedEvents(ctx context.Context, in *GetRecommendedEventsRequest, opts ...grpc.CallOption) (*GetRecommendedEventsResponse, error)
 	RegisterForEvent(ctx context.Context, in *RegisterForEventRequest, opts ...grpc.CallOption) (*RegisterForEventResponse, error)
+	AddEventOrganizer(ctx context.Context, in *AddEventOrganizerRequest, opts ...grpc.CallOption) (*AddEventOrganizerResponse, error)
+	RemoveEventOrganizer(ctx context.Context, in *RemoveEventOrganizerRequest, opts ...grpc.CallOption) (*RemoveEventOrganizerResponse, error)
+	GetEventOrganizers(ctx context.Context, in *GetEventOrganizersRequest, opts ...grpc.CallOption) (*GetEventOrganizersResponse, error)
+	CheckEventOrganizer(ctx context.Context, in *CheckEventOrganizerRequest, opts ...grpc.CallOption) (*CheckEventOrganizerResponse, error)
+	GetOrganizedEvents(ctx context.Context, in *GetOrganizedEventsRequest, opts ...grpc.CallOption) (*GetOrganizedEventsResponse, error)
+	DuplicateEvent(ctx context.Context, in *DuplicateEventRequest, opts ...grpc.CallOption) (*DuplicateEventResponse, error)
+	CreateEventTemplate(ctx context.Context, in *CreateEventTemplateRequest, opts ...grpc.CallOption) (*CreateEventTemplateResponse, error)
+	GetEventTemplates(ctx context.Context, in *GetEventTemplatesRequest, opts ...grpc.CallOption) (*GetEventTemplatesResponse, error)
+	DeleteEventTemplate(ctx context.Context, in *DeleteEventTemplateRequest, opts ...grpc.CallOption) (*DeleteEventTemplateResponse, error)
+	CreateEventFromTemplate(ctx context.Context, in *CreateEventFromTemplateRequest, opts ...grpc.CallOption) (*CreateEventFromTemplateResponse, error)
+	CreateVenue(ctx context.Context, in *CreateVenueRequest, opts ...grpc.CallOption) (*CreateVenueResponse, error)
+	GetVenues(ctx context.Context, in *GetVenuesRequest, opts ...grpc.CallOption) (*GetVenuesResponse, error)
+	DeleteVenue(ctx context.Context, in *DeleteVenueRequest, opts ...grpc.CallOption) (*DeleteVenueResponse, error)
+	CreateRoom(ctx context.Context, in *CreateRoomRequest, opts ...grpc.CallOption) (*CreateRoomResponse, error)
+	EditRoom(ctx context.Context, in *EditRoomRequest, opts ...grpc.CallOption) (*EditRoomResponse, error)
+	DeleteRoom(ctx context.Context, in *DeleteRoomRequest, opts ...grpc.CallOption) (*DeleteRoomResponse, error)
+	GetRoomAvailability(ctx context.Context, in *GetRoomAvailabilityRequest, opts ...grpc.CallOption) (*GetRoomAvailabilityResponse, error)
+	CreateVolunteerRole(ctx context.Context, in *CreateVolunteerRoleRequest, opts ...grpc.CallOption) (*CreateVolunteerRoleResponse, error)
+	DeleteVolunteerRole(ctx context.Context, in *DeleteVolunteerRoleRequest, opts ...grpc.CallOption) (*DeleteVolunteerRoleResponse, error)
+	CreateVolunteerShift(ctx context.Context, in *CreateVolunteerShiftRequest, opts ...grpc.CallOption) (*CreateVolunteerShiftResponse, error)
+	EditVolunteerShift(ctx context.Context, in *EditVolunteerShiftRequest, opts ...grpc.CallOption) (*EditVolunteerShiftResponse, error)
+	DeleteVolunteerShift(ctx context.Context, in *DeleteVolunteerShiftRequest, opts ...grpc.CallOption) (*DeleteVolunteerShiftResponse, error)
+	GetVolunteerShifts(ctx context.Context, in *GetVolunteerShiftsRequest, opts ...grpc.CallOption) (*GetVolunteerShiftsResponse, error)
+	GetVolunteerRoster(ctx context.Context, in *GetVolunteerRosterRequest, opts ...grpc.CallOption) (*GetVolunteerRosterResponse, error)
+	SignUpForShift(ctx context.Context, in *SignUpForShiftRequest, opts ...grpc.CallOption) (*SignUpForShiftResponse, error)
+	CancelShiftSignup(ctx context.Context, in *CancelShiftSignupRequest, opts ...grpc.CallOption) (*CancelShiftSignupResponse, error)
+	SubmitProject(ctx context.Context, in *SubmitProjectRequest, opts ...grpc.CallOption) (*SubmitProjectResponse, error)
+	GetEventSubmissions(ctx context.Context, in *GetEventSubmissionsRequest, opts ...grpc.CallOption) (*GetEventSubmissionsResponse, error)
+	SetSubmissionContent(ctx context.Context, in *SetSubmissionContentRequest, opts ...grpc.CallOption) (*SetSubmissionContentResponse, error)
+	AddJudge(ctx context.Context, in *AddJudgeRequest, opts ...grpc.CallOption) (*AddJudgeResponse, error)
+	RemoveJudge(ctx context.Context, in *RemoveJudgeRequest, opts ...grpc.CallOption) (*RemoveJudgeResponse, error)
+	GetEventJudges(ctx context.Context, in *GetEventJudgesRequest, opts ...grpc.CallOption) (*GetEventJudgesResponse, error)
+	CreateCriterion(ctx context.Context, in *CreateCriterionRequest, opts ...grpc.CallOption) (*CreateCriterionResponse, error)
+	EditCriterion(ctx context.Context, in *EditCriterionRequest, opts ...grpc.CallOption) (*EditCriterionResponse, error)
+	DeleteCriterion(ctx context.Context, in *DeleteCriterionRequest, opts ...grpc.CallOption) (*DeleteCriterionResponse, error)
+	GetEventCriteria(ctx context.Context, in *GetEventCriteriaRequest, opts ...grpc.CallOption) (*GetEventCriteriaResponse, error)
+	ScoreSubmission(ctx context.Context, in *ScoreSubmissionRequest, opts ...grpc.CallOption) (*ScoreSubmissionResponse, error)
+	GetLeaderboard(ctx context.Context, in *GetLeaderboardRequest, opts ...grpc.CallOption) (*GetLeaderboardResponse, error)
+	SetGuestRegistration(ctx context.Context, in *SetGuestRegistrationRequest, opts ...grpc.CallOption) (*SetGuestRegistrationResponse, error)
+	RegisterGuest(ctx context.Context, in *RegisterGuestRequest, opts ...grpc.CallOption) (*RegisterGuestResponse, error)
+	ConfirmGuestRegistration(ctx context.Context, in *ConfirmGuestRegistrationRequest, opts ...grpc.CallOption) (*ConfirmGuestRegistrationResponse, error)
+	ClaimGuestRegistrations(ctx context.Context, in *ClaimGuestRegistrationsRequest, opts ...grpc.CallOption) (*ClaimGuestRegistrationsResponse, error)
+	RecordNewsletterSend(ctx context.Context, in *RecordNewsletterSendRequest, opts ...grpc.CallOption) (*RecordNewsletterSendResponse, error)
+	GetEventAnalytics(ctx context.Context, in *GetEventAnalyticsRequest, opts ...grpc.CallOption) (*GetEventAnalyticsResponse, error)
+	GetAnalyticsOverview(ctx context.Context, in *GetAnalyticsOverviewRequest, opts ...grpc.CallOption) (*GetAnalyticsOverviewResponse, error)
+	SearchEvents(ctx context.Context, in *SearchEventsRequest, opts ...grpc.CallOption) (*SearchEventsResponse, error)
 	GetEventRegistrations(ctx context.Context, in *GetEventRegistrationsRequest, opts ...grpc.CallOption) (*GetEventRegistrationsResponse, error)
+	ExportEventRegistrations(ctx context.Context, in *ExportEventRegistrationsRequest, opts ...grpc.CallOption) (*ExportEventRegistrationsResponse, error)
+	GetEventReport(ctx context.Context, in *GetEventReportRequest, opts ...grpc.CallOption) (*GetEventReportResponse, error)
+	SubmitFeedback(ctx context.Context, in *SubmitFeedbackRequest, opts ...grpc.CallOption) (*SubmitFeedbackResponse, error)
+	GetEventFeedback(ctx context.Context, in *GetEventFeedbackRequest, opts ...grpc.CallOption) (*GetEventFeedbackResponse, error)
+	CreateEventSeries(ctx context.Context, in *CreateEventSeriesRequest, opts ...grpc.CallOption) (*CreateEventSeriesResponse, error)
+	GetEventSeries(ctx context.Context, in *GetEventSeriesRequest, opts ...grpc.CallOption) (*GetEventSeriesResponse, error)
+	CancelOccurrence(ctx context.Context, in *CancelOccurrenceRequest, opts ...grpc.CallOption) (*CancelOccurrenceResponse, error)
+	RegisterForSeries(ctx context.Context, in *RegisterForSeriesRequest, opts ...grpc.CallOption) (*RegisterForSeriesResponse, error)
+	GetSeriesAttendance(ctx context.Context, in *GetSeriesAttendanceRequest, opts ...grpc.CallOption) (*GetSeriesAttendanceResponse, error)
+	CreateSpeaker(ctx context.Context, in *CreateSpeakerRequest, opts ...grpc.CallOption) (*CreateSpeakerResponse, error)
+	EditSpeaker(ctx context.Context, in *EditSpeakerRequest, opts ...grpc.CallOption) (*EditSpeakerResponse, error)
+	DeleteSpeaker(ctx context.Context, in *DeleteSpeakerRequest, opts ...grpc.CallOption) (*DeleteSpeakerResponse, error)
+	CreateSession(ctx context.Context, in *CreateSessionRequest, opts ...grpc.CallOption) (*CreateSessionResponse, error)
+	EditSession(ctx context.Context, in *EditSessionRequest, opts ...grpc.CallOption) (*EditSessionResponse, error)
+	DeleteSession(ctx context.Context, in *DeleteSessionRequest, opts ...grpc.CallOption) (*DeleteSessionResponse, error)
+	GetEventAgenda(ctx context.Context, in *GetEventAgendaRequest, opts ...grpc.CallOption) (*GetEventAgendaResponse, error)
+	BookmarkSession(ctx context.Context, in *BookmarkSessionRequest, opts ...grpc.CallOption) (*BookmarkSessionResponse, error)
+	RemoveBookmark(ctx context.Context, in *RemoveBookmarkRequest, opts ...grpc.CallOption) (*RemoveBookmarkResponse, error)
+	GetUserAgenda(ctx context.Context, in *GetUserAgendaRequest, opts ...grpc.CallOption) (*GetUserAgendaResponse, error)
 	GetEventUserRegistration(ctx context.Context, in *GetEventUserRegistrationRequest, opts ...grpc.CallOption) (*GetEventUserRegistrationResponse, error)
 	GetUserEvents(ctx context.Context, in *GetUserEventsRequest, opts ...grpc.CallOption) (*GetUserEventsResponse, error)
 	EditRegistration(ctx context.Context, in *EditRegistrationRequest, opts ...grpc.CallOption) (*EditRegistrationResponse, error)
+	GetTicket(ctx context.Context, in *GetTicketRequest, opts ...grpc.CallOption) (*GetTicketResponse, error)
+	CheckIn(ctx context.Context, in *CheckInRequest, opts ...grpc.CallOption) (*CheckInResponse, error)
+	IssueCertificates(ctx context.Context, in *IssueCertificatesRequest, opts ...grpc.CallOption) (*IssueCertificatesResponse, error)
+	GetUserCertificate(ctx context.Context, in *GetUserCertificateRequest, opts ...grpc.CallOption) (*GetUserCertificateResponse, error)
+	GetCertificate(ctx context.Context, in *GetCertificateRequest, opts ...grpc.CallOption) (*GetCertificateResponse, error)
+	VerifyCertificate(ctx context.Context, in *VerifyCertificateRequest, opts ...grpc.CallOption) (*VerifyCertificateResponse, error)
+	CreateTeam(ctx context.Context, in *CreateTeamRequest, opts ...grpc.CallOption) (*CreateTeamResponse, error)
+	InviteTeamMember(ctx context.Context, in *InviteTeamMemberRequest, opts ...grpc.CallOption) (*InviteTeamMemberResponse, error)
+	RespondTeamInvite(ctx context.Context, in *RespondTeamInviteRequest, opts ...grpc.CallOption) (*RespondTeamInviteResponse, error)
+	TransferTeamCaptain(ctx context.Context, in *TransferTeamCaptainRequest, opts ...grpc.CallOption) (*TransferTeamCaptainResponse, error)
+	DisbandTeam(ctx context.Context, in *DisbandTeamRequest, opts ...grpc.CallOption) (*DisbandTeamResponse, error)
+	GetUserTeam(ctx context.Context, in *GetUserTeamRequest, opts ...grpc.CallOption) (*GetUserTeamResponse, error)
+	GetUserTeamInvites(ctx context.Context, in *GetUserTeamInvitesRequest, opts ...grpc.CallOption) (*GetUserTeamInvitesResponse, error)
+	GetEventTeams(ctx context.Context, in *GetEventTeamsRequest, opts ...grpc.CallOption) (*GetEventTeamsResponse, error)
+	OptInTeamMatching(ctx context.Context, in *OptInTeamMatchingRequest, opts ...grpc.CallOption) (*OptInTeamMatchingResponse, error)
+	OptOutTeamMatching(ctx context.Context, in *OptOutTeamMatchingRequest, opts ...grpc.CallOption) (*OptOutTeamMatchingResponse, error)
+	MatchTeams(ctx context.Context, in *MatchTeamsRequest, opts ...grpc.CallOption) (*MatchTeamsResponse, error)
 }
 
 type eventServiceClient struct {
@@ -83,6 +250,15 @@ func (c *eventServiceClient) DeleteEvent(ctx context.Context, in *DeleteEventReq
 	return out, nil
 }
 
+func (c *eventServiceClient) CancelEvent(ctx context.Context, in *CancelEventRequest, opts ...grpc.CallOption) (*CancelEventResponse, error) {
+	out := new(CancelEventResponse)
+	err := c.cc.Invoke(ctx, EventService_CancelEvent_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *eventServiceClient) GetEvent(ctx context.Context, in *GetEventRequest, opts ...grpc.CallOption) (*GetEventResponse, error) {
 	out := new(GetEventResponse)
 	err := c.cc.Invoke(ctx, EventService_GetEvent_FullMethodName, in, out, opts...)
@@ -92,7 +268,7 @@ func (c *eventServiceClient) GetEvent(ctx context.Context, in *GetEventRequest,
 	return out, nil
 }
 
-func (c *eventServiceClient) GetEvents(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetEventsResponse, error) {
+func (c *eventServiceClient) GetEvents(ctx context.Context, in *GetEventsRequest, opts ...grpc.CallOption) (*GetEventsResponse, error) {
 	out := new(GetEventsResponse)
 	err := c.cc.Invoke(ctx, EventService_GetEvents_FullMethodName, in, out, opts...)
 	if err != nil {
@@ -101,6 +277,15 @@ func (c *eventServiceClient) GetEvents(ctx context.Context, in *emptypb.Empty, o
 	return out, nil
 }
 
+func (c *eventServiceClient) GetRecommendedEvents(ctx context.Context, in *GetRecommendedEventsRequest, opts ...grpc.CallOption) (*GetRecommendedEventsResponse, error) {
+	out := new(GetRecommendedEventsResponse)
+	err := c.cc.Invoke(ctx, EventService_GetRecommendedEvents_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *eventServiceClient) RegisterForEvent(ctx context.Context, in *RegisterForEventRequest, opts ...grpc.CallOption) (*RegisterForEventResponse, error) {
 	out := new(RegisterForEventResponse)
 	err := c.cc.Invoke(ctx, EventService_RegisterForEvent_FullMethodName, in, out, opts...)
@@ -110,280 +295,2866 @@ func (c *eventServiceClient) RegisterForEvent(ctx context.Context, in *RegisterF
 	return out, nil
 }
 
-func (c *eventServiceClient) GetEventRegistrations(ctx context.Context, in *GetEventRegistrationsRequest, opts ...grpc.CallOption) (*GetEventRegistrationsResponse, error) {
-	out := new(GetEventRegistrationsResponse)
-	err := c.cc.Invoke(ctx, EventService_GetEventRegistrations_FullMethodName, in, out, opts...)
+func (c *eventServiceClient) AddEventOrganizer(ctx context.Context, in *AddEventOrganizerRequest, opts ...grpc.CallOption) (*AddEventOrganizerResponse, error) {
+	out := new(AddEventOrganizerResponse)
+	err := c.cc.Invoke(ctx, EventService_AddEventOrganizer_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *eventServiceClient) GetEventUserRegistration(ctx context.Context, in *GetEventUserRegistrationRequest, opts ...grpc.CallOption) (*GetEventUserRegistrationResponse, error) {
-	out := new(GetEventUserRegistrationResponse)
-	err := c.cc.Invoke(ctx, EventService_GetEventUserRegistration_FullMethodName, in, out, opts...)
+func (c *eventServiceClient) RemoveEventOrganizer(ctx context.Context, in *RemoveEventOrganizerRequest, opts ...grpc.CallOption) (*RemoveEventOrganizerResponse, error) {
+	out := new(RemoveEventOrganizerResponse)
+	err := c.cc.Invoke(ctx, EventService_RemoveEventOrganizer_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *eventServiceClient) GetUserEvents(ctx context.Context, in *GetUserEventsRequest, opts ...grpc.CallOption) (*GetUserEventsResponse, error) {
-	out := new(GetUserEventsResponse)
-	err := c.cc.Invoke(ctx, EventService_GetUserEvents_FullMethodName, in, out, opts...)
+func (c *eventServiceClient) GetEventOrganizers(ctx context.Context, in *GetEventOrganizersRequest, opts ...grpc.CallOption) (*GetEventOrganizersResponse, error) {
+	out := new(GetEventOrganizersResponse)
+	err := c.cc.Invoke(ctx, EventService_GetEventOrganizers_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *eventServiceClient) EditRegistration(ctx context.Context, in *EditRegistrationRequest, opts ...grpc.CallOption) (*EditRegistrationResponse, error) {
-	out := new(EditRegistrationResponse)
-	err := c.cc.Invoke(ctx, EventService_EditRegistration_FullMethodName, in, out, opts...)
+func (c *eventServiceClient) CheckEventOrganizer(ctx context.Context, in *CheckEventOrganizerRequest, opts ...grpc.CallOption) (*CheckEventOrganizerResponse, error) {
+	out := new(CheckEventOrganizerResponse)
+	err := c.cc.Invoke(ctx, EventService_CheckEventOrganizer_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// EventServiceServer is the server API for EventService service.
-// All implementations should embed UnimplementedEventServiceServer
-// for forward compatibility
-type EventServiceServer interface {
-	CreateEvent(context.Context, *CreateEventRequest) (*CreateEventResponse, error)
-	EditEvent(context.Context, *EditEventRequest) (*EditEventResponse, error)
-	DeleteEvent(context.Context, *DeleteEventRequest) (*DeleteEventResponse, error)
-	GetEvent(context.Context, *GetEventRequest) (*GetEventResponse, error)
-	GetEvents(context.Context, *emptypb.Empty) (*GetEventsResponse, error)
-	RegisterForEvent(context.Context, *RegisterForEventRequest) (*RegisterForEventResponse, error)
-	GetEventRegistrations(context.Context, *GetEventRegistrationsRequest) (*GetEventRegistrationsResponse, error)
-	GetEventUserRegistration(context.Context, *GetEventUserRegistrationRequest) (*GetEventUserRegistrationResponse, error)
-	GetUserEvents(context.Context, *GetUserEventsRequest) (*GetUserEventsResponse, error)
-	EditRegistration(context.Context, *EditRegistrationRequest) (*EditRegistrationResponse, error)
+func (c *eventServiceClient) GetOrganizedEvents(ctx context.Context, in *GetOrganizedEventsRequest, opts ...grpc.CallOption) (*GetOrganizedEventsResponse, error) {
+	out := new(GetOrganizedEventsResponse)
+	err := c.cc.Invoke(ctx, EventService_GetOrganizedEvents_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-// UnimplementedEventServiceServer should be embedded to have forward compatible implementations.
-type UnimplementedEventServiceServer struct {
+func (c *eventServiceClient) DuplicateEvent(ctx context.Context, in *DuplicateEventRequest, opts ...grpc.CallOption) (*DuplicateEventResponse, error) {
+	out := new(DuplicateEventResponse)
+	err := c.cc.Invoke(ctx, EventService_DuplicateEvent_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (UnimplementedEventServiceServer) CreateEvent(context.Context, *CreateEventRequest) (*CreateEventResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateEvent not implemented")
+func (c *eventServiceClient) CreateEventTemplate(ctx context.Context, in *CreateEventTemplateRequest, opts ...grpc.CallOption) (*CreateEventTemplateResponse, error) {
+	out := new(CreateEventTemplateResponse)
+	err := c.cc.Invoke(ctx, EventService_CreateEventTemplate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedEventServiceServer) EditEvent(context.Context, *EditEventRequest) (*EditEventResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EditEvent not implemented")
+
+func (c *eventServiceClient) GetEventTemplates(ctx context.Context, in *GetEventTemplatesRequest, opts ...grpc.CallOption) (*GetEventTemplatesResponse, error) {
+	out := new(GetEventTemplatesResponse)
+	err := c.cc.Invoke(ctx, EventService_GetEventTemplates_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedEventServiceServer) DeleteEvent(context.Context, *DeleteEventRequest) (*DeleteEventResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteEvent not implemented")
+
+func (c *eventServiceClient) DeleteEventTemplate(ctx context.Context, in *DeleteEventTemplateRequest, opts ...grpc.CallOption) (*DeleteEventTemplateResponse, error) {
+	out := new(DeleteEventTemplateResponse)
+	err := c.cc.Invoke(ctx, EventService_DeleteEventTemplate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedEventServiceServer) GetEvent(context.Context, *GetEventRequest) (*GetEventResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetEvent not implemented")
+
+func (c *eventServiceClient) CreateEventFromTemplate(ctx context.Context, in *CreateEventFromTemplateRequest, opts ...grpc.CallOption) (*CreateEventFromTemplateResponse, error) {
+	out := new(CreateEventFromTemplateResponse)
+	err := c.cc.Invoke(ctx, EventService_CreateEventFromTemplate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedEventServiceServer) GetEvents(context.Context, *emptypb.Empty) (*GetEventsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetEvents not implemented")
+
+func (c *eventServiceClient) CreateVenue(ctx context.Context, in *CreateVenueRequest, opts ...grpc.CallOption) (*CreateVenueResponse, error) {
+	out := new(CreateVenueResponse)
+	err := c.cc.Invoke(ctx, EventService_CreateVenue_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedEventServiceServer) RegisterForEvent(context.Context, *RegisterForEventRequest) (*RegisterForEventResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RegisterForEvent not implemented")
+
+func (c *eventServiceClient) GetVenues(ctx context.Context, in *GetVenuesRequest, opts ...grpc.CallOption) (*GetVenuesResponse, error) {
+	out := new(GetVenuesResponse)
+	err := c.cc.Invoke(ctx, EventService_GetVenues_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedEventServiceServer) GetEventRegistrations(context.Context, *GetEventRegistrationsRequest) (*GetEventRegistrationsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetEventRegistrations not implemented")
+
+func (c *eventServiceClient) DeleteVenue(ctx context.Context, in *DeleteVenueRequest, opts ...grpc.CallOption) (*DeleteVenueResponse, error) {
+	out := new(DeleteVenueResponse)
+	err := c.cc.Invoke(ctx, EventService_DeleteVenue_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedEventServiceServer) GetEventUserRegistration(context.Context, *GetEventUserRegistrationRequest) (*GetEventUserRegistrationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetEventUserRegistration not implemented")
+
+func (c *eventServiceClient) CreateRoom(ctx context.Context, in *CreateRoomRequest, opts ...grpc.CallOption) (*CreateRoomResponse, error) {
+	out := new(CreateRoomResponse)
+	err := c.cc.Invoke(ctx, EventService_CreateRoom_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedEventServiceServer) GetUserEvents(context.Context, *GetUserEventsRequest) (*GetUserEventsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetUserEvents not implemented")
+
+func (c *eventServiceClient) EditRoom(ctx context.Context, in *EditRoomRequest, opts ...grpc.CallOption) (*EditRoomResponse, error) {
+	out := new(EditRoomResponse)
+	err := c.cc.Invoke(ctx, EventService_EditRoom_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedEventServiceServer) EditRegistration(context.Context, *EditRegistrationRequest) (*EditRegistrationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EditRegistration not implemented")
+
+func (c *eventServiceClient) DeleteRoom(ctx context.Context, in *DeleteRoomRequest, opts ...grpc.CallOption) (*DeleteRoomResponse, error) {
+	out := new(DeleteRoomResponse)
+	err := c.cc.Invoke(ctx, EventService_DeleteRoom_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) GetRoomAvailability(ctx context.Context, in *GetRoomAvailabilityRequest, opts ...grpc.CallOption) (*GetRoomAvailabilityResponse, error) {
+	out := new(GetRoomAvailabilityResponse)
+	err := c.cc.Invoke(ctx, EventService_GetRoomAvailability_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) CreateVolunteerRole(ctx context.Context, in *CreateVolunteerRoleRequest, opts ...grpc.CallOption) (*CreateVolunteerRoleResponse, error) {
+	out := new(CreateVolunteerRoleResponse)
+	err := c.cc.Invoke(ctx, EventService_CreateVolunteerRole_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) DeleteVolunteerRole(ctx context.Context, in *DeleteVolunteerRoleRequest, opts ...grpc.CallOption) (*DeleteVolunteerRoleResponse, error) {
+	out := new(DeleteVolunteerRoleResponse)
+	err := c.cc.Invoke(ctx, EventService_DeleteVolunteerRole_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) CreateVolunteerShift(ctx context.Context, in *CreateVolunteerShiftRequest, opts ...grpc.CallOption) (*CreateVolunteerShiftResponse, error) {
+	out := new(CreateVolunteerShiftResponse)
+	err := c.cc.Invoke(ctx, EventService_CreateVolunteerShift_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) EditVolunteerShift(ctx context.Context, in *EditVolunteerShiftRequest, opts ...grpc.CallOption) (*EditVolunteerShiftResponse, error) {
+	out := new(EditVolunteerShiftResponse)
+	err := c.cc.Invoke(ctx, EventService_EditVolunteerShift_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) DeleteVolunteerShift(ctx context.Context, in *DeleteVolunteerShiftRequest, opts ...grpc.CallOption) (*DeleteVolunteerShiftResponse, error) {
+	out := new(DeleteVolunteerShiftResponse)
+	err := c.cc.Invoke(ctx, EventService_DeleteVolunteerShift_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) GetVolunteerShifts(ctx context.Context, in *GetVolunteerShiftsRequest, opts ...grpc.CallOption) (*GetVolunteerShiftsResponse, error) {
+	out := new(GetVolunteerShiftsResponse)
+	err := c.cc.Invoke(ctx, EventService_GetVolunteerShifts_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) GetVolunteerRoster(ctx context.Context, in *GetVolunteerRosterRequest, opts ...grpc.CallOption) (*GetVolunteerRosterResponse, error) {
+	out := new(GetVolunteerRosterResponse)
+	err := c.cc.Invoke(ctx, EventService_GetVolunteerRoster_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) SignUpForShift(ctx context.Context, in *SignUpForShiftRequest, opts ...grpc.CallOption) (*SignUpForShiftResponse, error) {
+	out := new(SignUpForShiftResponse)
+	err := c.cc.Invoke(ctx, EventService_SignUpForShift_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) CancelShiftSignup(ctx context.Context, in *CancelShiftSignupRequest, opts ...grpc.CallOption) (*CancelShiftSignupResponse, error) {
+	out := new(CancelShiftSignupResponse)
+	err := c.cc.Invoke(ctx, EventService_CancelShiftSignup_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) SubmitProject(ctx context.Context, in *SubmitProjectRequest, opts ...grpc.CallOption) (*SubmitProjectResponse, error) {
+	out := new(SubmitProjectResponse)
+	err := c.cc.Invoke(ctx, EventService_SubmitProject_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) GetEventSubmissions(ctx context.Context, in *GetEventSubmissionsRequest, opts ...grpc.CallOption) (*GetEventSubmissionsResponse, error) {
+	out := new(GetEventSubmissionsResponse)
+	err := c.cc.Invoke(ctx, EventService_GetEventSubmissions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) SetSubmissionContent(ctx context.Context, in *SetSubmissionContentRequest, opts ...grpc.CallOption) (*SetSubmissionContentResponse, error) {
+	out := new(SetSubmissionContentResponse)
+	err := c.cc.Invoke(ctx, EventService_SetSubmissionContent_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) AddJudge(ctx context.Context, in *AddJudgeRequest, opts ...grpc.CallOption) (*AddJudgeResponse, error) {
+	out := new(AddJudgeResponse)
+	err := c.cc.Invoke(ctx, EventService_AddJudge_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) RemoveJudge(ctx context.Context, in *RemoveJudgeRequest, opts ...grpc.CallOption) (*RemoveJudgeResponse, error) {
+	out := new(RemoveJudgeResponse)
+	err := c.cc.Invoke(ctx, EventService_RemoveJudge_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) GetEventJudges(ctx context.Context, in *GetEventJudgesRequest, opts ...grpc.CallOption) (*GetEventJudgesResponse, error) {
+	out := new(GetEventJudgesResponse)
+	err := c.cc.Invoke(ctx, EventService_GetEventJudges_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) CreateCriterion(ctx context.Context, in *CreateCriterionRequest, opts ...grpc.CallOption) (*CreateCriterionResponse, error) {
+	out := new(CreateCriterionResponse)
+	err := c.cc.Invoke(ctx, EventService_CreateCriterion_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) EditCriterion(ctx context.Context, in *EditCriterionRequest, opts ...grpc.CallOption) (*EditCriterionResponse, error) {
+	out := new(EditCriterionResponse)
+	err := c.cc.Invoke(ctx, EventService_EditCriterion_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) DeleteCriterion(ctx context.Context, in *DeleteCriterionRequest, opts ...grpc.CallOption) (*DeleteCriterionResponse, error) {
+	out := new(DeleteCriterionResponse)
+	err := c.cc.Invoke(ctx, EventService_DeleteCriterion_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) GetEventCriteria(ctx context.Context, in *GetEventCriteriaRequest, opts ...grpc.CallOption) (*GetEventCriteriaResponse, error) {
+	out := new(GetEventCriteriaResponse)
+	err := c.cc.Invoke(ctx, EventService_GetEventCriteria_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) ScoreSubmission(ctx context.Context, in *ScoreSubmissionRequest, opts ...grpc.CallOption) (*ScoreSubmissionResponse, error) {
+	out := new(ScoreSubmissionResponse)
+	err := c.cc.Invoke(ctx, EventService_ScoreSubmission_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) GetLeaderboard(ctx context.Context, in *GetLeaderboardRequest, opts ...grpc.CallOption) (*GetLeaderboardResponse, error) {
+	out := new(GetLeaderboardResponse)
+	err := c.cc.Invoke(ctx, EventService_GetLeaderboard_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) SetGuestRegistration(ctx context.Context, in *SetGuestRegistrationRequest, opts ...grpc.CallOption) (*SetGuestRegistrationResponse, error) {
+	out := new(SetGuestRegistrationResponse)
+	err := c.cc.Invoke(ctx, EventService_SetGuestRegistration_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) RegisterGuest(ctx context.Context, in *RegisterGuestRequest, opts ...grpc.CallOption) (*RegisterGuestResponse, error) {
+	out := new(RegisterGuestResponse)
+	err := c.cc.Invoke(ctx, EventService_RegisterGuest_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) ConfirmGuestRegistration(ctx context.Context, in *ConfirmGuestRegistrationRequest, opts ...grpc.CallOption) (*ConfirmGuestRegistrationResponse, error) {
+	out := new(ConfirmGuestRegistrationResponse)
+	err := c.cc.Invoke(ctx, EventService_ConfirmGuestRegistration_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) ClaimGuestRegistrations(ctx context.Context, in *ClaimGuestRegistrationsRequest, opts ...grpc.CallOption) (*ClaimGuestRegistrationsResponse, error) {
+	out := new(ClaimGuestRegistrationsResponse)
+	err := c.cc.Invoke(ctx, EventService_ClaimGuestRegistrations_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) RecordNewsletterSend(ctx context.Context, in *RecordNewsletterSendRequest, opts ...grpc.CallOption) (*RecordNewsletterSendResponse, error) {
+	out := new(RecordNewsletterSendResponse)
+	err := c.cc.Invoke(ctx, EventService_RecordNewsletterSend_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) GetEventAnalytics(ctx context.Context, in *GetEventAnalyticsRequest, opts ...grpc.CallOption) (*GetEventAnalyticsResponse, error) {
+	out := new(GetEventAnalyticsResponse)
+	err := c.cc.Invoke(ctx, EventService_GetEventAnalytics_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) GetAnalyticsOverview(ctx context.Context, in *GetAnalyticsOverviewRequest, opts ...grpc.CallOption) (*GetAnalyticsOverviewResponse, error) {
+	out := new(GetAnalyticsOverviewResponse)
+	err := c.cc.Invoke(ctx, EventService_GetAnalyticsOverview_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) SearchEvents(ctx context.Context, in *SearchEventsRequest, opts ...grpc.CallOption) (*SearchEventsResponse, error) {
+	out := new(SearchEventsResponse)
+	err := c.cc.Invoke(ctx, EventService_SearchEvents_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) GetEventRegistrations(ctx context.Context, in *GetEventRegistrationsRequest, opts ...grpc.CallOption) (*GetEventRegistrationsResponse, error) {
+	out := new(GetEventRegistrationsResponse)
+	err := c.cc.Invoke(ctx, EventService_GetEventRegistrations_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) ExportEventRegistrations(ctx context.Context, in *ExportEventRegistrationsRequest, opts ...grpc.CallOption) (*ExportEventRegistrationsResponse, error) {
+	out := new(ExportEventRegistrationsResponse)
+	err := c.cc.Invoke(ctx, EventService_ExportEventRegistrations_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) GetEventReport(ctx context.Context, in *GetEventReportRequest, opts ...grpc.CallOption) (*GetEventReportResponse, error) {
+	out := new(GetEventReportResponse)
+	err := c.cc.Invoke(ctx, EventService_GetEventReport_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) SubmitFeedback(ctx context.Context, in *SubmitFeedbackRequest, opts ...grpc.CallOption) (*SubmitFeedbackResponse, error) {
+	out := new(SubmitFeedbackResponse)
+	err := c.cc.Invoke(ctx, EventService_SubmitFeedback_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) GetEventFeedback(ctx context.Context, in *GetEventFeedbackRequest, opts ...grpc.CallOption) (*GetEventFeedbackResponse, error) {
+	out := new(GetEventFeedbackResponse)
+	err := c.cc.Invoke(ctx, EventService_GetEventFeedback_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) CreateEventSeries(ctx context.Context, in *CreateEventSeriesRequest, opts ...grpc.CallOption) (*CreateEventSeriesResponse, error) {
+	out := new(CreateEventSeriesResponse)
+	err := c.cc.Invoke(ctx, EventService_CreateEventSeries_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) GetEventSeries(ctx context.Context, in *GetEventSeriesRequest, opts ...grpc.CallOption) (*GetEventSeriesResponse, error) {
+	out := new(GetEventSeriesResponse)
+	err := c.cc.Invoke(ctx, EventService_GetEventSeries_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) CancelOccurrence(ctx context.Context, in *CancelOccurrenceRequest, opts ...grpc.CallOption) (*CancelOccurrenceResponse, error) {
+	out := new(CancelOccurrenceResponse)
+	err := c.cc.Invoke(ctx, EventService_CancelOccurrence_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) RegisterForSeries(ctx context.Context, in *RegisterForSeriesRequest, opts ...grpc.CallOption) (*RegisterForSeriesResponse, error) {
+	out := new(RegisterForSeriesResponse)
+	err := c.cc.Invoke(ctx, EventService_RegisterForSeries_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) GetSeriesAttendance(ctx context.Context, in *GetSeriesAttendanceRequest, opts ...grpc.CallOption) (*GetSeriesAttendanceResponse, error) {
+	out := new(GetSeriesAttendanceResponse)
+	err := c.cc.Invoke(ctx, EventService_GetSeriesAttendance_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) CreateSpeaker(ctx context.Context, in *CreateSpeakerRequest, opts ...grpc.CallOption) (*CreateSpeakerResponse, error) {
+	out := new(CreateSpeakerResponse)
+	err := c.cc.Invoke(ctx, EventService_CreateSpeaker_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) EditSpeaker(ctx context.Context, in *EditSpeakerRequest, opts ...grpc.CallOption) (*EditSpeakerResponse, error) {
+	out := new(EditSpeakerResponse)
+	err := c.cc.Invoke(ctx, EventService_EditSpeaker_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) DeleteSpeaker(ctx context.Context, in *DeleteSpeakerRequest, opts ...grpc.CallOption) (*DeleteSpeakerResponse, error) {
+	out := new(DeleteSpeakerResponse)
+	err := c.cc.Invoke(ctx, EventService_DeleteSpeaker_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) CreateSession(ctx context.Context, in *CreateSessionRequest, opts ...grpc.CallOption) (*CreateSessionResponse, error) {
+	out := new(CreateSessionResponse)
+	err := c.cc.Invoke(ctx, EventService_CreateSession_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) EditSession(ctx context.Context, in *EditSessionRequest, opts ...grpc.CallOption) (*EditSessionResponse, error) {
+	out := new(EditSessionResponse)
+	err := c.cc.Invoke(ctx, EventService_EditSession_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) DeleteSession(ctx context.Context, in *DeleteSessionRequest, opts ...grpc.CallOption) (*DeleteSessionResponse, error) {
+	out := new(DeleteSessionResponse)
+	err := c.cc.Invoke(ctx, EventService_DeleteSession_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) GetEventAgenda(ctx context.Context, in *GetEventAgendaRequest, opts ...grpc.CallOption) (*GetEventAgendaResponse, error) {
+	out := new(GetEventAgendaResponse)
+	err := c.cc.Invoke(ctx, EventService_GetEventAgenda_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) BookmarkSession(ctx context.Context, in *BookmarkSessionRequest, opts ...grpc.CallOption) (*BookmarkSessionResponse, error) {
+	out := new(BookmarkSessionResponse)
+	err := c.cc.Invoke(ctx, EventService_BookmarkSession_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) RemoveBookmark(ctx context.Context, in *RemoveBookmarkRequest, opts ...grpc.CallOption) (*RemoveBookmarkResponse, error) {
+	out := new(RemoveBookmarkResponse)
+	err := c.cc.Invoke(ctx, EventService_RemoveBookmark_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) GetUserAgenda(ctx context.Context, in *GetUserAgendaRequest, opts ...grpc.CallOption) (*GetUserAgendaResponse, error) {
+	out := new(GetUserAgendaResponse)
+	err := c.cc.Invoke(ctx, EventService_GetUserAgenda_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) GetEventUserRegistration(ctx context.Context, in *GetEventUserRegistrationRequest, opts ...grpc.CallOption) (*GetEventUserRegistrationResponse, error) {
+	out := new(GetEventUserRegistrationResponse)
+	err := c.cc.Invoke(ctx, EventService_GetEventUserRegistration_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) GetUserEvents(ctx context.Context, in *GetUserEventsRequest, opts ...grpc.CallOption) (*GetUserEventsResponse, error) {
+	out := new(GetUserEventsResponse)
+	err := c.cc.Invoke(ctx, EventService_GetUserEvents_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) EditRegistration(ctx context.Context, in *EditRegistrationRequest, opts ...grpc.CallOption) (*EditRegistrationResponse, error) {
+	out := new(EditRegistrationResponse)
+	err := c.cc.Invoke(ctx, EventService_EditRegistration_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) GetTicket(ctx context.Context, in *GetTicketRequest, opts ...grpc.CallOption) (*GetTicketResponse, error) {
+	out := new(GetTicketResponse)
+	err := c.cc.Invoke(ctx, EventService_GetTicket_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) CheckIn(ctx context.Context, in *CheckInRequest, opts ...grpc.CallOption) (*CheckInResponse, error) {
+	out := new(CheckInResponse)
+	err := c.cc.Invoke(ctx, EventService_CheckIn_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) IssueCertificates(ctx context.Context, in *IssueCertificatesRequest, opts ...grpc.CallOption) (*IssueCertificatesResponse, error) {
+	out := new(IssueCertificatesResponse)
+	err := c.cc.Invoke(ctx, EventService_IssueCertificates_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) GetUserCertificate(ctx context.Context, in *GetUserCertificateRequest, opts ...grpc.CallOption) (*GetUserCertificateResponse, error) {
+	out := new(GetUserCertificateResponse)
+	err := c.cc.Invoke(ctx, EventService_GetUserCertificate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) GetCertificate(ctx context.Context, in *GetCertificateRequest, opts ...grpc.CallOption) (*GetCertificateResponse, error) {
+	out := new(GetCertificateResponse)
+	err := c.cc.Invoke(ctx, EventService_GetCertificate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) VerifyCertificate(ctx context.Context, in *VerifyCertificateRequest, opts ...grpc.CallOption) (*VerifyCertificateResponse, error) {
+	out := new(VerifyCertificateResponse)
+	err := c.cc.Invoke(ctx, EventService_VerifyCertificate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) CreateTeam(ctx context.Context, in *CreateTeamRequest, opts ...grpc.CallOption) (*CreateTeamResponse, error) {
+	out := new(CreateTeamResponse)
+	err := c.cc.Invoke(ctx, EventService_CreateTeam_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) InviteTeamMember(ctx context.Context, in *InviteTeamMemberRequest, opts ...grpc.CallOption) (*InviteTeamMemberResponse, error) {
+	out := new(InviteTeamMemberResponse)
+	err := c.cc.Invoke(ctx, EventService_InviteTeamMember_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) RespondTeamInvite(ctx context.Context, in *RespondTeamInviteRequest, opts ...grpc.CallOption) (*RespondTeamInviteResponse, error) {
+	out := new(RespondTeamInviteResponse)
+	err := c.cc.Invoke(ctx, EventService_RespondTeamInvite_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) TransferTeamCaptain(ctx context.Context, in *TransferTeamCaptainRequest, opts ...grpc.CallOption) (*TransferTeamCaptainResponse, error) {
+	out := new(TransferTeamCaptainResponse)
+	err := c.cc.Invoke(ctx, EventService_TransferTeamCaptain_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) DisbandTeam(ctx context.Context, in *DisbandTeamRequest, opts ...grpc.CallOption) (*DisbandTeamResponse, error) {
+	out := new(DisbandTeamResponse)
+	err := c.cc.Invoke(ctx, EventService_DisbandTeam_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) GetUserTeam(ctx context.Context, in *GetUserTeamRequest, opts ...grpc.CallOption) (*GetUserTeamResponse, error) {
+	out := new(GetUserTeamResponse)
+	err := c.cc.Invoke(ctx, EventService_GetUserTeam_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) GetUserTeamInvites(ctx context.Context, in *GetUserTeamInvitesRequest, opts ...grpc.CallOption) (*GetUserTeamInvitesResponse, error) {
+	out := new(GetUserTeamInvitesResponse)
+	err := c.cc.Invoke(ctx, EventService_GetUserTeamInvites_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) GetEventTeams(ctx context.Context, in *GetEventTeamsRequest, opts ...grpc.CallOption) (*GetEventTeamsResponse, error) {
+	out := new(GetEventTeamsResponse)
+	err := c.cc.Invoke(ctx, EventService_GetEventTeams_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) OptInTeamMatching(ctx context.Context, in *OptInTeamMatchingRequest, opts ...grpc.CallOption) (*OptInTeamMatchingResponse, error) {
+	out := new(OptInTeamMatchingResponse)
+	err := c.cc.Invoke(ctx, EventService_OptInTeamMatching_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) OptOutTeamMatching(ctx context.Context, in *OptOutTeamMatchingRequest, opts ...grpc.CallOption) (*OptOutTeamMatchingResponse, error) {
+	out := new(OptOutTeamMatchingResponse)
+	err := c.cc.Invoke(ctx, EventService_OptOutTeamMatching_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) MatchTeams(ctx context.Context, in *MatchTeamsRequest, opts ...grpc.CallOption) (*MatchTeamsResponse, error) {
+	out := new(MatchTeamsResponse)
+	err := c.cc.Invoke(ctx, EventService_MatchTeams_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// EventServiceServer is the server API for EventService service.
+// All implementations should embed UnimplementedEventServiceServer
+// for forward compatibility
+type EventServiceServer interface {
+	CreateEvent(context.Context, *CreateEventRequest) (*CreateEventResponse, error)
+	EditEvent(context.Context, *EditEventRequest) (*EditEventResponse, error)
+	DeleteEvent(context.Context, *DeleteEventRequest) (*DeleteEventResponse, error)
+	CancelEvent(context.Context, *CancelEventRequest) (*CancelEventResponse, error)
+	GetEvent(context.Context, *GetEventRequest) (*GetEventResponse, error)
+	GetEvents(context.Context, *GetEventsRequest) (*GetEventsResponse, error)
+	GetRecommendedEvents(context.Context, *GetRecommendedEventsRequest) (*GetRecommendedEventsResponse, error)
+	RegisterForEvent(context.Context, *RegisterForEventRequest) (*RegisterForEventResponse, error)
+	AddEventOrganizer(context.Context, *AddEventOrganizerRequest) (*AddEventOrganizerResponse, error)
+	RemoveEventOrganizer(context.Context, *RemoveEventOrganizerRequest) (*RemoveEventOrganizerResponse, error)
+	GetEventOrganizers(context.Context, *GetEventOrganizersRequest) (*GetEventOrganizersResponse, error)
+	CheckEventOrganizer(context.Context, *CheckEventOrganizerRequest) (*CheckEventOrganizerResponse, error)
+	GetOrganizedEvents(context.Context, *GetOrganizedEventsRequest) (*GetOrganizedEventsResponse, error)
+	DuplicateEvent(context.Context, *DuplicateEventRequest) (*DuplicateEventResponse, error)
+	CreateEventTemplate(context.Context, *CreateEventTemplateRequest) (*CreateEventTemplateResponse, error)
+	GetEventTemplates(context.Context, *GetEventTemplatesRequest) (*GetEventTemplatesResponse, error)
+	DeleteEventTemplate(context.Context, *DeleteEventTemplateRequest) (*DeleteEventTemplateResponse, error)
+	CreateEventFromTemplate(context.Context, *CreateEventFromTemplateRequest) (*CreateEventFromTemplateResponse, error)
+	CreateVenue(context.Context, *CreateVenueRequest) (*CreateVenueResponse, error)
+	GetVenues(context.Context, *GetVenuesRequest) (*GetVenuesResponse, error)
+	DeleteVenue(context.Context, *DeleteVenueRequest) (*DeleteVenueResponse, error)
+	CreateRoom(context.Context, *CreateRoomRequest) (*CreateRoomResponse, error)
+	EditRoom(context.Context, *EditRoomRequest) (*EditRoomResponse, error)
+	DeleteRoom(context.Context, *DeleteRoomRequest) (*DeleteRoomResponse, error)
+	GetRoomAvailability(context.Context, *GetRoomAvailabilityRequest) (*GetRoomAvailabilityResponse, error)
+	CreateVolunteerRole(context.Context, *CreateVolunteerRoleRequest) (*CreateVolunteerRoleResponse, error)
+	DeleteVolunteerRole(context.Context, *DeleteVolunteerRoleRequest) (*DeleteVolunteerRoleResponse, error)
+	CreateVolunteerShift(context.Context, *CreateVolunteerShiftRequest) (*CreateVolunteerShiftResponse, error)
+	EditVolunteerShift(context.Context, *EditVolunteerShiftRequest) (*EditVolunteerShiftResponse, error)
+	DeleteVolunteerShift(context.Context, *DeleteVolunteerShiftRequest) (*DeleteVolunteerShiftResponse, error)
+	GetVolunteerShifts(context.Context, *GetVolunteerShiftsRequest) (*GetVolunteerShiftsResponse, error)
+	GetVolunteerRoster(context.Context, *GetVolunteerRosterRequest) (*GetVolunteerRosterResponse, error)
+	SignUpForShift(context.Context, *SignUpForShiftRequest) (*SignUpForShiftResponse, error)
+	CancelShiftSignup(context.Context, *CancelShiftSignupRequest) (*CancelShiftSignupResponse, error)
+	SubmitProject(context.Context, *SubmitProjectRequest) (*SubmitProjectResponse, error)
+	GetEventSubmissions(context.Context, *GetEventSubmissionsRequest) (*GetEventSubmissionsResponse, error)
+	SetSubmissionContent(context.Context, *SetSubmissionContentRequest) (*SetSubmissionContentResponse, error)
+	AddJudge(context.Context, *AddJudgeRequest) (*AddJudgeResponse, error)
+	RemoveJudge(context.Context, *RemoveJudgeRequest) (*RemoveJudgeResponse, error)
+	GetEventJudges(context.Context, *GetEventJudgesRequest) (*GetEventJudgesResponse, error)
+	CreateCriterion(context.Context, *CreateCriterionRequest) (*CreateCriterionResponse, error)
+	EditCriterion(context.Context, *EditCriterionRequest) (*EditCriterionResponse, error)
+	DeleteCriterion(context.Context, *DeleteCriterionRequest) (*DeleteCriterionResponse, error)
+	GetEventCriteria(context.Context, *GetEventCriteriaRequest) (*GetEventCriteriaResponse, error)
+	ScoreSubmission(context.Context, *ScoreSubmissionRequest) (*ScoreSubmissionResponse, error)
+	GetLeaderboard(context.Context, *GetLeaderboardRequest) (*GetLeaderboardResponse, error)
+	SetGuestRegistration(context.Context, *SetGuestRegistrationRequest) (*SetGuestRegistrationResponse, error)
+	RegisterGuest(context.Context, *RegisterGuestRequest) (*RegisterGuestResponse, error)
+	ConfirmGuestRegistration(context.Context, *ConfirmGuestRegistrationRequest) (*ConfirmGuestRegistrationResponse, error)
+	ClaimGuestRegistrations(context.Context, *ClaimGuestRegistrationsRequest) (*ClaimGuestRegistrationsResponse, error)
+	RecordNewsletterSend(context.Context, *RecordNewsletterSendRequest) (*RecordNewsletterSendResponse, error)
+	GetEventAnalytics(context.Context, *GetEventAnalyticsRequest) (*GetEventAnalyticsResponse, error)
+	GetAnalyticsOverview(context.Context, *GetAnalyticsOverviewRequest) (*GetAnalyticsOverviewResponse, error)
+	SearchEvents(context.Context, *SearchEventsRequest) (*SearchEventsResponse, error)
+	GetEventRegistrations(context.Context, *GetEventRegistrationsRequest) (*GetEventRegistrationsResponse, error)
+	ExportEventRegistrations(context.Context, *ExportEventRegistrationsRequest) (*ExportEventRegistrationsResponse, error)
+	GetEventReport(context.Context, *GetEventReportRequest) (*GetEventReportResponse, error)
+	SubmitFeedback(context.Context, *SubmitFeedbackRequest) (*SubmitFeedbackResponse, error)
+	GetEventFeedback(context.Context, *GetEventFeedbackRequest) (*GetEventFeedbackResponse, error)
+	CreateEventSeries(context.Context, *CreateEventSeriesRequest) (*CreateEventSeriesResponse, error)
+	GetEventSeries(context.Context, *GetEventSeriesRequest) (*GetEventSeriesResponse, error)
+	CancelOccurrence(context.Context, *CancelOccurrenceRequest) (*CancelOccurrenceResponse, error)
+	RegisterForSeries(context.Context, *RegisterForSeriesRequest) (*RegisterForSeriesResponse, error)
+	GetSeriesAttendance(context.Context, *GetSeriesAttendanceRequest) (*GetSeriesAttendanceResponse, error)
+	CreateSpeaker(context.Context, *CreateSpeakerRequest) (*CreateSpeakerResponse, error)
+	EditSpeaker(context.Context, *EditSpeakerRequest) (*EditSpeakerResponse, error)
+	DeleteSpeaker(context.Context, *DeleteSpeakerRequest) (*DeleteSpeakerResponse, error)
+	CreateSession(context.Context, *CreateSessionRequest) (*CreateSessionResponse, error)
+	EditSession(context.Context, *EditSessionRequest) (*EditSessionResponse, error)
+	DeleteSession(context.Context, *DeleteSessionRequest) (*DeleteSessionResponse, error)
+	GetEventAgenda(context.Context, *GetEventAgendaRequest) (*GetEventAgendaResponse, error)
+	BookmarkSession(context.Context, *BookmarkSessionRequest) (*BookmarkSessionResponse, error)
+	RemoveBookmark(context.Context, *RemoveBookmarkRequest) (*RemoveBookmarkResponse, error)
+	GetUserAgenda(context.Context, *GetUserAgendaRequest) (*GetUserAgendaResponse, error)
+	GetEventUserRegistration(context.Context, *GetEventUserRegistrationRequest) (*GetEventUserRegistrationResponse, error)
+	GetUserEvents(context.Context, *GetUserEventsRequest) (*GetUserEventsResponse, error)
+	EditRegistration(context.Context, *EditRegistrationRequest) (*EditRegistrationResponse, error)
+	GetTicket(context.Context, *GetTicketRequest) (*GetTicketResponse, error)
+	CheckIn(context.Context, *CheckInRequest) (*CheckInResponse, error)
+	IssueCertificates(context.Context, *IssueCertificatesRequest) (*IssueCertificatesResponse, error)
+	GetUserCertificate(context.Context, *GetUserCertificateRequest) (*GetUserCertificateResponse, error)
+	GetCertificate(context.Context, *GetCertificateRequest) (*GetCertificateResponse, error)
+	VerifyCertificate(context.Context, *VerifyCertificateRequest) (*VerifyCertificateResponse, error)
+	CreateTeam(context.Context, *CreateTeamRequest) (*CreateTeamResponse, error)
+	InviteTeamMember(context.Context, *InviteTeamMemberRequest) (*InviteTeamMemberResponse, error)
+	RespondTeamInvite(context.Context, *RespondTeamInviteRequest) (*RespondTeamInviteResponse, error)
+	TransferTeamCaptain(context.Context, *TransferTeamCaptainRequest) (*TransferTeamCaptainResponse, error)
+	DisbandTeam(context.Context, *DisbandTeamRequest) (*DisbandTeamResponse, error)
+	GetUserTeam(context.Context, *GetUserTeamRequest) (*GetUserTeamResponse, error)
+	GetUserTeamInvites(context.Context, *GetUserTeamInvitesRequest) (*GetUserTeamInvitesResponse, error)
+	GetEventTeams(context.Context, *GetEventTeamsRequest) (*GetEventTeamsResponse, error)
+	OptInTeamMatching(context.Context, *OptInTeamMatchingRequest) (*OptInTeamMatchingResponse, error)
+	OptOutTeamMatching(context.Context, *OptOutTeamMatchingRequest) (*OptOutTeamMatchingResponse, error)
+	MatchTeams(context.Context, *MatchTeamsRequest) (*MatchTeamsResponse, error)
+}
+
+// UnimplementedEventServiceServer should be embedded to have forward compatible implementations.
+type UnimplementedEventServiceServer struct {
+}
+
+func (UnimplementedEventServiceServer) CreateEvent(context.Context, *CreateEventRequest) (*CreateEventResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateEvent not implemented")
+}
+func (UnimplementedEventServiceServer) EditEvent(context.Context, *EditEventRequest) (*EditEventResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EditEvent not implemented")
+}
+func (UnimplementedEventServiceServer) DeleteEvent(context.Context, *DeleteEventRequest) (*DeleteEventResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteEvent not implemented")
+}
+func (UnimplementedEventServiceServer) CancelEvent(context.Context, *CancelEventRequest) (*CancelEventResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelEvent not implemented")
+}
+func (UnimplementedEventServiceServer) GetEvent(context.Context, *GetEventRequest) (*GetEventResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEvent not implemented")
+}
+func (UnimplementedEventServiceServer) GetEvents(context.Context, *GetEventsRequest) (*GetEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEvents not implemented")
+}
+func (UnimplementedEventServiceServer) GetRecommendedEvents(context.Context, *GetRecommendedEventsRequest) (*GetRecommendedEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRecommendedEvents not implemented")
+}
+func (UnimplementedEventServiceServer) RegisterForEvent(context.Context, *RegisterForEventRequest) (*RegisterForEventResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterForEvent not implemented")
+}
+func (UnimplementedEventServiceServer) AddEventOrganizer(context.Context, *AddEventOrganizerRequest) (*AddEventOrganizerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddEventOrganizer not implemented")
+}
+func (UnimplementedEventServiceServer) RemoveEventOrganizer(context.Context, *RemoveEventOrganizerRequest) (*RemoveEventOrganizerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveEventOrganizer not implemented")
+}
+func (UnimplementedEventServiceServer) GetEventOrganizers(context.Context, *GetEventOrganizersRequest) (*GetEventOrganizersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEventOrganizers not implemented")
+}
+func (UnimplementedEventServiceServer) CheckEventOrganizer(context.Context, *CheckEventOrganizerRequest) (*CheckEventOrganizerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckEventOrganizer not implemented")
+}
+func (UnimplementedEventServiceServer) GetOrganizedEvents(context.Context, *GetOrganizedEventsRequest) (*GetOrganizedEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrganizedEvents not implemented")
+}
+func (UnimplementedEventServiceServer) DuplicateEvent(context.Context, *DuplicateEventRequest) (*DuplicateEventResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DuplicateEvent not implemented")
+}
+func (UnimplementedEventServiceServer) CreateEventTemplate(context.Context, *CreateEventTemplateRequest) (*CreateEventTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateEventTemplate not implemented")
+}
+func (UnimplementedEventServiceServer) GetEventTemplates(context.Context, *GetEventTemplatesRequest) (*GetEventTemplatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEventTemplates not implemented")
+}
+func (UnimplementedEventServiceServer) DeleteEventTemplate(context.Context, *DeleteEventTemplateRequest) (*DeleteEventTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteEventTemplate not implemented")
+}
+func (UnimplementedEventServiceServer) CreateEventFromTemplate(context.Context, *CreateEventFromTemplateRequest) (*CreateEventFromTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateEventFromTemplate not implemented")
+}
+func (UnimplementedEventServiceServer) CreateVenue(context.Context, *CreateVenueRequest) (*CreateVenueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateVenue not implemented")
+}
+func (UnimplementedEventServiceServer) GetVenues(context.Context, *GetVenuesRequest) (*GetVenuesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVenues not implemented")
+}
+func (UnimplementedEventServiceServer) DeleteVenue(context.Context, *DeleteVenueRequest) (*DeleteVenueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteVenue not implemented")
+}
+func (UnimplementedEventServiceServer) CreateRoom(context.Context, *CreateRoomRequest) (*CreateRoomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRoom not implemented")
+}
+func (UnimplementedEventServiceServer) EditRoom(context.Context, *EditRoomRequest) (*EditRoomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EditRoom not implemented")
+}
+func (UnimplementedEventServiceServer) DeleteRoom(context.Context, *DeleteRoomRequest) (*DeleteRoomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRoom not implemented")
+}
+func (UnimplementedEventServiceServer) GetRoomAvailability(context.Context, *GetRoomAvailabilityRequest) (*GetRoomAvailabilityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRoomAvailability not implemented")
+}
+func (UnimplementedEventServiceServer) CreateVolunteerRole(context.Context, *CreateVolunteerRoleRequest) (*CreateVolunteerRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateVolunteerRole not implemented")
+}
+func (UnimplementedEventServiceServer) DeleteVolunteerRole(context.Context, *DeleteVolunteerRoleRequest) (*DeleteVolunteerRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteVolunteerRole not implemented")
+}
+func (UnimplementedEventServiceServer) CreateVolunteerShift(context.Context, *CreateVolunteerShiftRequest) (*CreateVolunteerShiftResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateVolunteerShift not implemented")
+}
+func (UnimplementedEventServiceServer) EditVolunteerShift(context.Context, *EditVolunteerShiftRequest) (*EditVolunteerShiftResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EditVolunteerShift not implemented")
+}
+func (UnimplementedEventServiceServer) DeleteVolunteerShift(context.Context, *DeleteVolunteerShiftRequest) (*DeleteVolunteerShiftResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteVolunteerShift not implemented")
+}
+func (UnimplementedEventServiceServer) GetVolunteerShifts(context.Context, *GetVolunteerShiftsRequest) (*GetVolunteerShiftsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVolunteerShifts not implemented")
+}
+func (UnimplementedEventServiceServer) GetVolunteerRoster(context.Context, *GetVolunteerRosterRequest) (*GetVolunteerRosterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVolunteerRoster not implemented")
+}
+func (UnimplementedEventServiceServer) SignUpForShift(context.Context, *SignUpForShiftRequest) (*SignUpForShiftResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignUpForShift not implemented")
+}
+func (UnimplementedEventServiceServer) CancelShiftSignup(context.Context, *CancelShiftSignupRequest) (*CancelShiftSignupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelShiftSignup not implemented")
+}
+func (UnimplementedEventServiceServer) SubmitProject(context.Context, *SubmitProjectRequest) (*SubmitProjectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitProject not implemented")
+}
+func (UnimplementedEventServiceServer) GetEventSubmissions(context.Context, *GetEventSubmissionsRequest) (*GetEventSubmissionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEventSubmissions not implemented")
+}
+func (UnimplementedEventServiceServer) SetSubmissionContent(context.Context, *SetSubmissionContentRequest) (*SetSubmissionContentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSubmissionContent not implemented")
+}
+func (UnimplementedEventServiceServer) AddJudge(context.Context, *AddJudgeRequest) (*AddJudgeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddJudge not implemented")
+}
+func (UnimplementedEventServiceServer) RemoveJudge(context.Context, *RemoveJudgeRequest) (*RemoveJudgeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveJudge not implemented")
+}
+func (UnimplementedEventServiceServer) GetEventJudges(context.Context, *GetEventJudgesRequest) (*GetEventJudgesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEventJudges not implemented")
+}
+func (UnimplementedEventServiceServer) CreateCriterion(context.Context, *CreateCriterionRequest) (*CreateCriterionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCriterion not implemented")
+}
+func (UnimplementedEventServiceServer) EditCriterion(context.Context, *EditCriterionRequest) (*EditCriterionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EditCriterion not implemented")
+}
+func (UnimplementedEventServiceServer) DeleteCriterion(context.Context, *DeleteCriterionRequest) (*DeleteCriterionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCriterion not implemented")
+}
+func (UnimplementedEventServiceServer) GetEventCriteria(context.Context, *GetEventCriteriaRequest) (*GetEventCriteriaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEventCriteria not implemented")
+}
+func (UnimplementedEventServiceServer) ScoreSubmission(context.Context, *ScoreSubmissionRequest) (*ScoreSubmissionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScoreSubmission not implemented")
+}
+func (UnimplementedEventServiceServer) GetLeaderboard(context.Context, *GetLeaderboardRequest) (*GetLeaderboardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLeaderboard not implemented")
+}
+func (UnimplementedEventServiceServer) SetGuestRegistration(context.Context, *SetGuestRegistrationRequest) (*SetGuestRegistrationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetGuestRegistration not implemented")
+}
+func (UnimplementedEventServiceServer) RegisterGuest(context.Context, *RegisterGuestRequest) (*RegisterGuestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterGuest not implemented")
+}
+func (UnimplementedEventServiceServer) ConfirmGuestRegistration(context.Context, *ConfirmGuestRegistrationRequest) (*ConfirmGuestRegistrationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmGuestRegistration not implemented")
+}
+func (UnimplementedEventServiceServer) ClaimGuestRegistrations(context.Context, *ClaimGuestRegistrationsRequest) (*ClaimGuestRegistrationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimGuestRegistrations not implemented")
+}
+func (UnimplementedEventServiceServer) RecordNewsletterSend(context.Context, *RecordNewsletterSendRequest) (*RecordNewsletterSendResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordNewsletterSend not implemented")
+}
+func (UnimplementedEventServiceServer) GetEventAnalytics(context.Context, *GetEventAnalyticsRequest) (*GetEventAnalyticsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEventAnalytics not implemented")
+}
+func (UnimplementedEventServiceServer) GetAnalyticsOverview(context.Context, *GetAnalyticsOverviewRequest) (*GetAnalyticsOverviewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAnalyticsOverview not implemented")
+}
+func (UnimplementedEventServiceServer) SearchEvents(context.Context, *SearchEventsRequest) (*SearchEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchEvents not implemented")
+}
+func (UnimplementedEventServiceServer) GetEventRegistrations(context.Context, *GetEventRegistrationsRequest) (*GetEventRegistrationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEventRegistrations not implemented")
+}
+func (UnimplementedEventServiceServer) ExportEventRegistrations(context.Context, *ExportEventRegistrationsRequest) (*ExportEventRegistrationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportEventRegistrations not implemented")
+}
+func (UnimplementedEventServiceServer) GetEventReport(context.Context, *GetEventReportRequest) (*GetEventReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEventReport not implemented")
+}
+func (UnimplementedEventServiceServer) SubmitFeedback(context.Context, *SubmitFeedbackRequest) (*SubmitFeedbackResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitFeedback not implemented")
+}
+func (UnimplementedEventServiceServer) GetEventFeedback(context.Context, *GetEventFeedbackRequest) (*GetEventFeedbackResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEventFeedback not implemented")
+}
+func (UnimplementedEventServiceServer) CreateEventSeries(context.Context, *CreateEventSeriesRequest) (*CreateEventSeriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateEventSeries not implemented")
+}
+func (UnimplementedEventServiceServer) GetEventSeries(context.Context, *GetEventSeriesRequest) (*GetEventSeriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEventSeries not implemented")
+}
+func (UnimplementedEventServiceServer) CancelOccurrence(context.Context, *CancelOccurrenceRequest) (*CancelOccurrenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelOccurrence not implemented")
+}
+func (UnimplementedEventServiceServer) RegisterForSeries(context.Context, *RegisterForSeriesRequest) (*RegisterForSeriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterForSeries not implemented")
+}
+func (UnimplementedEventServiceServer) GetSeriesAttendance(context.Context, *GetSeriesAttendanceRequest) (*GetSeriesAttendanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSeriesAttendance not implemented")
+}
+func (UnimplementedEventServiceServer) CreateSpeaker(context.Context, *CreateSpeakerRequest) (*CreateSpeakerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSpeaker not implemented")
+}
+func (UnimplementedEventServiceServer) EditSpeaker(context.Context, *EditSpeakerRequest) (*EditSpeakerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EditSpeaker not implemented")
+}
+func (UnimplementedEventServiceServer) DeleteSpeaker(context.Context, *DeleteSpeakerRequest) (*DeleteSpeakerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSpeaker not implemented")
+}
+func (UnimplementedEventServiceServer) CreateSession(context.Context, *CreateSessionRequest) (*CreateSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSession not implemented")
+}
+func (UnimplementedEventServiceServer) EditSession(context.Context, *EditSessionRequest) (*EditSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EditSession not implemented")
+}
+func (UnimplementedEventServiceServer) DeleteSession(context.Context, *DeleteSessionRequest) (*DeleteSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSession not implemented")
+}
+func (UnimplementedEventServiceServer) GetEventAgenda(context.Context, *GetEventAgendaRequest) (*GetEventAgendaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEventAgenda not implemented")
+}
+func (UnimplementedEventServiceServer) BookmarkSession(context.Context, *BookmarkSessionRequest) (*BookmarkSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BookmarkSession not implemented")
+}
+func (UnimplementedEventServiceServer) RemoveBookmark(context.Context, *RemoveBookmarkRequest) (*RemoveBookmarkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveBookmark not implemented")
+}
+func (UnimplementedEventServiceServer) GetUserAgenda(context.Context, *GetUserAgendaRequest) (*GetUserAgendaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserAgenda not implemented")
+}
+func (UnimplementedEventServiceServer) GetEventUserRegistration(context.Context, *GetEventUserRegistrationRequest) (*GetEventUserRegistrationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEventUserRegistration not implemented")
+}
+func (UnimplementedEventServiceServer) GetUserEvents(context.Context, *GetUserEventsRequest) (*GetUserEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserEvents not implemented")
+}
+func (UnimplementedEventServiceServer) EditRegistration(context.Context, *EditRegistrationRequest) (*EditRegistrationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EditRegistration not implemented")
+}
+func (UnimplementedEventServiceServer) GetTicket(context.Context, *GetTicketRequest) (*GetTicketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTicket not implemented")
+}
+func (UnimplementedEventServiceServer) CheckIn(context.Context, *CheckInRequest) (*CheckInResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckIn not implemented")
+}
+func (UnimplementedEventServiceServer) IssueCertificates(context.Context, *IssueCertificatesRequest) (*IssueCertificatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IssueCertificates not implemented")
+}
+func (UnimplementedEventServiceServer) GetUserCertificate(context.Context, *GetUserCertificateRequest) (*GetUserCertificateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserCertificate not implemented")
+}
+func (UnimplementedEventServiceServer) GetCertificate(context.Context, *GetCertificateRequest) (*GetCertificateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCertificate not implemented")
+}
+func (UnimplementedEventServiceServer) VerifyCertificate(context.Context, *VerifyCertificateRequest) (*VerifyCertificateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyCertificate not implemented")
+}
+func (UnimplementedEventServiceServer) CreateTeam(context.Context, *CreateTeamRequest) (*CreateTeamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTeam not implemented")
+}
+func (UnimplementedEventServiceServer) InviteTeamMember(context.Context, *InviteTeamMemberRequest) (*InviteTeamMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InviteTeamMember not implemented")
+}
+func (UnimplementedEventServiceServer) RespondTeamInvite(context.Context, *RespondTeamInviteRequest) (*RespondTeamInviteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RespondTeamInvite not implemented")
+}
+func (UnimplementedEventServiceServer) TransferTeamCaptain(context.Context, *TransferTeamCaptainRequest) (*TransferTeamCaptainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferTeamCaptain not implemented")
+}
+func (UnimplementedEventServiceServer) DisbandTeam(context.Context, *DisbandTeamRequest) (*DisbandTeamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisbandTeam not implemented")
+}
+func (UnimplementedEventServiceServer) GetUserTeam(context.Context, *GetUserTeamRequest) (*GetUserTeamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserTeam not implemented")
+}
+func (UnimplementedEventServiceServer) GetUserTeamInvites(context.Context, *GetUserTeamInvitesRequest) (*GetUserTeamInvitesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserTeamInvites not implemented")
+}
+func (UnimplementedEventServiceServer) GetEventTeams(context.Context, *GetEventTeamsRequest) (*GetEventTeamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEventTeams not implemented")
+}
+func (UnimplementedEventServiceServer) OptInTeamMatching(context.Context, *OptInTeamMatchingRequest) (*OptInTeamMatchingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OptInTeamMatching not implemented")
+}
+func (UnimplementedEventServiceServer) OptOutTeamMatching(context.Context, *OptOutTeamMatchingRequest) (*OptOutTeamMatchingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OptOutTeamMatching not implemented")
+}
+func (UnimplementedEventServiceServer) MatchTeams(context.Context, *MatchTeamsRequest) (*MatchTeamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MatchTeams not implemented")
+}
+
+// UnsafeEventServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to EventServiceServer will
+// result in compilation errors.
+type UnsafeEventServiceServer interface {
+	mustEmbedUnimplementedEventServiceServer()
+}
+
+func RegisterEventServiceServer(s grpc.ServiceRegistrar, srv EventServiceServer) {
+	s.RegisterService(&EventService_ServiceDesc, srv)
+}
+
+func _EventService_CreateEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).CreateEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_CreateEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).CreateEvent(ctx, req.(*CreateEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_EditEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EditEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).EditEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_EditEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).EditEvent(ctx, req.(*EditEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_DeleteEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).DeleteEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_DeleteEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).DeleteEvent(ctx, req.(*DeleteEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_CancelEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).CancelEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_CancelEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).CancelEvent(ctx, req.(*CancelEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_GetEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).GetEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_GetEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).GetEvent(ctx, req.(*GetEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_GetEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).GetEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_GetEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).GetEvents(ctx, req.(*GetEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_GetRecommendedEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRecommendedEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).GetRecommendedEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_GetRecommendedEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).GetRecommendedEvents(ctx, req.(*GetRecommendedEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_RegisterForEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterForEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).RegisterForEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_RegisterForEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).RegisterForEvent(ctx, req.(*RegisterForEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_AddEventOrganizer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddEventOrganizerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).AddEventOrganizer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_AddEventOrganizer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).AddEventOrganizer(ctx, req.(*AddEventOrganizerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_RemoveEventOrganizer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveEventOrganizerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).RemoveEventOrganizer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_RemoveEventOrganizer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).RemoveEventOrganizer(ctx, req.(*RemoveEventOrganizerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_GetEventOrganizers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEventOrganizersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).GetEventOrganizers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_GetEventOrganizers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).GetEventOrganizers(ctx, req.(*GetEventOrganizersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_CheckEventOrganizer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckEventOrganizerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).CheckEventOrganizer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_CheckEventOrganizer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).CheckEventOrganizer(ctx, req.(*CheckEventOrganizerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_GetOrganizedEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrganizedEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).GetOrganizedEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_GetOrganizedEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).GetOrganizedEvents(ctx, req.(*GetOrganizedEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_DuplicateEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DuplicateEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).DuplicateEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_DuplicateEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).DuplicateEvent(ctx, req.(*DuplicateEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_CreateEventTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateEventTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).CreateEventTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_CreateEventTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).CreateEventTemplate(ctx, req.(*CreateEventTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_GetEventTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEventTemplatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).GetEventTemplates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_GetEventTemplates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).GetEventTemplates(ctx, req.(*GetEventTemplatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_DeleteEventTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteEventTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).DeleteEventTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_DeleteEventTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).DeleteEventTemplate(ctx, req.(*DeleteEventTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_CreateEventFromTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateEventFromTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).CreateEventFromTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_CreateEventFromTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).CreateEventFromTemplate(ctx, req.(*CreateEventFromTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_CreateVenue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateVenueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).CreateVenue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_CreateVenue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).CreateVenue(ctx, req.(*CreateVenueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_GetVenues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVenuesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).GetVenues(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_GetVenues_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).GetVenues(ctx, req.(*GetVenuesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_DeleteVenue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteVenueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).DeleteVenue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_DeleteVenue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).DeleteVenue(ctx, req.(*DeleteVenueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_CreateRoom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRoomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).CreateRoom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_CreateRoom_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).CreateRoom(ctx, req.(*CreateRoomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_EditRoom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EditRoomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).EditRoom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_EditRoom_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).EditRoom(ctx, req.(*EditRoomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_DeleteRoom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRoomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).DeleteRoom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_DeleteRoom_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).DeleteRoom(ctx, req.(*DeleteRoomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_GetRoomAvailability_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRoomAvailabilityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).GetRoomAvailability(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_GetRoomAvailability_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).GetRoomAvailability(ctx, req.(*GetRoomAvailabilityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_CreateVolunteerRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateVolunteerRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).CreateVolunteerRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_CreateVolunteerRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).CreateVolunteerRole(ctx, req.(*CreateVolunteerRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_DeleteVolunteerRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteVolunteerRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).DeleteVolunteerRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_DeleteVolunteerRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).DeleteVolunteerRole(ctx, req.(*DeleteVolunteerRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_CreateVolunteerShift_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateVolunteerShiftRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).CreateVolunteerShift(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_CreateVolunteerShift_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).CreateVolunteerShift(ctx, req.(*CreateVolunteerShiftRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_EditVolunteerShift_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EditVolunteerShiftRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).EditVolunteerShift(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_EditVolunteerShift_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).EditVolunteerShift(ctx, req.(*EditVolunteerShiftRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_DeleteVolunteerShift_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteVolunteerShiftRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).DeleteVolunteerShift(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_DeleteVolunteerShift_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).DeleteVolunteerShift(ctx, req.(*DeleteVolunteerShiftRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_GetVolunteerShifts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVolunteerShiftsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).GetVolunteerShifts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_GetVolunteerShifts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).GetVolunteerShifts(ctx, req.(*GetVolunteerShiftsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_GetVolunteerRoster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVolunteerRosterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).GetVolunteerRoster(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_GetVolunteerRoster_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).GetVolunteerRoster(ctx, req.(*GetVolunteerRosterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_SignUpForShift_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignUpForShiftRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).SignUpForShift(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_SignUpForShift_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).SignUpForShift(ctx, req.(*SignUpForShiftRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_CancelShiftSignup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelShiftSignupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).CancelShiftSignup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_CancelShiftSignup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).CancelShiftSignup(ctx, req.(*CancelShiftSignupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_SubmitProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitProjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).SubmitProject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_SubmitProject_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).SubmitProject(ctx, req.(*SubmitProjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_GetEventSubmissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEventSubmissionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).GetEventSubmissions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_GetEventSubmissions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).GetEventSubmissions(ctx, req.(*GetEventSubmissionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_SetSubmissionContent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetSubmissionContentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).SetSubmissionContent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_SetSubmissionContent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).SetSubmissionContent(ctx, req.(*SetSubmissionContentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_AddJudge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddJudgeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).AddJudge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_AddJudge_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).AddJudge(ctx, req.(*AddJudgeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_RemoveJudge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveJudgeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).RemoveJudge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_RemoveJudge_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).RemoveJudge(ctx, req.(*RemoveJudgeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_GetEventJudges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEventJudgesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).GetEventJudges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_GetEventJudges_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).GetEventJudges(ctx, req.(*GetEventJudgesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_CreateCriterion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCriterionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).CreateCriterion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_CreateCriterion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).CreateCriterion(ctx, req.(*CreateCriterionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_EditCriterion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EditCriterionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).EditCriterion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_EditCriterion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).EditCriterion(ctx, req.(*EditCriterionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_DeleteCriterion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCriterionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).DeleteCriterion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_DeleteCriterion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).DeleteCriterion(ctx, req.(*DeleteCriterionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_GetEventCriteria_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEventCriteriaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).GetEventCriteria(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_GetEventCriteria_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).GetEventCriteria(ctx, req.(*GetEventCriteriaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_ScoreSubmission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScoreSubmissionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).ScoreSubmission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_ScoreSubmission_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).ScoreSubmission(ctx, req.(*ScoreSubmissionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_GetLeaderboard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLeaderboardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).GetLeaderboard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_GetLeaderboard_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).GetLeaderboard(ctx, req.(*GetLeaderboardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_SetGuestRegistration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetGuestRegistrationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).SetGuestRegistration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_SetGuestRegistration_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).SetGuestRegistration(ctx, req.(*SetGuestRegistrationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_RegisterGuest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterGuestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).RegisterGuest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_RegisterGuest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).RegisterGuest(ctx, req.(*RegisterGuestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_ConfirmGuestRegistration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmGuestRegistrationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).ConfirmGuestRegistration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_ConfirmGuestRegistration_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).ConfirmGuestRegistration(ctx, req.(*ConfirmGuestRegistrationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_ClaimGuestRegistrations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClaimGuestRegistrationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).ClaimGuestRegistrations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_ClaimGuestRegistrations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).ClaimGuestRegistrations(ctx, req.(*ClaimGuestRegistrationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_RecordNewsletterSend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordNewsletterSendRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).RecordNewsletterSend(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_RecordNewsletterSend_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).RecordNewsletterSend(ctx, req.(*RecordNewsletterSendRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_GetEventAnalytics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEventAnalyticsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).GetEventAnalytics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_GetEventAnalytics_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).GetEventAnalytics(ctx, req.(*GetEventAnalyticsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_GetAnalyticsOverview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAnalyticsOverviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).GetAnalyticsOverview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_GetAnalyticsOverview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).GetAnalyticsOverview(ctx, req.(*GetAnalyticsOverviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_SearchEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).SearchEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_SearchEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).SearchEvents(ctx, req.(*SearchEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_GetEventRegistrations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEventRegistrationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).GetEventRegistrations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_GetEventRegistrations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).GetEventRegistrations(ctx, req.(*GetEventRegistrationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_ExportEventRegistrations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportEventRegistrationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).ExportEventRegistrations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_ExportEventRegistrations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).ExportEventRegistrations(ctx, req.(*ExportEventRegistrationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_GetEventReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEventReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).GetEventReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_GetEventReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).GetEventReport(ctx, req.(*GetEventReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_SubmitFeedback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitFeedbackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).SubmitFeedback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_SubmitFeedback_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).SubmitFeedback(ctx, req.(*SubmitFeedbackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_GetEventFeedback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEventFeedbackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).GetEventFeedback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_GetEventFeedback_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).GetEventFeedback(ctx, req.(*GetEventFeedbackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_CreateEventSeries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateEventSeriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).CreateEventSeries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_CreateEventSeries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).CreateEventSeries(ctx, req.(*CreateEventSeriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_GetEventSeries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEventSeriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).GetEventSeries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_GetEventSeries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).GetEventSeries(ctx, req.(*GetEventSeriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_CancelOccurrence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelOccurrenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).CancelOccurrence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_CancelOccurrence_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).CancelOccurrence(ctx, req.(*CancelOccurrenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_RegisterForSeries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterForSeriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).RegisterForSeries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_RegisterForSeries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).RegisterForSeries(ctx, req.(*RegisterForSeriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_GetSeriesAttendance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSeriesAttendanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).GetSeriesAttendance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_GetSeriesAttendance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).GetSeriesAttendance(ctx, req.(*GetSeriesAttendanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_CreateSpeaker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSpeakerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).CreateSpeaker(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_CreateSpeaker_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).CreateSpeaker(ctx, req.(*CreateSpeakerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_EditSpeaker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EditSpeakerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).EditSpeaker(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_EditSpeaker_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).EditSpeaker(ctx, req.(*EditSpeakerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_DeleteSpeaker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSpeakerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).DeleteSpeaker(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_DeleteSpeaker_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).DeleteSpeaker(ctx, req.(*DeleteSpeakerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_CreateSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).CreateSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_CreateSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).CreateSession(ctx, req.(*CreateSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_EditSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EditSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).EditSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_EditSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).EditSession(ctx, req.(*EditSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_DeleteSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).DeleteSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_DeleteSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).DeleteSession(ctx, req.(*DeleteSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_GetEventAgenda_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEventAgendaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).GetEventAgenda(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_GetEventAgenda_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).GetEventAgenda(ctx, req.(*GetEventAgendaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_BookmarkSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BookmarkSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).BookmarkSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_BookmarkSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).BookmarkSession(ctx, req.(*BookmarkSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_RemoveBookmark_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveBookmarkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).RemoveBookmark(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_RemoveBookmark_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).RemoveBookmark(ctx, req.(*RemoveBookmarkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_GetUserAgenda_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserAgendaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).GetUserAgenda(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_GetUserAgenda_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).GetUserAgenda(ctx, req.(*GetUserAgendaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_GetEventUserRegistration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEventUserRegistrationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).GetEventUserRegistration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_GetEventUserRegistration_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).GetEventUserRegistration(ctx, req.(*GetEventUserRegistrationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_GetUserEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).GetUserEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_GetUserEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).GetUserEvents(ctx, req.(*GetUserEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_EditRegistration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EditRegistrationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).EditRegistration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_EditRegistration_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).EditRegistration(ctx, req.(*EditRegistrationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_GetTicket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTicketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).GetTicket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_GetTicket_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).GetTicket(ctx, req.(*GetTicketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_CheckIn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckInRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).CheckIn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_CheckIn_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).CheckIn(ctx, req.(*CheckInRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_IssueCertificates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IssueCertificatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).IssueCertificates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_IssueCertificates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).IssueCertificates(ctx, req.(*IssueCertificatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_GetUserCertificate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserCertificateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).GetUserCertificate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_GetUserCertificate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).GetUserCertificate(ctx, req.(*GetUserCertificateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_GetCertificate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCertificateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).GetCertificate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_GetCertificate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).GetCertificate(ctx, req.(*GetCertificateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// UnsafeEventServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to EventServiceServer will
-// result in compilation errors.
-type UnsafeEventServiceServer interface {
-	mustEmbedUnimplementedEventServiceServer()
+func _EventService_VerifyCertificate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyCertificateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).VerifyCertificate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_VerifyCertificate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).VerifyCertificate(ctx, req.(*VerifyCertificateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterEventServiceServer(s grpc.ServiceRegistrar, srv EventServiceServer) {
-	s.RegisterService(&EventService_ServiceDesc, srv)
+func _EventService_CreateTeam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTeamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).CreateTeam(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_CreateTeam_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).CreateTeam(ctx, req.(*CreateTeamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _EventService_CreateEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateEventRequest)
+func _EventService_InviteTeamMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InviteTeamMemberRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(EventServiceServer).CreateEvent(ctx, in)
+		return srv.(EventServiceServer).InviteTeamMember(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: EventService_CreateEvent_FullMethodName,
+		FullMethod: EventService_InviteTeamMember_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(EventServiceServer).CreateEvent(ctx, req.(*CreateEventRequest))
+		return srv.(EventServiceServer).InviteTeamMember(ctx, req.(*InviteTeamMemberRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _EventService_EditEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EditEventRequest)
+func _EventService_RespondTeamInvite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RespondTeamInviteRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(EventServiceServer).EditEvent(ctx, in)
+		return srv.(EventServiceServer).RespondTeamInvite(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: EventService_EditEvent_FullMethodName,
+		FullMethod: EventService_RespondTeamInvite_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(EventServiceServer).EditEvent(ctx, req.(*EditEventRequest))
+		return srv.(EventServiceServer).RespondTeamInvite(ctx, req.(*RespondTeamInviteRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _EventService_DeleteEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteEventRequest)
+func _EventService_TransferTeamCaptain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferTeamCaptainRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(EventServiceServer).DeleteEvent(ctx, in)
+		return srv.(EventServiceServer).TransferTeamCaptain(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: EventService_DeleteEvent_FullMethodName,
+		FullMethod: EventService_TransferTeamCaptain_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(EventServiceServer).DeleteEvent(ctx, req.(*DeleteEventRequest))
+		return srv.(EventServiceServer).TransferTeamCaptain(ctx, req.(*TransferTeamCaptainRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _EventService_GetEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetEventRequest)
+func _EventService_DisbandTeam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisbandTeamRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(EventServiceServer).GetEvent(ctx, in)
+		return srv.(EventServiceServer).DisbandTeam(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: EventService_GetEvent_FullMethodName,
+		FullMethod: EventService_DisbandTeam_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(EventServiceServer).GetEvent(ctx, req.(*GetEventRequest))
+		return srv.(EventServiceServer).DisbandTeam(ctx, req.(*DisbandTeamRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _EventService_GetEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
+func _EventService_GetUserTeam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserTeamRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(EventServiceServer).GetEvents(ctx, in)
+		return srv.(EventServiceServer).GetUserTeam(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: EventService_GetEvents_FullMethodName,
+		FullMethod: EventService_GetUserTeam_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(EventServiceServer).GetEvents(ctx, req.(*emptypb.Empty))
+		return srv.(EventServiceServer).GetUserTeam(ctx, req.(*GetUserTeamRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _EventService_RegisterForEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RegisterForEventRequest)
+func _EventService_GetUserTeamInvites_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserTeamInvitesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(EventServiceServer).RegisterForEvent(ctx, in)
+		return srv.(EventServiceServer).GetUserTeamInvites(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: EventService_RegisterForEvent_FullMethodName,
+		FullMethod: EventService_GetUserTeamInvites_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(EventServiceServer).RegisterForEvent(ctx, req.(*RegisterForEventRequest))
+		return srv.(EventServiceServer).GetUserTeamInvites(ctx, req.(*GetUserTeamInvitesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _EventService_GetEventRegistrations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetEventRegistrationsRequest)
+func _EventService_GetEventTeams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEventTeamsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(EventServiceServer).GetEventRegistrations(ctx, in)
+		return srv.(EventServiceServer).GetEventTeams(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: EventService_GetEventRegistrations_FullMethodName,
+		FullMethod: EventService_GetEventTeams_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(EventServiceServer).GetEventRegistrations(ctx, req.(*GetEventRegistrationsRequest))
+		return srv.(EventServiceServer).GetEventTeams(ctx, req.(*GetEventTeamsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _EventService_GetEventUserRegistration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetEventUserRegistrationRequest)
+func _EventService_OptInTeamMatching_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OptInTeamMatchingRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(EventServiceServer).GetEventUserRegistration(ctx, in)
+		return srv.(EventServiceServer).OptInTeamMatching(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: EventService_GetEventUserRegistration_FullMethodName,
+		FullMethod: EventService_OptInTeamMatching_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(EventServiceServer).GetEventUserRegistration(ctx, req.(*GetEventUserRegistrationRequest))
+		return srv.(EventServiceServer).OptInTeamMatching(ctx, req.(*OptInTeamMatchingRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _EventService_GetUserEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetUserEventsRequest)
+func _EventService_OptOutTeamMatching_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OptOutTeamMatchingRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(EventServiceServer).GetUserEvents(ctx, in)
+		return srv.(EventServiceServer).OptOutTeamMatching(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: EventService_GetUserEvents_FullMethodName,
+		FullMethod: EventService_OptOutTeamMatching_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(EventServiceServer).GetUserEvents(ctx, req.(*GetUserEventsRequest))
+		return srv.(EventServiceServer).OptOutTeamMatching(ctx, req.(*OptOutTeamMatchingRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _EventService_EditRegistration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EditRegistrationRequest)
+func _EventService_MatchTeams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MatchTeamsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(EventServiceServer).EditRegistration(ctx, in)
+		return srv.(EventServiceServer).MatchTeams(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: EventService_EditRegistration_FullMethodName,
+		FullMethod: EventService_MatchTeams_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(EventServiceServer).EditRegistration(ctx, req.(*EditRegistrationRequest))
+		return srv.(EventServiceServer).MatchTeams(ctx, req.(*MatchTeamsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -407,6 +3178,10 @@ var EventService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteEvent",
 			Handler:    _EventService_DeleteEvent_Handler,
 		},
+		{
+			MethodName: "CancelEvent",
+			Handler:    _EventService_CancelEvent_Handler,
+		},
 		{
 			MethodName: "GetEvent",
 			Handler:    _EventService_GetEvent_Handler,
@@ -415,14 +3190,278 @@ var EventService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetEvents",
 			Handler:    _EventService_GetEvents_Handler,
 		},
+		{
+			MethodName: "GetRecommendedEvents",
+			Handler:    _EventService_GetRecommendedEvents_Handler,
+		},
 		{
 			MethodName: "RegisterForEvent",
 			Handler:    _EventService_RegisterForEvent_Handler,
 		},
+		{
+			MethodName: "AddEventOrganizer",
+			Handler:    _EventService_AddEventOrganizer_Handler,
+		},
+		{
+			MethodName: "RemoveEventOrganizer",
+			Handler:    _EventService_RemoveEventOrganizer_Handler,
+		},
+		{
+			MethodName: "GetEventOrganizers",
+			Handler:    _EventService_GetEventOrganizers_Handler,
+		},
+		{
+			MethodName: "CheckEventOrganizer",
+			Handler:    _EventService_CheckEventOrganizer_Handler,
+		},
+		{
+			MethodName: "GetOrganizedEvents",
+			Handler:    _EventService_GetOrganizedEvents_Handler,
+		},
+		{
+			MethodName: "DuplicateEvent",
+			Handler:    _EventService_DuplicateEvent_Handler,
+		},
+		{
+			MethodName: "CreateEventTemplate",
+			Handler:    _EventService_CreateEventTemplate_Handler,
+		},
+		{
+			MethodName: "GetEventTemplates",
+			Handler:    _EventService_GetEventTemplates_Handler,
+		},
+		{
+			MethodName: "DeleteEventTemplate",
+			Handler:    _EventService_DeleteEventTemplate_Handler,
+		},
+		{
+			MethodName: "CreateEventFromTemplate",
+			Handler:    _EventService_CreateEventFromTemplate_Handler,
+		},
+		{
+			MethodName: "CreateVenue",
+			Handler:    _EventService_CreateVenue_Handler,
+		},
+		{
+			MethodName: "GetVenues",
+			Handler:    _EventService_GetVenues_Handler,
+		},
+		{
+			MethodName: "DeleteVenue",
+			Handler:    _EventService_DeleteVenue_Handler,
+		},
+		{
+			MethodName: "CreateRoom",
+			Handler:    _EventService_CreateRoom_Handler,
+		},
+		{
+			MethodName: "EditRoom",
+			Handler:    _EventService_EditRoom_Handler,
+		},
+		{
+			MethodName: "DeleteRoom",
+			Handler:    _EventService_DeleteRoom_Handler,
+		},
+		{
+			MethodName: "GetRoomAvailability",
+			Handler:    _EventService_GetRoomAvailability_Handler,
+		},
+		{
+			MethodName: "CreateVolunteerRole",
+			Handler:    _EventService_CreateVolunteerRole_Handler,
+		},
+		{
+			MethodName: "DeleteVolunteerRole",
+			Handler:    _EventService_DeleteVolunteerRole_Handler,
+		},
+		{
+			MethodName: "CreateVolunteerShift",
+			Handler:    _EventService_CreateVolunteerShift_Handler,
+		},
+		{
+			MethodName: "EditVolunteerShift",
+			Handler:    _EventService_EditVolunteerShift_Handler,
+		},
+		{
+			MethodName: "DeleteVolunteerShift",
+			Handler:    _EventService_DeleteVolunteerShift_Handler,
+		},
+		{
+			MethodName: "GetVolunteerShifts",
+			Handler:    _EventService_GetVolunteerShifts_Handler,
+		},
+		{
+			MethodName: "GetVolunteerRoster",
+			Handler:    _EventService_GetVolunteerRoster_Handler,
+		},
+		{
+			MethodName: "SignUpForShift",
+			Handler:    _EventService_SignUpForShift_Handler,
+		},
+		{
+			MethodName: "CancelShiftSignup",
+			Handler:    _EventService_CancelShiftSignup_Handler,
+		},
+		{
+			MethodName: "SubmitProject",
+			Handler:    _EventService_SubmitProject_Handler,
+		},
+		{
+			MethodName: "GetEventSubmissions",
+			Handler:    _EventService_GetEventSubmissions_Handler,
+		},
+		{
+			MethodName: "SetSubmissionContent",
+			Handler:    _EventService_SetSubmissionContent_Handler,
+		},
+		{
+			MethodName: "AddJudge",
+			Handler:    _EventService_AddJudge_Handler,
+		},
+		{
+			MethodName: "RemoveJudge",
+			Handler:    _EventService_RemoveJudge_Handler,
+		},
+		{
+			MethodName: "GetEventJudges",
+			Handler:    _EventService_GetEventJudges_Handler,
+		},
+		{
+			MethodName: "CreateCriterion",
+			Handler:    _EventService_CreateCriterion_Handler,
+		},
+		{
+			MethodName: "EditCriterion",
+			Handler:    _EventService_EditCriterion_Handler,
+		},
+		{
+			MethodName: "DeleteCriterion",
+			Handler:    _EventService_DeleteCriterion_Handler,
+		},
+		{
+			MethodName: "GetEventCriteria",
+			Handler:    _EventService_GetEventCriteria_Handler,
+		},
+		{
+			MethodName: "ScoreSubmission",
+			Handler:    _EventService_ScoreSubmission_Handler,
+		},
+		{
+			MethodName: "GetLeaderboard",
+			Handler:    _EventService_GetLeaderboard_Handler,
+		},
+		{
+			MethodName: "SetGuestRegistration",
+			Handler:    _EventService_SetGuestRegistration_Handler,
+		},
+		{
+			MethodName: "RegisterGuest",
+			Handler:    _EventService_RegisterGuest_Handler,
+		},
+		{
+			MethodName: "ConfirmGuestRegistration",
+			Handler:    _EventService_ConfirmGuestRegistration_Handler,
+		},
+		{
+			MethodName: "ClaimGuestRegistrations",
+			Handler:    _EventService_ClaimGuestRegistrations_Handler,
+		},
+		{
+			MethodName: "RecordNewsletterSend",
+			Handler:    _EventService_RecordNewsletterSend_Handler,
+		},
+		{
+			MethodName: "GetEventAnalytics",
+			Handler:    _EventService_GetEventAnalytics_Handler,
+		},
+		{
+			MethodName: "GetAnalyticsOverview",
+			Handler:    _EventService_GetAnalyticsOverview_Handler,
+		},
+		{
+			MethodName: "SearchEvents",
+			Handler:    _EventService_SearchEvents_Handler,
+		},
 		{
 			MethodName: "GetEventRegistrations",
 			Handler:    _EventService_GetEventRegistrations_Handler,
 		},
+		{
+			MethodName: "ExportEventRegistrations",
+			Handler:    _EventService_ExportEventRegistrations_Handler,
+		},
+		{
+			MethodName: "GetEventReport",
+			Handler:    _EventService_GetEventReport_Handler,
+		},
+		{
+			MethodName: "SubmitFeedback",
+			Handler:    _EventService_SubmitFeedback_Handler,
+		},
+		{
+			MethodName: "GetEventFeedback",
+			Handler:    _EventService_GetEventFeedback_Handler,
+		},
+		{
+			MethodName: "CreateEventSeries",
+			Handler:    _EventService_CreateEventSeries_Handler,
+		},
+		{
+			MethodName: "GetEventSeries",
+			Handler:    _EventService_GetEventSeries_Handler,
+		},
+		{
+			MethodName: "CancelOccurrence",
+			Handler:    _EventService_CancelOccurrence_Handler,
+		},
+		{
+			MethodName: "RegisterForSeries",
+			Handler:    _EventService_RegisterForSeries_Handler,
+		},
+		{
+			MethodName: "GetSeriesAttendance",
+			Handler:    _EventService_GetSeriesAttendance_Handler,
+		},
+		{
+			MethodName: "CreateSpeaker",
+			Handler:    _EventService_CreateSpeaker_Handler,
+		},
+		{
+			MethodName: "EditSpeaker",
+			Handler:    _EventService_EditSpeaker_Handler,
+		},
+		{
+			MethodName: "DeleteSpeaker",
+			Handler:    _EventService_DeleteSpeaker_Handler,
+		},
+		{
+			MethodName: "CreateSession",
+			Handler:    _EventService_CreateSession_Handler,
+		},
+		{
+			MethodName: "EditSession",
+			Handler:    _EventService_EditSession_Handler,
+		},
+		{
+			MethodName: "DeleteSession",
+			Handler:    _EventService_DeleteSession_Handler,
+		},
+		{
+			MethodName: "GetEventAgenda",
+			Handler:    _EventService_GetEventAgenda_Handler,
+		},
+		{
+			MethodName: "BookmarkSession",
+			Handler:    _EventService_BookmarkSession_Handler,
+		},
+		{
+			MethodName: "RemoveBookmark",
+			Handler:    _EventService_RemoveBookmark_Handler,
+		},
+		{
+			MethodName: "GetUserAgenda",
+			Handler:    _EventService_GetUserAgenda_Handler,
+		},
 		{
 			MethodName: "GetEventUserRegistration",
 			Handler:    _EventService_GetEventUserRegistration_Handler,
@@ -435,6 +3474,74 @@ var EventService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "EditRegistration",
 			Handler:    _EventService_EditRegistration_Handler,
 		},
+		{
+			MethodName: "GetTicket",
+			Handler:    _EventService_GetTicket_Handler,
+		},
+		{
+			MethodName: "CheckIn",
+			Handler:    _EventService_CheckIn_Handler,
+		},
+		{
+			MethodName: "IssueCertificates",
+			Handler:    _EventService_IssueCertificates_Handler,
+		},
+		{
+			MethodName: "GetUserCertificate",
+			Handler:    _EventService_GetUserCertificate_Handler,
+		},
+		{
+			MethodName: "GetCertificate",
+			Handler:    _EventService_GetCertificate_Handler,
+		},
+		{
+			MethodName: "VerifyCertificate",
+			Handler:    _EventService_VerifyCertificate_Handler,
+		},
+		{
+			MethodName: "CreateTeam",
+			Handler:    _EventService_CreateTeam_Handler,
+		},
+		{
+			MethodName: "InviteTeamMember",
+			Handler:    _EventService_InviteTeamMember_Handler,
+		},
+		{
+			MethodName: "RespondTeamInvite",
+			Handler:    _EventService_RespondTeamInvite_Handler,
+		},
+		{
+			MethodName: "TransferTeamCaptain",
+			Handler:    _EventService_TransferTeamCaptain_Handler,
+		},
+		{
+			MethodName: "DisbandTeam",
+			Handler:    _EventService_DisbandTeam_Handler,
+		},
+		{
+			MethodName: "GetUserTeam",
+			Handler:    _EventService_GetUserTeam_Handler,
+		},
+		{
+			MethodName: "GetUserTeamInvites",
+			Handler:    _EventService_GetUserTeamInvites_Handler,
+		},
+		{
+			MethodName: "GetEventTeams",
+			Handler:    _EventService_GetEventTeams_Handler,
+		},
+		{
+			MethodName: "OptInTeamMatching",
+			Handler:    _EventService_OptInTeamMatching_Handler,
+		},
+		{
+			MethodName: "OptOutTeamMatching",
+			Handler:    _EventService_OptOutTeamMatching_Handler,
+		},
+		{
+			MethodName: "MatchTeams",
+			Handler:    _EventService_MatchTeams_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "event_svc.proto",
@@ -0,0 +1,165 @@
+package repository
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/catness812/faf-hub-backend/event_service/internal/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type AgendaRepository struct {
+	db *gorm.DB
+}
+
+func NewAgendaRepository(db *gorm.DB) *AgendaRepository {
+	return &AgendaRepository{
+		db: db,
+	}
+}
+
+func (repo *AgendaRepository) SaveSpeaker(speaker *models.Speaker) (uint, error) {
+	err := repo.db.Create(speaker).Error
+	if err != nil {
+		return 0, err
+	}
+	return speaker.ID, nil
+}
+
+func (repo *AgendaRepository) UpdateSpeaker(speaker models.Speaker) error {
+	err := repo.db.Updates(&speaker).Error
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// DeleteSpeaker removes the speaker from their sessions, which stay on the
+// agenda.
+func (repo *AgendaRepository) DeleteSpeaker(speakerID uint) error {
+	return repo.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&models.Session{}).Where("speaker_id = ?", speakerID).Update("speaker_id", nil).Error; err != nil {
+			return err
+		}
+		return tx.Unscoped().Where("id = ?", speakerID).Delete(&models.Speaker{}).Error
+	})
+}
+
+func (repo *AgendaRepository) GetSpeakerByID(speakerID uint) (models.Speaker, error) {
+	var speaker models.Speaker
+	if err := repo.db.Where("id = ?", speakerID).First(&speaker).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return speaker, fmt.Errorf("speaker doesn't exist")
+		} else {
+			return speaker, err
+		}
+	}
+	return speaker, nil
+}
+
+func (repo *AgendaRepository) GetEventSpeakers(eventID uint) ([]models.Speaker, error) {
+	var speakers []models.Speaker
+	if err := repo.db.Where("event_id = ?", eventID).Order("name").Find(&speakers).Error; err != nil {
+		return nil, err
+	}
+	return speakers, nil
+}
+
+func (repo *AgendaRepository) SaveSession(session *models.Session) (uint, error) {
+	err := repo.db.Omit("Speaker").Create(session).Error
+	if err != nil {
+		return 0, err
+	}
+	return session.ID, nil
+}
+
+func (repo *AgendaRepository) UpdateSession(session models.Session) error {
+	err := repo.db.Omit("Speaker").Save(&session).Error
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (repo *AgendaRepository) DeleteSession(sessionID uint) error {
+	return repo.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Unscoped().Where("session_id = ?", sessionID).Delete(&models.SessionBookmark{}).Error; err != nil {
+			return err
+		}
+		return tx.Unscoped().Where("id = ?", sessionID).Delete(&models.Session{}).Error
+	})
+}
+
+func (repo *AgendaRepository) GetSessionByID(sessionID uint) (models.Session, error) {
+	var session models.Session
+	if err := repo.db.Where("id = ?", sessionID).First(&session).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return session, fmt.Errorf("session doesn't exist")
+		} else {
+			return session, err
+		}
+	}
+	return session, nil
+}
+
+func (repo *AgendaRepository) GetEventSessions(eventID uint) ([]models.Session, error) {
+	var sessions []models.Session
+	err := repo.db.Preload("Speaker").
+		Where("event_id = ?", eventID).
+		Order("start_time, room").
+		Find(&sessions).Error
+	if err != nil {
+		return nil, err
+	}
+	return sessions, nil
+}
+
+// GetOverlappingSession returns a session of the event in the same room whose
+// time slot overlaps the given one, ignoring the session being updated.
+func (repo *AgendaRepository) GetOverlappingSession(eventID uint, room string, start time.Time, end time.Time, excludeID uint) (models.Session, bool, error) {
+	var sessions []models.Session
+	err := repo.db.
+		Where("event_id = ? AND LOWER(room) = LOWER(?) AND id <> ?", eventID, room, excludeID).
+		Where("start_time < ? AND end_time > ?", end, start).
+		Limit(1).
+		Find(&sessions).Error
+	if err != nil {
+		return models.Session{}, false, err
+	}
+	if len(sessions) == 0 {
+		return models.Session{}, false, nil
+	}
+	return sessions[0], true, nil
+}
+
+func (repo *AgendaRepository) SaveBookmark(bookmark models.SessionBookmark) error {
+	return repo.db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "session_id"}, {Name: "user_id"}},
+		DoNothing: true,
+	}).Create(&bookmark).Error
+}
+
+func (repo *AgendaRepository) DeleteBookmark(sessionID uint, userID uint) error {
+	res := repo.db.Unscoped().Where("session_id = ? AND user_id = ?", sessionID, userID).Delete(&models.SessionBookmark{})
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return fmt.Errorf("session isn't in your agenda")
+	}
+	return nil
+}
+
+func (repo *AgendaRepository) GetUserAgenda(eventID uint, userID uint) ([]models.Session, error) {
+	var sessions []models.Session
+	err := repo.db.Preload("Speaker").
+		Joins("JOIN session_bookmarks ON session_bookmarks.session_id = sessions.id AND session_bookmarks.deleted_at IS NULL").
+		Where("sessions.event_id = ? AND session_bookmarks.user_id = ?", eventID, userID).
+		Order("sessions.start_time, sessions.room").
+		Find(&sessions).Error
+	if err != nil {
+		return nil, err
+	}
+	return sessions, nil
+}
@@ -0,0 +1,174 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/catness812/faf-hub-backend/event_service/internal/models"
+	"gorm.io/gorm"
+)
+
+// repeatAttendee matches checked in registrations of users who had already
+// checked in at an event that started earlier. Guests have no user to follow.
+const repeatAttendee = "registrations.checked_in_at IS NOT NULL AND registrations.user_id <> 0 AND EXISTS (" +
+	"SELECT 1 FROM registrations AS earlier " +
+	"JOIN events AS earlier_events ON earlier_events.id = earlier.event_id AND earlier_events.deleted_at IS NULL " +
+	"WHERE earlier.user_id = registrations.user_id AND earlier.checked_in_at IS NOT NULL AND earlier.deleted_at IS NULL " +
+	"AND earlier_events.start_date_time < events.start_date_time)"
+
+// recipientBatchSize keeps the bound parameters of a recipients insert well
+// below the Postgres limit.
+const recipientBatchSize = 1000
+
+// monthPeriod groups events by the UTC month they start in.
+const monthPeriod = "to_char(events.start_date_time AT TIME ZONE 'UTC', 'YYYY-MM')"
+
+type AnalyticsRepository struct {
+	db *gorm.DB
+}
+
+func NewAnalyticsRepository(db *gorm.DB) *AnalyticsRepository {
+	return &AnalyticsRepository{
+		db: db,
+	}
+}
+
+// SaveNewsletterSend saves the send together with its recipients, all or
+// nothing.
+func (repo *AnalyticsRepository) SaveNewsletterSend(send models.NewsletterSend, emails []string) error {
+	return repo.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&send).Error; err != nil {
+			return err
+		}
+		if len(emails) == 0 {
+			return nil
+		}
+
+		recipients := make([]models.NewsletterRecipient, 0, len(emails))
+		for _, email := range emails {
+			recipients = append(recipients, models.NewsletterRecipient{SendID: send.ID, Email: email})
+		}
+		return tx.CreateInBatches(&recipients, recipientBatchSize).Error
+	})
+}
+
+func (repo *AnalyticsRepository) GetNewsletterSends(eventID uint) ([]models.NewsletterSend, error) {
+	var sends []models.NewsletterSend
+	if err := repo.db.Where("event_id = ?", eventID).Order("sent_at").Find(&sends).Error; err != nil {
+		return nil, err
+	}
+	return sends, nil
+}
+
+// CountRegistrationsByDeadline groups the registrations of an event by the
+// number of whole days between their creation and the registration deadline.
+// Guests who never confirmed their registration are left out.
+func (repo *AnalyticsRepository) CountRegistrationsByDeadline(eventID uint) ([]models.RegistrationTimelinePoint, error) {
+	var points []models.RegistrationTimelinePoint
+	err := repo.db.Model(&models.Registration{}).
+		Select("FLOOR(EXTRACT(EPOCH FROM events.application_deadline - registrations.created_at) / 86400)::int AS days_before_deadline, "+
+			"COUNT(*) AS registrations").
+		Joins("JOIN events ON events.id = registrations.event_id").
+		Where("registrations.event_id = ? AND registrations.status <> ?", eventID, models.RegistrationPending).
+		Group("days_before_deadline").
+		Order("days_before_deadline DESC").
+		Scan(&points).Error
+	if err != nil {
+		return nil, err
+	}
+	return points, nil
+}
+
+// CountNewsletterRecipients counts the distinct emails the newsletters about
+// an event went out to.
+func (repo *AnalyticsRepository) CountNewsletterRecipients(eventID uint) (int64, error) {
+	var count int64
+	err := repo.db.Model(&models.NewsletterRecipient{}).
+		Joins("JOIN newsletter_sends ON newsletter_sends.id = newsletter_recipients.send_id AND newsletter_sends.deleted_at IS NULL").
+		Where("newsletter_sends.event_id = ?", eventID).
+		Distinct("newsletter_recipients.email").
+		Count(&count).Error
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// CountRegistrationsFromNewsletter counts the registrations made since the
+// given time with an email a newsletter about the event went out to.
+func (repo *AnalyticsRepository) CountRegistrationsFromNewsletter(eventID uint, since time.Time) (int64, error) {
+	var count int64
+	recipients := repo.db.Model(&models.NewsletterRecipient{}).
+		Select("newsletter_recipients.email").
+		Joins("JOIN newsletter_sends ON newsletter_sends.id = newsletter_recipients.send_id AND newsletter_sends.deleted_at IS NULL").
+		Where("newsletter_sends.event_id = ?", eventID)
+	err := repo.db.Model(&models.Registration{}).
+		Where("event_id = ? AND status <> ? AND created_at >= ?", eventID, models.RegistrationPending, since).
+		Where("LOWER(email) IN (?)", recipients).
+		Count(&count).Error
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// CountAttendance returns the confirmed registrations of an event, how many
+// of them checked in, and how many of those had attended an earlier event.
+func (repo *AnalyticsRepository) CountAttendance(eventID uint) (int64, int64, int64, error) {
+	var res struct {
+		Confirmed       int64
+		CheckedIn       int64
+		RepeatAttendees int64
+	}
+	err := repo.db.Model(&models.Registration{}).
+		Select("COUNT(*) AS confirmed, COUNT(registrations.checked_in_at) AS checked_in, "+
+			"COUNT(*) FILTER (WHERE "+repeatAttendee+") AS repeat_attendees").
+		Joins("JOIN events ON events.id = registrations.event_id").
+		Where("registrations.event_id = ? AND registrations.status = ?", eventID, models.RegistrationConfirmed).
+		Scan(&res).Error
+	if err != nil {
+		return 0, 0, 0, err
+	}
+	return res.Confirmed, res.CheckedIn, res.RepeatAttendees, nil
+}
+
+// GetAnalyticsPeriods sums up the events that weren't cancelled and started
+// between from and to, month by month. Months without events are skipped.
+func (repo *AnalyticsRepository) GetAnalyticsPeriods(from time.Time, to time.Time) ([]models.AnalyticsPeriod, error) {
+	var periods []models.AnalyticsPeriod
+	err := repo.db.Model(&models.Event{}).
+		Select(monthPeriod+" AS period, COUNT(DISTINCT events.id) AS events, "+
+			"COUNT(registrations.id) AS registrations, COUNT(registrations.checked_in_at) AS checked_in, "+
+			"COUNT(registrations.id) FILTER (WHERE "+repeatAttendee+") AS repeat_attendees").
+		Joins("LEFT JOIN registrations ON registrations.event_id = events.id AND registrations.status = ? AND registrations.deleted_at IS NULL", models.RegistrationConfirmed).
+		Where("events.start_date_time >= ? AND events.start_date_time < ? AND events.cancelled_at IS NULL", from, to).
+		Group("period").
+		Order("period").
+		Scan(&periods).Error
+	if err != nil {
+		return nil, err
+	}
+
+	var ratings []struct {
+		Period    string
+		AvgRating float64
+	}
+	err = repo.db.Model(&models.Feedback{}).
+		Select(monthPeriod+" AS period, "+
+			"ROUND(AVG((feedbacks.content_rating + feedbacks.organization_rating + feedbacks.venue_rating) / 3.0), 2) AS avg_rating").
+		Joins("JOIN events ON events.id = feedbacks.event_id AND events.deleted_at IS NULL").
+		Where("events.start_date_time >= ? AND events.start_date_time < ? AND events.cancelled_at IS NULL", from, to).
+		Group("period").
+		Scan(&ratings).Error
+	if err != nil {
+		return nil, err
+	}
+
+	for _, rating := range ratings {
+		for i := range periods {
+			if periods[i].Period == rating.Period {
+				periods[i].AvgRating = rating.AvgRating
+			}
+		}
+	}
+	return periods, nil
+}
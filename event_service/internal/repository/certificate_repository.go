@@ -0,0 +1,65 @@
+package repository
+
+import (
+	"fmt"
+
+	"github.com/catness812/faf-hub-backend/event_service/internal/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type CertificateRepository struct {
+	db *gorm.DB
+}
+
+func NewCertificateRepository(db *gorm.DB) *CertificateRepository {
+	return &CertificateRepository{
+		db: db,
+	}
+}
+
+func (repo *CertificateRepository) SaveCertificates(certificates []models.Certificate) error {
+	if len(certificates) == 0 {
+		return nil
+	}
+	err := repo.db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "registration_id"}},
+		DoNothing: true,
+	}).Create(&certificates).Error
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (repo *CertificateRepository) GetCertificateByCode(code string) (models.Certificate, error) {
+	var certificate models.Certificate
+	if err := repo.db.Where("code = ?", code).First(&certificate).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return certificate, fmt.Errorf("certificate doesn't exist")
+		} else {
+			return certificate, err
+		}
+	}
+	return certificate, nil
+}
+
+func (repo *CertificateRepository) GetEventCertificates(eventID uint) ([]models.Certificate, error) {
+	var certificates []models.Certificate
+	if err := repo.db.Where("event_id = ?", eventID).Order("id").Find(&certificates).Error; err != nil {
+		return nil, err
+	}
+	return certificates, nil
+}
+
+func (repo *CertificateRepository) GetUserEventCertificate(eventID uint, userID uint) (models.Certificate, error) {
+	var certificate models.Certificate
+	if err := repo.db.Where("event_id = ? AND user_id = ?", eventID, userID).First(&certificate).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return certificate, fmt.Errorf("certificate doesn't exist")
+		} else {
+			return certificate, err
+		}
+	}
+	return certificate, nil
+}
@@ -2,11 +2,21 @@ package repository
 
 import (
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/catness812/faf-hub-backend/event_service/internal/models"
+	"github.com/catness812/faf-hub-backend/event_service/internal/util"
 	"gorm.io/gorm"
 )
 
+var eventSortColumns = map[string]string{
+	"start":    "start_date_time",
+	"deadline": "application_deadline",
+	"created":  "created_at",
+	"name":     "name",
+}
+
 type EventRepository struct {
 	db *gorm.DB
 }
@@ -18,24 +28,38 @@ func NewEventRepository(db *gorm.DB) *EventRepository {
 }
 
 func (repo *EventRepository) SaveEvent(event *models.Event) (uint, error) {
-	err := repo.db.Create(event).Error
+	err := repo.db.Transaction(func(tx *gorm.DB) error {
+		if err := resolveTags(tx, event.Tags); err != nil {
+			return err
+		}
+		return tx.Create(event).Error
+	})
 	if err != nil {
-		return 0, err
+		return 0, roomBookingError(err)
 	}
 	return event.ID, nil
 }
 
+// UpdateEvent replaces the event tags with the given ones.
 func (repo *EventRepository) UpdateEvent(event models.Event) error {
-	err := repo.db.Updates(&event).Error
+	err := repo.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit("Tags").Updates(&event).Error; err != nil {
+			return err
+		}
+		if err := resolveTags(tx, event.Tags); err != nil {
+			return err
+		}
+		return tx.Model(&event).Association("Tags").Replace(event.Tags)
+	})
 	if err != nil {
-		return err
+		return roomBookingError(err)
 	}
 	return nil
 }
 
 func (repo *EventRepository) GetEventByID(eventID uint) (models.Event, error) {
 	var event models.Event
-	if err := repo.db.Where("id = ?", eventID).First(&event).Error; err != nil {
+	if err := repo.db.Preload("Tags").Where("id = ?", eventID).First(&event).Error; err != nil {
 		if err == gorm.ErrRecordNotFound {
 			return event, fmt.Errorf("event doesn't exist")
 		} else {
@@ -45,30 +69,302 @@ func (repo *EventRepository) GetEventByID(eventID uint) (models.Event, error) {
 	return event, nil
 }
 
+// DeleteEvent removes the event together with everything that belongs to it,
+// all or nothing.
 func (repo *EventRepository) DeleteEvent(eventID uint) error {
-	var event models.Event
-	err := repo.db.Unscoped().Where("id = ?", eventID).Delete(&event).Error
+	err := repo.db.Transaction(func(tx *gorm.DB) error {
+		teamIDs := tx.Model(&models.Team{}).Select("id").Where("event_id = ?", eventID)
+		if err := tx.Unscoped().Where("team_id IN (?)", teamIDs).Delete(&models.TeamMember{}).Error; err != nil {
+			return err
+		}
+		sessionIDs := tx.Model(&models.Session{}).Select("id").Where("event_id = ?", eventID)
+		if err := tx.Unscoped().Where("session_id IN (?)", sessionIDs).Delete(&models.SessionBookmark{}).Error; err != nil {
+			return err
+		}
+		submissionIDs := tx.Model(&models.Submission{}).Select("id").Where("event_id = ?", eventID)
+		if err := tx.Unscoped().Where("submission_id IN (?)", submissionIDs).Delete(&models.Score{}).Error; err != nil {
+			return err
+		}
+		shiftIDs := tx.Model(&models.VolunteerShift{}).Select("id").Where("event_id = ?", eventID)
+		if err := tx.Unscoped().Where("shift_id IN (?)", shiftIDs).Delete(&models.VolunteerSignup{}).Error; err != nil {
+			return err
+		}
+		sendIDs := tx.Model(&models.NewsletterSend{}).Select("id").Where("event_id = ?", eventID)
+		if err := tx.Where("send_id IN (?)", sendIDs).Delete(&models.NewsletterRecipient{}).Error; err != nil {
+			return err
+		}
+		for _, model := range []interface{}{
+			&models.Submission{},
+			&models.Judge{},
+			&models.Criterion{},
+			&models.Feedback{},
+			&models.Certificate{},
+			&models.Registration{},
+			&models.Team{},
+			&models.MatchingProfile{},
+			&models.Session{},
+			&models.Speaker{},
+			&models.EventOrganizer{},
+			&models.ReminderLog{},
+			&models.VolunteerShift{},
+			&models.VolunteerRole{},
+			&models.NewsletterSend{},
+		} {
+			if err := tx.Unscoped().Where("event_id = ?", eventID).Delete(model).Error; err != nil {
+				return err
+			}
+		}
+		if err := tx.Exec("DELETE FROM event_tags WHERE event_id = ?", eventID).Error; err != nil {
+			return err
+		}
+		var event models.Event
+		return tx.Unscoped().Where("id = ?", eventID).Delete(&event).Error
+	})
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
-func (repo *EventRepository) GetEvents() ([]models.Event, error) {
+func (repo *EventRepository) CancelEvent(eventID uint, cancelledAt time.Time, reason string) error {
+	res := repo.db.Model(&models.Event{}).
+		Where("id = ? AND cancelled_at IS NULL", eventID).
+		Updates(map[string]interface{}{"cancelled_at": cancelledAt, "cancellation_reason": reason})
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return fmt.Errorf("event is already cancelled")
+	}
+	return nil
+}
+
+func (repo *EventRepository) SetAllowGuests(eventID uint, allowGuests bool) error {
+	res := repo.db.Model(&models.Event{}).Where("id = ?", eventID).Update("allow_guests", allowGuests)
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return fmt.Errorf("event doesn't exist")
+	}
+	return nil
+}
+
+func (repo *EventRepository) GetEvents(filter models.EventFilter) ([]models.Event, int64, string, error) {
 	var events []models.Event
-	// add pagination
-	err := repo.db.Find(&events)
-	if err.Error != nil {
-		return nil, err.Error
+	var total int64
+
+	column, ok := eventSortColumns[strings.TrimPrefix(filter.Sort, "-")]
+	if !ok {
+		return nil, 0, "", fmt.Errorf("invalid sort order")
 	}
-	return events, nil
+	direction, op := "ASC", ">"
+	if strings.HasPrefix(filter.Sort, "-") {
+		direction, op = "DESC", "<"
+	}
+
+	query := repo.filterEvents(filter)
+	if err := query.Model(&models.Event{}).Count(&total).Error; err != nil {
+		return nil, 0, "", err
+	}
+
+	query = repo.filterEvents(filter).Preload("Tags").Order(fmt.Sprintf("%s %s, id %s", column, direction, direction)).Limit(filter.PageSize)
+	if filter.Cursor != "" {
+		value, id, err := util.DecodeCursor(filter.Cursor)
+		if err != nil {
+			return nil, 0, "", err
+		}
+		var cursorValue interface{} = value
+		if column != "name" {
+			if cursorValue, err = time.Parse(time.RFC3339Nano, value); err != nil {
+				return nil, 0, "", fmt.Errorf("invalid cursor")
+			}
+		}
+		query = query.Where(fmt.Sprintf("(%s, id) %s (?, ?)", column, op), cursorValue, id)
+	} else {
+		query = query.Offset((filter.Page - 1) * filter.PageSize)
+	}
+
+	if err := query.Find(&events).Error; err != nil {
+		return nil, 0, "", err
+	}
+
+	nextCursor := ""
+	if len(events) == filter.PageSize {
+		last := events[len(events)-1]
+		switch column {
+		case "name":
+			nextCursor = util.EncodeCursor(last.Name, last.ID)
+		case "application_deadline":
+			nextCursor = util.EncodeCursor(last.ApplicationDeadline.Format(time.RFC3339Nano), last.ID)
+		case "created_at":
+			nextCursor = util.EncodeCursor(last.CreatedAt.Format(time.RFC3339Nano), last.ID)
+		default:
+			nextCursor = util.EncodeCursor(last.StartDateTime.Format(time.RFC3339Nano), last.ID)
+		}
+	}
+
+	return events, total, nextCursor, nil
+}
+
+// likeEscaper escapes the LIKE wildcards in user input, to be used with
+// ESCAPE '\'.
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// containsPattern returns a LIKE pattern matching values that contain s
+// literally.
+func containsPattern(s string) string {
+	return "%" + likeEscaper.Replace(s) + "%"
+}
+
+func (repo *EventRepository) filterEvents(filter models.EventFilter) *gorm.DB {
+	query := repo.db.Model(&models.Event{})
+	if !filter.From.IsZero() {
+		query = query.Where("start_date_time >= ?", filter.From)
+	}
+	if !filter.To.IsZero() {
+		query = query.Where("start_date_time <= ?", filter.To)
+	}
+	switch filter.When {
+	case "upcoming":
+		query = query.Where("end_date_time >= ?", time.Now())
+	case "past":
+		query = query.Where("end_date_time < ?", time.Now())
+	}
+	if filter.Location != "" {
+		query = query.Where("location ILIKE ? ESCAPE '\\'", containsPattern(filter.Location))
+	}
+	if filter.Query != "" {
+		pattern := containsPattern(filter.Query)
+		query = query.Where("name ILIKE ? ESCAPE '\\' OR description ILIKE ? ESCAPE '\\'", pattern, pattern)
+	}
+	if filter.Category != "" {
+		query = query.Where("category = ?", filter.Category)
+	}
+	if len(filter.Tags) > 0 {
+		query = query.Where("id IN (?)", eventIDsWithTags(repo.db, filter.Tags))
+	}
+	return query
+}
+
+// SearchEvents matches the tsquery against the indexed column of the search
+// language and returns the events by rank, with the matched words of the name
+// and description wrapped in <mark> tags. The text is HTML escaped before it
+// is highlighted, so the <mark> tags are the only markup in the highlights.
+func (repo *EventRepository) SearchEvents(search models.EventSearch, tsquery string) ([]models.EventSearchResult, int64, error) {
+	var total int64
+	config, ok := models.SearchConfigs[search.Language]
+	if !ok {
+		return nil, 0, fmt.Errorf("unsupported search language %s", search.Language)
+	}
+	column := "events.search_" + search.Language
+
+	matches := func() *gorm.DB {
+		return repo.db.Model(&models.Event{}).
+			Joins("CROSS JOIN to_tsquery('"+config+"', ?) AS query", tsquery).
+			Where(column + " @@ query")
+	}
+	if err := matches().Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var hits []struct {
+		ID            uint
+		Rank          float64
+		NameHighlight string
+		DescHighlight string
+	}
+	err := matches().
+		Select("events.id, ts_rank(" + column + ", query) AS rank, " +
+			"ts_headline('" + config + "', " + escapeHTML("events.name") + ", query, 'StartSel=<mark>, StopSel=</mark>, HighlightAll=true') AS name_highlight, " +
+			"ts_headline('" + config + "', " + escapeHTML("events.description") + ", query, 'StartSel=<mark>, StopSel=</mark>, MaxFragments=2, MaxWords=30, MinWords=10') AS desc_highlight").
+		Order("rank DESC, events.start_date_time DESC, events.id").
+		Limit(search.PageSize).
+		Offset((search.Page - 1) * search.PageSize).
+		Scan(&hits).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	ids := make([]uint, len(hits))
+	for i, hit := range hits {
+		ids[i] = hit.ID
+	}
+	var events []models.Event
+	if err := repo.db.Preload("Tags").Where("id IN ?", ids).Find(&events).Error; err != nil {
+		return nil, 0, err
+	}
+	byID := make(map[uint]models.Event, len(events))
+	for _, event := range events {
+		byID[event.ID] = event
+	}
+
+	results := make([]models.EventSearchResult, 0, len(hits))
+	for _, hit := range hits {
+		if event, ok := byID[hit.ID]; ok {
+			results = append(results, models.EventSearchResult{
+				Event:         event,
+				Rank:          hit.Rank,
+				NameHighlight: hit.NameHighlight,
+				DescHighlight: hit.DescHighlight,
+			})
+		}
+	}
+	return results, total, nil
+}
+
+// escapeHTML wraps the SQL expression so its special HTML characters are
+// replaced by entities, starting with the ampersand.
+func escapeHTML(expr string) string {
+	for _, r := range [][2]string{{"&", "&amp;"}, {"<", "&lt;"}, {">", "&gt;"}, {`"`, "&quot;"}, {"'", "&#39;"}} {
+		expr = "replace(" + expr + ", '" + strings.ReplaceAll(r[0], "'", "''") + "', '" + r[1] + "')"
+	}
+	return expr
 }
 
 // needs rework
 func (repo *EventRepository) GetEventsByIDs(eventIDs []uint) ([]models.Event, error) {
 	var events []models.Event
-	if err := repo.db.Where("id IN ?", eventIDs).Find(&events).Error; err != nil {
+	if err := repo.db.Preload("Tags").Where("id IN ?", eventIDs).Find(&events).Error; err != nil {
 		return nil, err
 	}
 	return events, nil
 }
+
+// GetRecommendationCandidates returns the upcoming events still open for
+// registration, leaving out the given ones. When tags are given, only events
+// with at least one of them are returned.
+func (repo *EventRepository) GetRecommendationCandidates(tags []string, excludeIDs []uint) ([]models.Event, error) {
+	var events []models.Event
+	now := time.Now()
+	query := repo.db.Preload("Tags").
+		Where("application_deadline >= ? AND end_date_time >= ? AND cancelled_at IS NULL", now, now)
+	if len(excludeIDs) > 0 {
+		query = query.Where("id NOT IN ?", excludeIDs)
+	}
+	if len(tags) > 0 {
+		query = query.Where("id IN (?)", eventIDsWithTags(repo.db, tags))
+	}
+	if err := query.Order("start_date_time, id").Find(&events).Error; err != nil {
+		return nil, err
+	}
+	return events, nil
+}
+
+func eventIDsWithTags(db *gorm.DB, tags []string) *gorm.DB {
+	return db.Table("event_tags").
+		Select("event_tags.event_id").
+		Joins("JOIN tags ON tags.id = event_tags.tag_id").
+		Where("tags.name IN ?", tags)
+}
+
+// resolveTags creates the tags that don't exist yet and sets the IDs of all
+// of them, so the existing ones are linked instead of duplicated.
+func resolveTags(tx *gorm.DB, tags []models.Tag) error {
+	for i := range tags {
+		if err := tx.Where(models.Tag{Name: tags[i].Name}).FirstOrCreate(&tags[i]).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,73 @@
+package repository
+
+import (
+	"fmt"
+
+	"github.com/catness812/faf-hub-backend/event_service/internal/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type FeedbackRepository struct {
+	db *gorm.DB
+}
+
+func NewFeedbackRepository(db *gorm.DB) *FeedbackRepository {
+	return &FeedbackRepository{
+		db: db,
+	}
+}
+
+func (repo *FeedbackRepository) SaveFeedback(feedback models.Feedback) error {
+	res := repo.db.Omit("Registration").Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "registration_id"}},
+		DoNothing: true,
+	}).Create(&feedback)
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return fmt.Errorf("feedback for this event was already submitted")
+	}
+	return nil
+}
+
+func (repo *FeedbackRepository) GetEventFeedback(eventID uint) ([]models.Feedback, error) {
+	var feedback []models.Feedback
+	if err := repo.db.Preload("Registration").Where("event_id = ?", eventID).Order("created_at DESC").Find(&feedback).Error; err != nil {
+		return nil, err
+	}
+	return feedback, nil
+}
+
+func (repo *FeedbackRepository) GetFeedbackSummary(eventID uint) (models.FeedbackSummary, error) {
+	var summary models.FeedbackSummary
+	err := repo.db.Model(&models.Feedback{}).
+		Select("COUNT(*) AS responses, "+
+			"COALESCE(ROUND(AVG(content_rating), 2), 0) AS avg_content, "+
+			"COALESCE(ROUND(AVG(organization_rating), 2), 0) AS avg_organization, "+
+			"COALESCE(ROUND(AVG(venue_rating), 2), 0) AS avg_venue").
+		Where("event_id = ?", eventID).
+		Scan(&summary).Error
+	if err != nil {
+		return summary, err
+	}
+	summary.EventID = eventID
+	return summary, nil
+}
+
+// CountFeedbackRatings returns how many times each star value was given for
+// the rating column.
+func (repo *FeedbackRepository) CountFeedbackRatings(eventID uint, column string) ([]models.Count, error) {
+	var counts []models.Count
+	err := repo.db.Model(&models.Feedback{}).
+		Select(column+"::text AS value, COUNT(*) AS count").
+		Where("event_id = ?", eventID).
+		Group(column).
+		Order(column + " DESC").
+		Scan(&counts).Error
+	if err != nil {
+		return nil, err
+	}
+	return counts, nil
+}
@@ -0,0 +1,184 @@
+package repository
+
+import (
+	"fmt"
+
+	"github.com/catness812/faf-hub-backend/event_service/internal/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type JudgingRepository struct {
+	db *gorm.DB
+}
+
+func NewJudgingRepository(db *gorm.DB) *JudgingRepository {
+	return &JudgingRepository{
+		db: db,
+	}
+}
+
+// SaveSubmission creates the submission of the team or replaces its details.
+func (repo *JudgingRepository) SaveSubmission(submission *models.Submission) error {
+	return repo.db.Omit("Team").Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "team_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"title", "description", "repo_url", "demo_url", "submitted_by", "updated_at"}),
+	}).Create(submission).Error
+}
+
+func (repo *JudgingRepository) GetSubmissionByID(submissionID uint) (models.Submission, error) {
+	var submission models.Submission
+	if err := repo.db.Preload("Team.Members").Where("id = ?", submissionID).First(&submission).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return submission, fmt.Errorf("submission doesn't exist")
+		} else {
+			return submission, err
+		}
+	}
+	return submission, nil
+}
+
+func (repo *JudgingRepository) GetEventSubmissions(eventID uint) ([]models.Submission, error) {
+	var submissions []models.Submission
+	err := repo.db.
+		Preload("Team.Members").
+		Where("event_id = ?", eventID).
+		Order("created_at").
+		Find(&submissions).Error
+	if err != nil {
+		return nil, err
+	}
+	return submissions, nil
+}
+
+// SetSubmissionContent only links submissions that aren't published yet, so
+// two concurrent publishes can't both link their content.
+func (repo *JudgingRepository) SetSubmissionContent(submissionID uint, contentID uint) error {
+	res := repo.db.Model(&models.Submission{}).Where("id = ? AND content_id IS NULL", submissionID).Update("content_id", contentID)
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		var count int64
+		if err := repo.db.Model(&models.Submission{}).Where("id = ?", submissionID).Count(&count).Error; err != nil {
+			return err
+		}
+		if count == 0 {
+			return fmt.Errorf("submission doesn't exist")
+		}
+		return fmt.Errorf("submission is already published")
+	}
+	return nil
+}
+
+func (repo *JudgingRepository) SaveJudge(judge models.Judge) error {
+	res := repo.db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "event_id"}, {Name: "user_id"}},
+		DoNothing: true,
+	}).Create(&judge)
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return fmt.Errorf("user is already a judge of this event")
+	}
+	return nil
+}
+
+// DeleteJudge removes the judge together with the scores they gave in the
+// event.
+func (repo *JudgingRepository) DeleteJudge(eventID uint, userID uint) error {
+	return repo.db.Transaction(func(tx *gorm.DB) error {
+		res := tx.Unscoped().Where("event_id = ? AND user_id = ?", eventID, userID).Delete(&models.Judge{})
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected == 0 {
+			return fmt.Errorf("user isn't a judge of this event")
+		}
+		submissionIDs := tx.Model(&models.Submission{}).Select("id").Where("event_id = ?", eventID)
+		return tx.Unscoped().Where("judge_id = ? AND submission_id IN (?)", userID, submissionIDs).Delete(&models.Score{}).Error
+	})
+}
+
+func (repo *JudgingRepository) GetEventJudges(eventID uint) ([]models.Judge, error) {
+	var judges []models.Judge
+	if err := repo.db.Where("event_id = ?", eventID).Order("id").Find(&judges).Error; err != nil {
+		return nil, err
+	}
+	return judges, nil
+}
+
+func (repo *JudgingRepository) IsEventJudge(eventID uint, userID uint) (bool, error) {
+	var count int64
+	err := repo.db.Model(&models.Judge{}).
+		Where("event_id = ? AND user_id = ?", eventID, userID).
+		Count(&count).Error
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+func (repo *JudgingRepository) SaveCriterion(criterion *models.Criterion) (uint, error) {
+	err := repo.db.Create(criterion).Error
+	if err != nil {
+		return 0, err
+	}
+	return criterion.ID, nil
+}
+
+func (repo *JudgingRepository) UpdateCriterion(criterion models.Criterion) error {
+	return repo.db.Save(&criterion).Error
+}
+
+func (repo *JudgingRepository) GetCriterionByID(criterionID uint) (models.Criterion, error) {
+	var criterion models.Criterion
+	if err := repo.db.Where("id = ?", criterionID).First(&criterion).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return criterion, fmt.Errorf("criterion doesn't exist")
+		} else {
+			return criterion, err
+		}
+	}
+	return criterion, nil
+}
+
+// DeleteCriterion removes the criterion together with its scores.
+func (repo *JudgingRepository) DeleteCriterion(criterionID uint) error {
+	return repo.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Unscoped().Where("criterion_id = ?", criterionID).Delete(&models.Score{}).Error; err != nil {
+			return err
+		}
+		return tx.Unscoped().Where("id = ?", criterionID).Delete(&models.Criterion{}).Error
+	})
+}
+
+func (repo *JudgingRepository) GetEventCriteria(eventID uint) ([]models.Criterion, error) {
+	var criteria []models.Criterion
+	if err := repo.db.Where("event_id = ?", eventID).Order("id").Find(&criteria).Error; err != nil {
+		return nil, err
+	}
+	return criteria, nil
+}
+
+// SaveScores creates or replaces the scores a judge gave a submission, all or
+// nothing.
+func (repo *JudgingRepository) SaveScores(scores []models.Score) error {
+	if len(scores) == 0 {
+		return nil
+	}
+	return repo.db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "submission_id"}, {Name: "judge_id"}, {Name: "criterion_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"value", "comment", "updated_at"}),
+	}).Create(&scores).Error
+}
+
+func (repo *JudgingRepository) GetEventScores(eventID uint) ([]models.Score, error) {
+	var scores []models.Score
+	submissionIDs := repo.db.Model(&models.Submission{}).Select("id").Where("event_id = ?", eventID)
+	if err := repo.db.Where("submission_id IN (?)", submissionIDs).Find(&scores).Error; err != nil {
+		return nil, err
+	}
+	return scores, nil
+}
@@ -0,0 +1,22 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/redis/go-redis/v9"
+)
+
+type NewsletterRepository struct {
+	redisClient *redis.Client
+}
+
+func NewNewsletterRepository(redisClient *redis.Client) *NewsletterRepository {
+	return &NewsletterRepository{
+		redisClient: redisClient,
+	}
+}
+
+// GetNewsletterEmails reads the subscribers the gateway keeps in Redis.
+func (repo *NewsletterRepository) GetNewsletterEmails() ([]string, error) {
+	return repo.redisClient.SMembers(context.Background(), "newsletter").Result()
+}
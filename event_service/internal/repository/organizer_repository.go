@@ -0,0 +1,71 @@
+package repository
+
+import (
+	"fmt"
+
+	"github.com/catness812/faf-hub-backend/event_service/internal/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type OrganizerRepository struct {
+	db *gorm.DB
+}
+
+func NewOrganizerRepository(db *gorm.DB) *OrganizerRepository {
+	return &OrganizerRepository{
+		db: db,
+	}
+}
+
+func (repo *OrganizerRepository) SaveOrganizer(organizer models.EventOrganizer) error {
+	res := repo.db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "event_id"}, {Name: "user_id"}},
+		DoNothing: true,
+	}).Create(&organizer)
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return fmt.Errorf("user is already an organizer of this event")
+	}
+	return nil
+}
+
+func (repo *OrganizerRepository) DeleteOrganizer(eventID uint, userID uint) error {
+	res := repo.db.Unscoped().Where("event_id = ? AND user_id = ?", eventID, userID).Delete(&models.EventOrganizer{})
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return fmt.Errorf("user isn't an organizer of this event")
+	}
+	return nil
+}
+
+func (repo *OrganizerRepository) GetEventOrganizers(eventID uint) ([]models.EventOrganizer, error) {
+	var organizers []models.EventOrganizer
+	if err := repo.db.Where("event_id = ?", eventID).Order("id").Find(&organizers).Error; err != nil {
+		return nil, err
+	}
+	return organizers, nil
+}
+
+func (repo *OrganizerRepository) IsEventOrganizer(eventID uint, userID uint) (bool, error) {
+	var count int64
+	err := repo.db.Model(&models.EventOrganizer{}).
+		Where("event_id = ? AND user_id = ?", eventID, userID).
+		Count(&count).Error
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+func (repo *OrganizerRepository) GetUserOrganizedEventIDs(userID uint) ([]uint, error) {
+	var eventIDs []uint
+	if err := repo.db.Model(&models.EventOrganizer{}).Where("user_id = ?", userID).Pluck("event_id", &eventIDs).Error; err != nil {
+		return nil, err
+	}
+	return eventIDs, nil
+}
@@ -2,6 +2,7 @@ package repository
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/catness812/faf-hub-backend/event_service/internal/models"
 	"gorm.io/gorm"
@@ -17,17 +18,51 @@ func NewRegistrationRepository(db *gorm.DB) *RegistrationRepository {
 	}
 }
 
-func (repo *RegistrationRepository) SaveRegistration(registration models.Registration) error {
-	err := repo.db.Create(&registration).Error
+func (repo *RegistrationRepository) SaveRegistration(registration *models.Registration) error {
+	err := repo.db.Create(registration).Error
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
+// SaveRegistrations saves the registrations all or nothing, failing when the
+// user registered for one of the events in the meantime.
+func (repo *RegistrationRepository) SaveRegistrations(registrations []models.Registration) error {
+	return repo.db.Transaction(func(tx *gorm.DB) error {
+		for i := range registrations {
+			var count int64
+			if err := tx.Model(&models.Registration{}).
+				Where("event_id = ? AND user_id = ? AND NOT guest", registrations[i].EventID, registrations[i].UserID).
+				Count(&count).Error; err != nil {
+				return err
+			}
+			if count > 0 {
+				return fmt.Errorf("registration already exists")
+			}
+			if err := tx.Create(&registrations[i]).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (repo *RegistrationRepository) GetRegistrationByID(registrationID uint) (models.Registration, error) {
+	var registration models.Registration
+	if err := repo.db.Where("id = ?", registrationID).First(&registration).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return registration, fmt.Errorf("registration doesn't exist")
+		} else {
+			return registration, err
+		}
+	}
+	return registration, nil
+}
+
 func (repo *RegistrationRepository) GetUserEventRegistration(eventID uint, userID uint) (models.Registration, error) {
 	var registration models.Registration
-	if err := repo.db.Where("event_id = ? AND user_id = ?", eventID, userID).First(&registration).Error; err != nil {
+	if err := repo.db.Where("event_id = ? AND user_id = ? AND NOT guest", eventID, userID).First(&registration).Error; err != nil {
 		if err == gorm.ErrRecordNotFound {
 			return registration, fmt.Errorf("registration for this event doesn't exist")
 		} else {
@@ -37,18 +72,99 @@ func (repo *RegistrationRepository) GetUserEventRegistration(eventID uint, userI
 	return registration, nil
 }
 
+func (repo *RegistrationRepository) GetEventRegistrationByEmail(eventID uint, email string) (models.Registration, error) {
+	var registration models.Registration
+	if err := repo.db.Where("event_id = ? AND LOWER(email) = LOWER(?)", eventID, email).First(&registration).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return registration, fmt.Errorf("registration for this event doesn't exist")
+		} else {
+			return registration, err
+		}
+	}
+	return registration, nil
+}
+
+func (repo *RegistrationRepository) GetGuestRegistrationByToken(token string) (models.Registration, error) {
+	var registration models.Registration
+	if err := repo.db.Where("guest AND guest_token = ?", token).First(&registration).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return registration, fmt.Errorf("invalid guest link")
+		} else {
+			return registration, err
+		}
+	}
+	return registration, nil
+}
+
+// ClaimGuestRegistrations moves the confirmed or cancelled guest registrations
+// made with the email to the user, except for events the user is already
+// registered for with their account.
+func (repo *RegistrationRepository) ClaimGuestRegistrations(userID uint, email string) (int64, error) {
+	registered := repo.db.Model(&models.Registration{}).Select("event_id").Where("user_id = ? AND NOT guest", userID)
+	res := repo.db.Model(&models.Registration{}).
+		Where("guest AND status <> ? AND LOWER(email) = LOWER(?) AND event_id NOT IN (?)", models.RegistrationPending, email, registered).
+		Updates(map[string]interface{}{"user_id": userID, "guest": false, "guest_token": ""})
+	if res.Error != nil {
+		return 0, res.Error
+	}
+	return res.RowsAffected, nil
+}
+
 func (repo *RegistrationRepository) GetEventRegistrations(eventID uint) ([]models.Registration, error) {
 	var registrations []models.Registration
-	// add pagination
-	if err := repo.db.Where("event_id = ?", eventID).Find(&registrations).Error; err != nil {
+	if err := repo.db.Where("event_id = ?", eventID).Order("id").Find(&registrations).Error; err != nil {
 		return nil, err
 	}
 	return registrations, nil
 }
 
+func (repo *RegistrationRepository) GetConfirmedEmails(eventID uint) ([]string, error) {
+	var emails []string
+	err := repo.db.Model(&models.Registration{}).
+		Where("event_id = ? AND status = ?", eventID, models.RegistrationConfirmed).
+		Order("id").
+		Pluck("email", &emails).Error
+	if err != nil {
+		return nil, err
+	}
+	return emails, nil
+}
+
+// SearchEventRegistrations returns one page of the event registrations that
+// match the filter, or all of them when the page size is 0.
+func (repo *RegistrationRepository) SearchEventRegistrations(eventID uint, filter models.RegistrationFilter) ([]models.Registration, int64, error) {
+	var registrations []models.Registration
+	var total int64
+
+	query := repo.db.Model(&models.Registration{}).Where("event_id = ?", eventID)
+	if filter.Status != "" {
+		query = query.Where("status = ?", filter.Status)
+	}
+	if filter.Query != "" {
+		pattern := containsPattern(filter.Query)
+		query = query.Where("first_name || ' ' || last_name ILIKE ? ESCAPE '\\' OR email ILIKE ? ESCAPE '\\' OR academic_group ILIKE ? ESCAPE '\\'", pattern, pattern, pattern)
+	}
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	query = query.Order("last_name, first_name, id")
+	if filter.PageSize > 0 {
+		query = query.Offset((filter.Page - 1) * filter.PageSize).Limit(filter.PageSize)
+	}
+	if err := query.Find(&registrations).Error; err != nil {
+		return nil, 0, err
+	}
+	return registrations, total, nil
+}
+
 func (repo *RegistrationRepository) GetUserEventIDs(userID uint) ([]uint, error) {
 	var eventIDs []uint
-	if err := repo.db.Model(&models.Registration{}).Where("user_id = ?", userID).Pluck("event_id", &eventIDs).Error; err != nil {
+	err := repo.db.Model(&models.Registration{}).
+		Where("user_id = ? AND NOT guest AND event_id IN (?)", userID, repo.db.Model(&models.Event{}).Select("id")).
+		Pluck("event_id", &eventIDs).Error
+	if err != nil {
 		return nil, err
 	}
 	return eventIDs, nil
@@ -61,3 +177,24 @@ func (repo *RegistrationRepository) UpdateRegistration(registration models.Regis
 	}
 	return nil
 }
+
+func (repo *RegistrationRepository) CheckInRegistration(registrationID uint, checkedInAt time.Time) error {
+	res := repo.db.Model(&models.Registration{}).
+		Where("id = ? AND checked_in_at IS NULL", registrationID).
+		Update("checked_in_at", checkedInAt)
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return fmt.Errorf("ticket was already checked in")
+	}
+	return nil
+}
+
+func (repo *RegistrationRepository) GetEventAttendees(eventID uint) ([]models.Registration, error) {
+	var registrations []models.Registration
+	if err := repo.db.Where("event_id = ? AND status = ? AND checked_in_at IS NOT NULL", eventID, models.RegistrationConfirmed).Order("id").Find(&registrations).Error; err != nil {
+		return nil, err
+	}
+	return registrations, nil
+}
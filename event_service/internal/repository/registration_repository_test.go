@@ -0,0 +1,53 @@
+package repository
+
+import (
+	"strings"
+	"testing"
+
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+// dryRunDB builds the queries of the repositories without a database and
+// hands the last one to the returned function.
+func dryRunDB(t *testing.T) (*gorm.DB, func() string) {
+	t.Helper()
+	db, err := gorm.Open(postgres.New(postgres.Config{DSN: "host=localhost"}), &gorm.Config{
+		DryRun:               true,
+		DisableAutomaticPing: true,
+	})
+	if err != nil {
+		t.Fatalf("could not open dry run database: %v", err)
+	}
+
+	var last string
+	capture := func(tx *gorm.DB) {
+		last = tx.Statement.SQL.String()
+	}
+	if err := db.Callback().Query().After("gorm:query").Register("test:capture", capture); err != nil {
+		t.Fatalf("could not register callback: %v", err)
+	}
+	return db, func() string { return last }
+}
+
+func TestGetUserEventRegistrationSkipsGuests(t *testing.T) {
+	db, lastSQL := dryRunDB(t)
+	repo := NewRegistrationRepository(db)
+
+	_, _ = repo.GetUserEventRegistration(1, 0)
+
+	if sql := lastSQL(); !strings.Contains(sql, "NOT guest") {
+		t.Errorf("guest registrations aren't excluded: %s", sql)
+	}
+}
+
+func TestGetUserEventIDsSkipsGuests(t *testing.T) {
+	db, lastSQL := dryRunDB(t)
+	repo := NewRegistrationRepository(db)
+
+	_, _ = repo.GetUserEventIDs(0)
+
+	if sql := lastSQL(); !strings.Contains(sql, "NOT guest") {
+		t.Errorf("guest registrations aren't excluded: %s", sql)
+	}
+}
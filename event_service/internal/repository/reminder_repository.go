@@ -0,0 +1,62 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/catness812/faf-hub-backend/event_service/internal/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type ReminderRepository struct {
+	db *gorm.DB
+}
+
+func NewReminderRepository(db *gorm.DB) *ReminderRepository {
+	return &ReminderRepository{
+		db: db,
+	}
+}
+
+// ClaimReminder returns true only for the first caller claiming the reminder,
+// which relies on the unique index on (event_id, kind).
+func (repo *ReminderRepository) ClaimReminder(eventID uint, kind string) (bool, error) {
+	res := repo.db.Clauses(clause.OnConflict{DoNothing: true}).Create(&models.ReminderLog{
+		EventID: eventID,
+		Kind:    kind,
+		SentAt:  time.Now(),
+	})
+	if res.Error != nil {
+		return false, res.Error
+	}
+	return res.RowsAffected == 1, nil
+}
+
+func (repo *ReminderRepository) ReleaseReminder(eventID uint, kind string) error {
+	err := repo.db.Unscoped().Where("event_id = ? AND kind = ?", eventID, kind).Delete(&models.ReminderLog{}).Error
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (repo *ReminderRepository) GetEventsStartingBetween(from time.Time, to time.Time, kind string) ([]models.Event, error) {
+	return repo.getUnremindedEvents("start_date_time", from, to, kind)
+}
+
+func (repo *ReminderRepository) GetEventsClosingBetween(from time.Time, to time.Time, kind string) ([]models.Event, error) {
+	return repo.getUnremindedEvents("application_deadline", from, to, kind)
+}
+
+func (repo *ReminderRepository) getUnremindedEvents(column string, from time.Time, to time.Time, kind string) ([]models.Event, error) {
+	var events []models.Event
+	reminded := repo.db.Model(&models.ReminderLog{}).Select("event_id").Where("kind = ?", kind)
+	err := repo.db.
+		Where(column+" > ? AND "+column+" <= ? AND cancelled_at IS NULL", from, to).
+		Where("id NOT IN (?)", reminded).
+		Find(&events).Error
+	if err != nil {
+		return nil, err
+	}
+	return events, nil
+}
@@ -0,0 +1,69 @@
+package repository
+
+import (
+	"github.com/catness812/faf-hub-backend/event_service/internal/models"
+	"gorm.io/gorm"
+)
+
+type ReportRepository struct {
+	db *gorm.DB
+}
+
+func NewReportRepository(db *gorm.DB) *ReportRepository {
+	return &ReportRepository{
+		db: db,
+	}
+}
+
+// CountRegistrationsBy groups the event registrations by the given SQL
+// expression. Cancelled registrations are skipped unless all is set.
+func (repo *ReportRepository) CountRegistrationsBy(eventID uint, expr string, all bool) ([]models.Count, error) {
+	var counts []models.Count
+	query := repo.db.Model(&models.Registration{}).
+		Select(expr+" AS value, COUNT(*) AS count").
+		Where("event_id = ?", eventID)
+	if !all {
+		query = query.Where("status = ?", models.RegistrationConfirmed)
+	}
+	if err := query.Group("value").Order("count DESC, value").Scan(&counts).Error; err != nil {
+		return nil, err
+	}
+	return counts, nil
+}
+
+// CountTeams returns the number of teams of the event, how many of them have
+// at least minSize accepted members, and the number of accepted members.
+func (repo *ReportRepository) CountTeams(eventID uint, minSize int) (int64, int64, int64, error) {
+	var res struct {
+		Teams    int64
+		Complete int64
+		Members  int64
+	}
+	sizes := repo.db.Model(&models.Team{}).
+		Select("teams.id, COUNT(team_members.id) AS size").
+		Joins("LEFT JOIN team_members ON team_members.team_id = teams.id AND team_members.status = ? AND team_members.deleted_at IS NULL", models.TeamMemberAccepted).
+		Where("teams.event_id = ?", eventID).
+		Group("teams.id")
+	err := repo.db.Table("(?) AS sizes", sizes).
+		Select("COUNT(*) AS teams, COUNT(*) FILTER (WHERE size >= ?) AS complete, COALESCE(SUM(size), 0) AS members", minSize).
+		Scan(&res).Error
+	if err != nil {
+		return 0, 0, 0, err
+	}
+	return res.Teams, res.Complete, res.Members, nil
+}
+
+func (repo *ReportRepository) CountSoloParticipants(eventID uint) (int64, error) {
+	var count int64
+	teamed := repo.db.Model(&models.TeamMember{}).
+		Select("team_members.user_id").
+		Joins("JOIN teams ON teams.id = team_members.team_id AND teams.deleted_at IS NULL").
+		Where("teams.event_id = ? AND team_members.status = ?", eventID, models.TeamMemberAccepted)
+	err := repo.db.Model(&models.Registration{}).
+		Where("event_id = ? AND status = ? AND user_id NOT IN (?)", eventID, models.RegistrationConfirmed, teamed).
+		Count(&count).Error
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
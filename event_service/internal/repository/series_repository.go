@@ -0,0 +1,84 @@
+package repository
+
+import (
+	"fmt"
+
+	"github.com/catness812/faf-hub-backend/event_service/internal/models"
+	"gorm.io/gorm"
+)
+
+type SeriesRepository struct {
+	db *gorm.DB
+}
+
+func NewSeriesRepository(db *gorm.DB) *SeriesRepository {
+	return &SeriesRepository{
+		db: db,
+	}
+}
+
+// SaveSeries creates the series together with all of its occurrences.
+func (repo *SeriesRepository) SaveSeries(series *models.EventSeries) error {
+	err := repo.db.Create(series).Error
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (repo *SeriesRepository) GetSeriesByID(seriesID uint) (models.EventSeries, error) {
+	var series models.EventSeries
+	err := repo.db.Preload("Occurrences", func(db *gorm.DB) *gorm.DB {
+		return db.Order("start_date_time")
+	}).Where("id = ?", seriesID).First(&series).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return series, fmt.Errorf("event series doesn't exist")
+		} else {
+			return series, err
+		}
+	}
+	return series, nil
+}
+
+func (repo *SeriesRepository) GetOccurrence(eventID uint) (models.Event, error) {
+	var event models.Event
+	if err := repo.db.Where("id = ? AND series_id IS NOT NULL", eventID).First(&event).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return event, fmt.Errorf("occurrence doesn't exist")
+		} else {
+			return event, err
+		}
+	}
+	return event, nil
+}
+
+// GetUserOccurrenceIDs returns the occurrences of the series the user is
+// registered for.
+func (repo *SeriesRepository) GetUserOccurrenceIDs(seriesID uint, userID uint) ([]uint, error) {
+	var eventIDs []uint
+	err := repo.db.Model(&models.Registration{}).
+		Joins("JOIN events ON events.id = registrations.event_id AND events.deleted_at IS NULL").
+		Where("events.series_id = ? AND registrations.user_id = ? AND NOT registrations.guest", seriesID, userID).
+		Pluck("registrations.event_id", &eventIDs).Error
+	if err != nil {
+		return nil, err
+	}
+	return eventIDs, nil
+}
+
+func (repo *SeriesRepository) GetSeriesAttendance(seriesID uint) ([]models.OccurrenceAttendance, error) {
+	var attendance []models.OccurrenceAttendance
+	err := repo.db.Model(&models.Event{}).
+		Select("events.id AS event_id, events.start_date_time, events.cancelled_at, "+
+			"COUNT(registrations.id) AS registered, COUNT(registrations.checked_in_at) AS attended").
+		Joins("LEFT JOIN registrations ON registrations.event_id = events.id AND registrations.status = ? AND registrations.deleted_at IS NULL", models.RegistrationConfirmed).
+		Where("events.series_id = ?", seriesID).
+		Group("events.id").
+		Order("events.start_date_time").
+		Scan(&attendance).Error
+	if err != nil {
+		return nil, err
+	}
+	return attendance, nil
+}
@@ -0,0 +1,276 @@
+package repository
+
+import (
+	"fmt"
+
+	"github.com/catness812/faf-hub-backend/event_service/internal/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type TeamRepository struct {
+	db *gorm.DB
+}
+
+func NewTeamRepository(db *gorm.DB) *TeamRepository {
+	return &TeamRepository{
+		db: db,
+	}
+}
+
+func (repo *TeamRepository) SaveTeam(team *models.Team) (uint, error) {
+	err := repo.db.Create(team).Error
+	if err != nil {
+		return 0, err
+	}
+	return team.ID, nil
+}
+
+func (repo *TeamRepository) UpdateTeam(team models.Team) error {
+	err := repo.db.Omit("Members").Updates(&team).Error
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// DeleteTeam removes the team with its members and project submission.
+func (repo *TeamRepository) DeleteTeam(teamID uint) error {
+	return repo.db.Transaction(func(tx *gorm.DB) error {
+		submissionIDs := tx.Model(&models.Submission{}).Select("id").Where("team_id = ?", teamID)
+		if err := tx.Unscoped().Where("submission_id IN (?)", submissionIDs).Delete(&models.Score{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Unscoped().Where("team_id = ?", teamID).Delete(&models.Submission{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Unscoped().Where("team_id = ?", teamID).Delete(&models.TeamMember{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Unscoped().Where("id = ?", teamID).Delete(&models.Team{}).Error; err != nil {
+			return err
+		}
+		return nil
+	})
+}
+
+func (repo *TeamRepository) GetTeamByID(teamID uint) (models.Team, error) {
+	var team models.Team
+	if err := repo.db.Preload("Members").Where("id = ?", teamID).First(&team).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return team, fmt.Errorf("team doesn't exist")
+		} else {
+			return team, err
+		}
+	}
+	return team, nil
+}
+
+func (repo *TeamRepository) GetEventTeams(eventID uint) ([]models.Team, error) {
+	var teams []models.Team
+	if err := repo.db.Preload("Members").Where("event_id = ?", eventID).Order("id").Find(&teams).Error; err != nil {
+		return nil, err
+	}
+	return teams, nil
+}
+
+func (repo *TeamRepository) GetUserEventTeam(eventID uint, userID uint) (models.Team, error) {
+	var team models.Team
+	err := repo.db.Preload("Members").
+		Joins("JOIN team_members ON team_members.team_id = teams.id AND team_members.deleted_at IS NULL").
+		Where("teams.event_id = ? AND team_members.user_id = ? AND team_members.status = ?", eventID, userID, models.TeamMemberAccepted).
+		First(&team).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return team, fmt.Errorf("user is not in a team for this event")
+		} else {
+			return team, err
+		}
+	}
+	return team, nil
+}
+
+func (repo *TeamRepository) SaveTeamMember(member models.TeamMember) error {
+	err := repo.db.Create(&member).Error
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (repo *TeamRepository) UpdateTeamMember(member models.TeamMember) error {
+	err := repo.db.Updates(&member).Error
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// AcceptTeamInvite accepts the invite unless the user joined another team for
+// the event or the team filled up in the meantime. The team and the user's
+// registration are locked, so concurrent accepts can't both pass the checks.
+// A team without a captain gets the user as its captain.
+func (repo *TeamRepository) AcceptTeamInvite(member models.TeamMember, maxTeamSize int) error {
+	return repo.db.Transaction(func(tx *gorm.DB) error {
+		var team models.Team
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ?", member.TeamID).First(&team).Error; err != nil {
+			if err == gorm.ErrRecordNotFound {
+				return fmt.Errorf("team doesn't exist")
+			}
+			return err
+		}
+
+		var registration models.Registration
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("event_id = ? AND user_id = ? AND NOT guest", team.EventID, member.UserID).First(&registration).Error; err != nil {
+			if err == gorm.ErrRecordNotFound {
+				return fmt.Errorf("user is not registered for this event")
+			}
+			return err
+		}
+
+		var pending int64
+		if err := tx.Model(&models.TeamMember{}).Where("id = ? AND status = ?", member.ID, models.TeamMemberPending).Count(&pending).Error; err != nil {
+			return err
+		}
+		if pending == 0 {
+			return fmt.Errorf("team invite was already answered")
+		}
+
+		var joined int64
+		err := tx.Model(&models.TeamMember{}).
+			Joins("JOIN teams ON teams.id = team_members.team_id AND teams.deleted_at IS NULL").
+			Where("teams.event_id = ? AND team_members.user_id = ? AND team_members.status = ?", team.EventID, member.UserID, models.TeamMemberAccepted).
+			Count(&joined).Error
+		if err != nil {
+			return err
+		}
+		if joined > 0 {
+			return fmt.Errorf("user is already in a team for this event")
+		}
+
+		var accepted int64
+		if err := tx.Model(&models.TeamMember{}).Where("team_id = ? AND status = ?", team.ID, models.TeamMemberAccepted).Count(&accepted).Error; err != nil {
+			return err
+		}
+		if accepted >= int64(maxTeamSize) {
+			return fmt.Errorf("team can have at most %d members", maxTeamSize)
+		}
+
+		if err := tx.Model(&member).Update("status", models.TeamMemberAccepted).Error; err != nil {
+			return err
+		}
+		// a matched team whose captain declined before anyone accepted has none
+		if team.CaptainID == 0 {
+			return tx.Model(&team).Update("captain_id", member.UserID).Error
+		}
+		return nil
+	})
+}
+
+// DeclineTeamInvite declines the invite and, when it belonged to the captain,
+// passes captaincy to the earliest accepted member, or leaves the team without
+// a captain until someone accepts.
+func (repo *TeamRepository) DeclineTeamInvite(member models.TeamMember) error {
+	return repo.db.Transaction(func(tx *gorm.DB) error {
+		var team models.Team
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ?", member.TeamID).First(&team).Error; err != nil {
+			if err == gorm.ErrRecordNotFound {
+				return fmt.Errorf("team doesn't exist")
+			}
+			return err
+		}
+
+		if err := tx.Model(&member).Update("status", models.TeamMemberDeclined).Error; err != nil {
+			return err
+		}
+		if team.CaptainID != member.UserID {
+			return nil
+		}
+
+		var captain models.TeamMember
+		err := tx.Where("team_id = ? AND status = ?", team.ID, models.TeamMemberAccepted).Order("id").First(&captain).Error
+		if err != nil && err != gorm.ErrRecordNotFound {
+			return err
+		}
+		return tx.Model(&team).Update("captain_id", captain.UserID).Error
+	})
+}
+
+func (repo *TeamRepository) GetTeamMemberByID(memberID uint) (models.TeamMember, error) {
+	var member models.TeamMember
+	if err := repo.db.Where("id = ?", memberID).First(&member).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return member, fmt.Errorf("team invite doesn't exist")
+		} else {
+			return member, err
+		}
+	}
+	return member, nil
+}
+
+func (repo *TeamRepository) GetUserPendingInvites(userID uint) ([]models.TeamMember, error) {
+	var invites []models.TeamMember
+	if err := repo.db.Where("user_id = ? AND status = ?", userID, models.TeamMemberPending).Find(&invites).Error; err != nil {
+		return nil, err
+	}
+	return invites, nil
+}
+
+func (repo *TeamRepository) GetEventSoloRegistrations(eventID uint) ([]models.Registration, error) {
+	var registrations []models.Registration
+	teamed := repo.db.Model(&models.TeamMember{}).
+		Select("team_members.user_id").
+		Joins("JOIN teams ON teams.id = team_members.team_id AND teams.deleted_at IS NULL").
+		Where("teams.event_id = ? AND team_members.status = ?", eventID, models.TeamMemberAccepted)
+	if err := repo.db.Where("event_id = ? AND user_id NOT IN (?)", eventID, teamed).Find(&registrations).Error; err != nil {
+		return nil, err
+	}
+	return registrations, nil
+}
+
+func (repo *TeamRepository) SaveMatchingProfile(profile models.MatchingProfile) error {
+	err := repo.db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "event_id"}, {Name: "user_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"skills", "interests", "updated_at"}),
+	}).Create(&profile).Error
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (repo *TeamRepository) DeleteMatchingProfile(eventID uint, userID uint) error {
+	res := repo.db.Unscoped().Where("event_id = ? AND user_id = ?", eventID, userID).Delete(&models.MatchingProfile{})
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return fmt.Errorf("user didn't opt in to team matching")
+	}
+	return nil
+}
+
+// GetEventMatchingCandidates returns the profiles of participants who opted in
+// and are neither in a team nor waiting on a team invite for the event.
+func (repo *TeamRepository) GetEventMatchingCandidates(eventID uint) ([]models.MatchingProfile, error) {
+	var profiles []models.MatchingProfile
+	busy := repo.db.Model(&models.TeamMember{}).
+		Select("team_members.user_id").
+		Joins("JOIN teams ON teams.id = team_members.team_id AND teams.deleted_at IS NULL").
+		Where("teams.event_id = ? AND team_members.status IN ?", eventID, []string{models.TeamMemberAccepted, models.TeamMemberPending})
+	if err := repo.db.Where("event_id = ? AND user_id NOT IN (?)", eventID, busy).Order("user_id").Find(&profiles).Error; err != nil {
+		return nil, err
+	}
+	return profiles, nil
+}
+
+func (repo *TeamRepository) SaveTeams(teams []models.Team) error {
+	return repo.db.Transaction(func(tx *gorm.DB) error {
+		for i := range teams {
+			if err := tx.Create(&teams[i]).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
@@ -0,0 +1,104 @@
+package repository
+
+import (
+	"fmt"
+
+	"github.com/catness812/faf-hub-backend/event_service/internal/models"
+	"gorm.io/gorm"
+)
+
+type TemplateRepository struct {
+	db *gorm.DB
+}
+
+func NewTemplateRepository(db *gorm.DB) *TemplateRepository {
+	return &TemplateRepository{
+		db: db,
+	}
+}
+
+func (repo *TemplateRepository) SaveTemplate(template *models.EventTemplate) (uint, error) {
+	err := repo.db.Create(template).Error
+	if err != nil {
+		return 0, err
+	}
+	return template.ID, nil
+}
+
+func (repo *TemplateRepository) GetTemplateByID(templateID uint) (models.EventTemplate, error) {
+	var template models.EventTemplate
+	if err := repo.db.Where("id = ?", templateID).First(&template).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return template, fmt.Errorf("event template doesn't exist")
+		} else {
+			return template, err
+		}
+	}
+	return template, nil
+}
+
+func (repo *TemplateRepository) GetTemplates() ([]models.EventTemplate, error) {
+	var templates []models.EventTemplate
+	if err := repo.db.Order("name").Find(&templates).Error; err != nil {
+		return nil, err
+	}
+	return templates, nil
+}
+
+func (repo *TemplateRepository) DeleteTemplate(templateID uint) error {
+	res := repo.db.Unscoped().Where("id = ?", templateID).Delete(&models.EventTemplate{})
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return fmt.Errorf("event template doesn't exist")
+	}
+	return nil
+}
+
+// SaveEventCopy creates the event along with copies of the agenda and the
+// organizers of another event, all or nothing. The sessions are linked to the
+// copied speakers.
+func (repo *TemplateRepository) SaveEventCopy(event *models.Event, speakers []models.Speaker, sessions []models.Session, organizers []models.EventOrganizer) error {
+	return repo.db.Transaction(func(tx *gorm.DB) error {
+		if err := resolveTags(tx, event.Tags); err != nil {
+			return err
+		}
+		if err := tx.Create(event).Error; err != nil {
+			return roomBookingError(err)
+		}
+
+		speakerIDs := make(map[uint]uint, len(speakers))
+		for _, speaker := range speakers {
+			oldID := speaker.ID
+			speaker.Model = gorm.Model{}
+			speaker.EventID = event.ID
+			if err := tx.Create(&speaker).Error; err != nil {
+				return err
+			}
+			speakerIDs[oldID] = speaker.ID
+		}
+
+		for _, session := range sessions {
+			session.Model = gorm.Model{}
+			session.EventID = event.ID
+			session.Speaker = nil
+			if session.SpeakerID != nil {
+				speakerID := speakerIDs[*session.SpeakerID]
+				session.SpeakerID = &speakerID
+			}
+			if err := tx.Create(&session).Error; err != nil {
+				return err
+			}
+		}
+
+		for _, organizer := range organizers {
+			organizer.Model = gorm.Model{}
+			organizer.EventID = event.ID
+			if err := tx.Create(&organizer).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
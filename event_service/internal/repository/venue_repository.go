@@ -0,0 +1,178 @@
+package repository
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/catness812/faf-hub-backend/event_service/internal/models"
+	"github.com/jackc/pgx/v5/pgconn"
+	"gorm.io/gorm"
+)
+
+type VenueRepository struct {
+	db *gorm.DB
+}
+
+func NewVenueRepository(db *gorm.DB) *VenueRepository {
+	return &VenueRepository{
+		db: db,
+	}
+}
+
+func (repo *VenueRepository) SaveVenue(venue *models.Venue) (uint, error) {
+	err := repo.db.Omit("Rooms").Create(venue).Error
+	if err != nil {
+		return 0, err
+	}
+	return venue.ID, nil
+}
+
+func (repo *VenueRepository) GetVenueByID(venueID uint) (models.Venue, error) {
+	var venue models.Venue
+	if err := repo.db.Where("id = ?", venueID).First(&venue).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return venue, fmt.Errorf("venue doesn't exist")
+		} else {
+			return venue, err
+		}
+	}
+	return venue, nil
+}
+
+func (repo *VenueRepository) GetVenues() ([]models.Venue, error) {
+	var venues []models.Venue
+	err := repo.db.
+		Preload("Rooms", func(db *gorm.DB) *gorm.DB { return db.Order("name") }).
+		Order("name").
+		Find(&venues).Error
+	if err != nil {
+		return nil, err
+	}
+	return venues, nil
+}
+
+// DeleteVenue only removes venues without rooms, so that events keep pointing
+// to existing rooms.
+func (repo *VenueRepository) DeleteVenue(venueID uint) error {
+	var rooms int64
+	if err := repo.db.Model(&models.Room{}).Where("venue_id = ?", venueID).Count(&rooms).Error; err != nil {
+		return err
+	}
+	if rooms > 0 {
+		return fmt.Errorf("venue still has rooms")
+	}
+
+	res := repo.db.Where("id = ?", venueID).Delete(&models.Venue{})
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return fmt.Errorf("venue doesn't exist")
+	}
+	return nil
+}
+
+func (repo *VenueRepository) SaveRoom(room *models.Room) (uint, error) {
+	err := repo.db.Omit("Venue").Create(room).Error
+	if err != nil {
+		return 0, err
+	}
+	return room.ID, nil
+}
+
+func (repo *VenueRepository) UpdateRoom(room models.Room) error {
+	return repo.db.Omit("Venue").Save(&room).Error
+}
+
+func (repo *VenueRepository) GetRoomByID(roomID uint) (models.Room, error) {
+	var room models.Room
+	if err := repo.db.Preload("Venue").Where("id = ?", roomID).First(&room).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return room, fmt.Errorf("room doesn't exist")
+		} else {
+			return room, err
+		}
+	}
+	return room, nil
+}
+
+// GetBookedRoomByID also finds deleted rooms, which existing bookings still
+// refer to.
+func (repo *VenueRepository) GetBookedRoomByID(roomID uint) (models.Room, error) {
+	var room models.Room
+	if err := repo.db.Unscoped().Preload("Venue", func(db *gorm.DB) *gorm.DB {
+		return db.Unscoped()
+	}).Where("id = ?", roomID).First(&room).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return room, fmt.Errorf("room doesn't exist")
+		} else {
+			return room, err
+		}
+	}
+	return room, nil
+}
+
+// DeleteRoom soft deletes the room, so past events still know where they
+// took place.
+func (repo *VenueRepository) DeleteRoom(roomID uint) error {
+	res := repo.db.Where("id = ?", roomID).Delete(&models.Room{})
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return fmt.Errorf("room doesn't exist")
+	}
+	return nil
+}
+
+// GetRoomBookings returns the events that aren't cancelled and take place in
+// the room at some point between from and to, ordered by start time.
+func (repo *VenueRepository) GetRoomBookings(roomID uint, from time.Time, to time.Time) ([]models.Event, error) {
+	var events []models.Event
+	err := repo.db.
+		Where("room_id = ? AND cancelled_at IS NULL", roomID).
+		Where("start_date_time < ? AND end_date_time > ?", to, from).
+		Order("start_date_time").
+		Find(&events).Error
+	if err != nil {
+		return nil, err
+	}
+	return events, nil
+}
+
+// GetOverlappingBooking returns an event that isn't cancelled and is booked in
+// the room at the same time, ignoring the event being updated.
+func (repo *VenueRepository) GetOverlappingBooking(roomID uint, start time.Time, end time.Time, excludeID uint) (models.Event, bool, error) {
+	events, err := repo.GetRoomBookings(roomID, start, end)
+	if err != nil {
+		return models.Event{}, false, err
+	}
+	for _, event := range events {
+		if event.ID != excludeID {
+			return event, true, nil
+		}
+	}
+	return models.Event{}, false, nil
+}
+
+func (repo *VenueRepository) CountUpcomingBookings(roomID uint) (int64, error) {
+	var count int64
+	err := repo.db.Model(&models.Event{}).
+		Where("room_id = ? AND cancelled_at IS NULL AND end_date_time > ?", roomID, time.Now()).
+		Count(&count).Error
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// roomBookingError reports a booking that lost the race against another one
+// for the same room, which the database turns down.
+func roomBookingError(err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.ConstraintName == models.RoomBookingConstraint {
+		return fmt.Errorf("room is already booked at that time")
+	}
+	return err
+}
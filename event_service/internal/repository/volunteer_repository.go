@@ -0,0 +1,229 @@
+package repository
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/catness812/faf-hub-backend/event_service/internal/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type VolunteerRepository struct {
+	db *gorm.DB
+}
+
+func NewVolunteerRepository(db *gorm.DB) *VolunteerRepository {
+	return &VolunteerRepository{
+		db: db,
+	}
+}
+
+func (repo *VolunteerRepository) SaveRole(role *models.VolunteerRole) (uint, error) {
+	err := repo.db.Create(role).Error
+	if err != nil {
+		return 0, err
+	}
+	return role.ID, nil
+}
+
+func (repo *VolunteerRepository) GetRoleByID(roleID uint) (models.VolunteerRole, error) {
+	var role models.VolunteerRole
+	if err := repo.db.Where("id = ?", roleID).First(&role).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return role, fmt.Errorf("volunteer role doesn't exist")
+		} else {
+			return role, err
+		}
+	}
+	return role, nil
+}
+
+func (repo *VolunteerRepository) GetEventRoles(eventID uint) ([]models.VolunteerRole, error) {
+	var roles []models.VolunteerRole
+	if err := repo.db.Where("event_id = ?", eventID).Order("name").Find(&roles).Error; err != nil {
+		return nil, err
+	}
+	return roles, nil
+}
+
+// DeleteRole removes the role together with its shifts and their signups.
+func (repo *VolunteerRepository) DeleteRole(roleID uint) error {
+	return repo.db.Transaction(func(tx *gorm.DB) error {
+		shiftIDs := tx.Model(&models.VolunteerShift{}).Select("id").Where("role_id = ?", roleID)
+		if err := tx.Unscoped().Where("shift_id IN (?)", shiftIDs).Delete(&models.VolunteerSignup{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Unscoped().Where("role_id = ?", roleID).Delete(&models.VolunteerShift{}).Error; err != nil {
+			return err
+		}
+		return tx.Unscoped().Where("id = ?", roleID).Delete(&models.VolunteerRole{}).Error
+	})
+}
+
+func (repo *VolunteerRepository) SaveShift(shift *models.VolunteerShift) (uint, error) {
+	err := repo.db.Omit("Role", "Signups").Create(shift).Error
+	if err != nil {
+		return 0, err
+	}
+	return shift.ID, nil
+}
+
+// UpdateShift saves the shift, and when it was rescheduled clears the
+// reminders already sent for it so volunteers get one for the new time.
+func (repo *VolunteerRepository) UpdateShift(shift models.VolunteerShift, rescheduled bool) error {
+	return repo.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit("Role", "Signups").Save(&shift).Error; err != nil {
+			return err
+		}
+		if !rescheduled {
+			return nil
+		}
+		return tx.Model(&models.VolunteerSignup{}).Where("shift_id = ?", shift.ID).Update("reminded_at", nil).Error
+	})
+}
+
+func (repo *VolunteerRepository) GetShiftByID(shiftID uint) (models.VolunteerShift, error) {
+	var shift models.VolunteerShift
+	if err := repo.db.Preload("Role").Preload("Signups").Where("id = ?", shiftID).First(&shift).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return shift, fmt.Errorf("volunteer shift doesn't exist")
+		} else {
+			return shift, err
+		}
+	}
+	return shift, nil
+}
+
+func (repo *VolunteerRepository) DeleteShift(shiftID uint) error {
+	return repo.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Unscoped().Where("shift_id = ?", shiftID).Delete(&models.VolunteerSignup{}).Error; err != nil {
+			return err
+		}
+		return tx.Unscoped().Where("id = ?", shiftID).Delete(&models.VolunteerShift{}).Error
+	})
+}
+
+// GetEventShifts returns the shifts of the event with their roles and signups,
+// ordered by start time.
+func (repo *VolunteerRepository) GetEventShifts(eventID uint) ([]models.VolunteerShift, error) {
+	var shifts []models.VolunteerShift
+	err := repo.db.
+		Preload("Role").
+		Preload("Signups", func(db *gorm.DB) *gorm.DB { return db.Order("created_at") }).
+		Where("event_id = ?", eventID).
+		Order("start_time, id").
+		Find(&shifts).Error
+	if err != nil {
+		return nil, err
+	}
+	return shifts, nil
+}
+
+// volunteerSignupLockSpace namespaces the advisory locks SaveSignup takes on
+// users.
+const volunteerSignupLockSpace int32 = 1
+
+// SaveSignup locks the user and the shift while checking the user's other
+// shifts and counting the signups, so that concurrent signups can't take more
+// than the available slots or overlap each other.
+func (repo *VolunteerRepository) SaveSignup(signup models.VolunteerSignup) error {
+	return repo.db.Transaction(func(tx *gorm.DB) error {
+		// the user may have no signups to lock yet, so lock the user instead
+		if err := tx.Exec("SELECT pg_advisory_xact_lock(?, ?)", volunteerSignupLockSpace, int32(signup.UserID)).Error; err != nil {
+			return err
+		}
+
+		var shift models.VolunteerShift
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ?", signup.ShiftID).First(&shift).Error; err != nil {
+			if err == gorm.ErrRecordNotFound {
+				return fmt.Errorf("volunteer shift doesn't exist")
+			}
+			return err
+		}
+
+		var overlapping []models.VolunteerShift
+		signedUp := tx.Model(&models.VolunteerSignup{}).Select("shift_id").Where("user_id = ?", signup.UserID)
+		err := tx.
+			Preload("Role").
+			Where("id IN (?) AND id <> ?", signedUp, shift.ID).
+			Where("start_time < ? AND end_time > ?", shift.EndTime, shift.StartTime).
+			Limit(1).
+			Find(&overlapping).Error
+		if err != nil {
+			return err
+		}
+		if len(overlapping) > 0 {
+			other := overlapping[0]
+			role := "a volunteer"
+			if other.Role != nil {
+				role = other.Role.Name
+			}
+			return fmt.Errorf("you already volunteer as %s from %s to %s", role,
+				other.StartTime.Format(time.RFC3339), other.EndTime.Format(time.RFC3339))
+		}
+
+		var taken int64
+		if err := tx.Model(&models.VolunteerSignup{}).Where("shift_id = ?", signup.ShiftID).Count(&taken).Error; err != nil {
+			return err
+		}
+		if taken >= int64(shift.Slots) {
+			return fmt.Errorf("volunteer shift is full")
+		}
+
+		res := tx.Clauses(clause.OnConflict{DoNothing: true}).Omit("Shift").Create(&signup)
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected == 0 {
+			return fmt.Errorf("user already signed up for this shift")
+		}
+		return nil
+	})
+}
+
+func (repo *VolunteerRepository) DeleteSignup(shiftID uint, userID uint) error {
+	res := repo.db.Unscoped().Where("shift_id = ? AND user_id = ?", shiftID, userID).Delete(&models.VolunteerSignup{})
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return fmt.Errorf("user isn't signed up for this shift")
+	}
+	return nil
+}
+
+// GetSignupsStartingBetween returns the signups that weren't reminded yet for
+// shifts starting in the range, leaving out cancelled events.
+func (repo *VolunteerRepository) GetSignupsStartingBetween(from time.Time, to time.Time) ([]models.VolunteerSignup, error) {
+	var signups []models.VolunteerSignup
+	shiftIDs := repo.db.Model(&models.VolunteerShift{}).
+		Select("volunteer_shifts.id").
+		Joins("JOIN events ON events.id = volunteer_shifts.event_id AND events.deleted_at IS NULL").
+		Where("volunteer_shifts.start_time > ? AND volunteer_shifts.start_time <= ?", from, to).
+		Where("events.cancelled_at IS NULL")
+	err := repo.db.
+		Preload("Shift.Role").
+		Where("reminded_at IS NULL AND shift_id IN (?)", shiftIDs).
+		Find(&signups).Error
+	if err != nil {
+		return nil, err
+	}
+	return signups, nil
+}
+
+// ClaimShiftReminder returns true only for the first caller claiming the
+// reminder of the signup.
+func (repo *VolunteerRepository) ClaimShiftReminder(signupID uint) (bool, error) {
+	res := repo.db.Model(&models.VolunteerSignup{}).
+		Where("id = ? AND reminded_at IS NULL", signupID).
+		Update("reminded_at", time.Now())
+	if res.Error != nil {
+		return false, res.Error
+	}
+	return res.RowsAffected == 1, nil
+}
+
+func (repo *VolunteerRepository) ReleaseShiftReminder(signupID uint) error {
+	return repo.db.Model(&models.VolunteerSignup{}).Where("id = ?", signupID).Update("reminded_at", nil).Error
+}
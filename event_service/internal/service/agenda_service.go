@@ -0,0 +1,296 @@
+package service
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/catness812/faf-hub-backend/event_service/internal/models"
+	"github.com/gookit/slog"
+)
+
+type IAgendaRepository interface {
+	SaveSpeaker(speaker *models.Speaker) (uint, error)
+	UpdateSpeaker(speaker models.Speaker) error
+	DeleteSpeaker(speakerID uint) error
+	GetSpeakerByID(speakerID uint) (models.Speaker, error)
+	GetEventSpeakers(eventID uint) ([]models.Speaker, error)
+	SaveSession(session *models.Session) (uint, error)
+	UpdateSession(session models.Session) error
+	DeleteSession(sessionID uint) error
+	GetSessionByID(sessionID uint) (models.Session, error)
+	GetEventSessions(eventID uint) ([]models.Session, error)
+	GetOverlappingSession(eventID uint, room string, start time.Time, end time.Time, excludeID uint) (models.Session, bool, error)
+	SaveBookmark(bookmark models.SessionBookmark) error
+	DeleteBookmark(sessionID uint, userID uint) error
+	GetUserAgenda(eventID uint, userID uint) ([]models.Session, error)
+}
+
+type AgendaService struct {
+	agendaRepository       IAgendaRepository
+	registrationRepository IRegistrationRepository
+	eventRepository        IEventRepository
+}
+
+func NewAgendaService(
+	agendaRepo IAgendaRepository,
+	registrationRepo IRegistrationRepository,
+	eventRepo IEventRepository,
+) *AgendaService {
+	return &AgendaService{
+		agendaRepository:       agendaRepo,
+		registrationRepository: registrationRepo,
+		eventRepository:        eventRepo,
+	}
+}
+
+func (svc *AgendaService) CreateSpeaker(speaker models.Speaker) (uint, error) {
+	if _, err := svc.eventRepository.GetEventByID(speaker.EventID); err != nil {
+		slog.Errorf("Could not retrieve event: %v", err)
+		return 0, err
+	}
+
+	if strings.TrimSpace(speaker.Name) == "" {
+		slog.Error("Could not create speaker: missing name")
+		return 0, fmt.Errorf("speaker name is required")
+	}
+
+	speakerID, err := svc.agendaRepository.SaveSpeaker(&speaker)
+	if err != nil {
+		slog.Errorf("Could not create speaker: %v", err)
+		return 0, err
+	}
+
+	slog.Infof("Speaker successfully created: %s", speaker.Name)
+	return speakerID, nil
+}
+
+func (svc *AgendaService) UpdateSpeaker(speaker models.Speaker) error {
+	newSpeaker, err := svc.agendaRepository.GetSpeakerByID(speaker.ID)
+	if err != nil {
+		slog.Errorf("Could not retrieve speaker: %v", err)
+		return err
+	}
+
+	if speaker.Name != "" {
+		newSpeaker.Name = speaker.Name
+	}
+	if speaker.Title != "" {
+		newSpeaker.Title = speaker.Title
+	}
+	if speaker.Bio != "" {
+		newSpeaker.Bio = speaker.Bio
+	}
+	if speaker.Photo != "" {
+		newSpeaker.Photo = speaker.Photo
+	}
+	if speaker.Links != "" {
+		newSpeaker.Links = speaker.Links
+	}
+
+	if err := svc.agendaRepository.UpdateSpeaker(newSpeaker); err != nil {
+		slog.Errorf("Could not update speaker: %v", err)
+		return err
+	}
+
+	slog.Info("Speaker successfully updated")
+	return nil
+}
+
+func (svc *AgendaService) DeleteSpeaker(speakerID uint) error {
+	if _, err := svc.agendaRepository.GetSpeakerByID(speakerID); err != nil {
+		slog.Errorf("Could not retrieve speaker: %v", err)
+		return err
+	}
+
+	if err := svc.agendaRepository.DeleteSpeaker(speakerID); err != nil {
+		slog.Errorf("Could not delete speaker: %v", err)
+		return err
+	}
+
+	slog.Info("Speaker successfully deleted")
+	return nil
+}
+
+func (svc *AgendaService) CreateSession(session models.Session) (uint, error) {
+	if err := svc.validateSession(session); err != nil {
+		slog.Errorf("Could not create session: %v", err)
+		return 0, err
+	}
+
+	sessionID, err := svc.agendaRepository.SaveSession(&session)
+	if err != nil {
+		slog.Errorf("Could not create session: %v", err)
+		return 0, err
+	}
+
+	slog.Infof("Session successfully created: %s", session.Title)
+	return sessionID, nil
+}
+
+func (svc *AgendaService) UpdateSession(session models.Session) error {
+	newSession, err := svc.agendaRepository.GetSessionByID(session.ID)
+	if err != nil {
+		slog.Errorf("Could not retrieve session: %v", err)
+		return err
+	}
+
+	if session.Title != "" {
+		newSession.Title = session.Title
+	}
+	if session.Description != "" {
+		newSession.Description = session.Description
+	}
+	if session.Room != "" {
+		newSession.Room = session.Room
+	}
+	if !session.StartTime.Equal(time.Time{}) {
+		newSession.StartTime = session.StartTime
+	}
+	if !session.EndTime.Equal(time.Time{}) {
+		newSession.EndTime = session.EndTime
+	}
+	if session.SpeakerID != nil {
+		newSession.SpeakerID = session.SpeakerID
+	}
+
+	if err := svc.validateSession(newSession); err != nil {
+		slog.Errorf("Could not update session: %v", err)
+		return err
+	}
+
+	if err := svc.agendaRepository.UpdateSession(newSession); err != nil {
+		slog.Errorf("Could not update session: %v", err)
+		return err
+	}
+
+	slog.Info("Session successfully updated")
+	return nil
+}
+
+func (svc *AgendaService) DeleteSession(sessionID uint) error {
+	if _, err := svc.agendaRepository.GetSessionByID(sessionID); err != nil {
+		slog.Errorf("Could not retrieve session: %v", err)
+		return err
+	}
+
+	if err := svc.agendaRepository.DeleteSession(sessionID); err != nil {
+		slog.Errorf("Could not delete session: %v", err)
+		return err
+	}
+
+	slog.Info("Session successfully deleted")
+	return nil
+}
+
+func (svc *AgendaService) GetEventAgenda(eventID uint) ([]models.Session, []models.Speaker, error) {
+	if _, err := svc.eventRepository.GetEventByID(eventID); err != nil {
+		slog.Errorf("Could not retrieve event: %v", err)
+		return nil, nil, err
+	}
+
+	sessions, err := svc.agendaRepository.GetEventSessions(eventID)
+	if err != nil {
+		slog.Errorf("Could not retrieve event sessions: %v", err)
+		return nil, nil, err
+	}
+
+	speakers, err := svc.agendaRepository.GetEventSpeakers(eventID)
+	if err != nil {
+		slog.Errorf("Could not retrieve event speakers: %v", err)
+		return nil, nil, err
+	}
+
+	slog.Info("Event agenda successfully retrieved")
+	return sessions, speakers, nil
+}
+
+// BookmarkSession adds the session to the user's personal agenda. Only
+// confirmed participants of the event can bookmark its sessions.
+func (svc *AgendaService) BookmarkSession(sessionID uint, userID uint) error {
+	session, err := svc.agendaRepository.GetSessionByID(sessionID)
+	if err != nil {
+		slog.Errorf("Could not retrieve session: %v", err)
+		return err
+	}
+
+	registration, err := svc.registrationRepository.GetUserEventRegistration(session.EventID, userID)
+	if err != nil {
+		slog.Errorf("Could not retrieve user event registration: %v", err)
+		return err
+	}
+	if registration.Status != models.RegistrationConfirmed {
+		slog.Error("Could not bookmark session: registration isn't confirmed")
+		return fmt.Errorf("only participants of the event can bookmark its sessions")
+	}
+
+	if err := svc.agendaRepository.SaveBookmark(models.SessionBookmark{SessionID: sessionID, UserID: userID}); err != nil {
+		slog.Errorf("Could not bookmark session: %v", err)
+		return err
+	}
+
+	slog.Info("Session successfully bookmarked")
+	return nil
+}
+
+func (svc *AgendaService) RemoveBookmark(sessionID uint, userID uint) error {
+	if err := svc.agendaRepository.DeleteBookmark(sessionID, userID); err != nil {
+		slog.Errorf("Could not remove bookmark: %v", err)
+		return err
+	}
+
+	slog.Info("Bookmark successfully removed")
+	return nil
+}
+
+func (svc *AgendaService) GetUserAgenda(eventID uint, userID uint) ([]models.Session, error) {
+	sessions, err := svc.agendaRepository.GetUserAgenda(eventID, userID)
+	if err != nil {
+		slog.Errorf("Could not retrieve user agenda: %v", err)
+		return nil, err
+	}
+
+	slog.Info("User agenda successfully retrieved")
+	return sessions, nil
+}
+
+// validateSession checks that the session fits in the event, that its speaker
+// belongs to the same event and that its room isn't already taken.
+func (svc *AgendaService) validateSession(session models.Session) error {
+	event, err := svc.eventRepository.GetEventByID(session.EventID)
+	if err != nil {
+		return err
+	}
+
+	if strings.TrimSpace(session.Title) == "" {
+		return fmt.Errorf("session title is required")
+	}
+	if !session.EndTime.After(session.StartTime) {
+		return fmt.Errorf("session end must be after its start")
+	}
+	if session.StartTime.Before(event.StartDateTime) || session.EndTime.After(event.EndDateTime) {
+		return fmt.Errorf("session must take place during the event")
+	}
+
+	if session.SpeakerID != nil {
+		speaker, err := svc.agendaRepository.GetSpeakerByID(*session.SpeakerID)
+		if err != nil {
+			return err
+		}
+		if speaker.EventID != session.EventID {
+			return fmt.Errorf("speaker doesn't belong to this event")
+		}
+	}
+
+	if strings.TrimSpace(session.Room) == "" {
+		return nil
+	}
+	other, found, err := svc.agendaRepository.GetOverlappingSession(session.EventID, session.Room, session.StartTime, session.EndTime, session.ID)
+	if err != nil {
+		return err
+	}
+	if found {
+		return fmt.Errorf("room %s is already taken by %s at that time", session.Room, other.Title)
+	}
+	return nil
+}
@@ -0,0 +1,173 @@
+package service
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/catness812/faf-hub-backend/event_service/internal/models"
+	"github.com/gookit/slog"
+)
+
+type IAnalyticsRepository interface {
+	SaveNewsletterSend(send models.NewsletterSend, emails []string) error
+	GetNewsletterSends(eventID uint) ([]models.NewsletterSend, error)
+	CountRegistrationsByDeadline(eventID uint) ([]models.RegistrationTimelinePoint, error)
+	CountNewsletterRecipients(eventID uint) (int64, error)
+	CountRegistrationsFromNewsletter(eventID uint, since time.Time) (int64, error)
+	CountAttendance(eventID uint) (int64, int64, int64, error)
+	GetAnalyticsPeriods(from time.Time, to time.Time) ([]models.AnalyticsPeriod, error)
+}
+
+type AnalyticsService struct {
+	analyticsRepository IAnalyticsRepository
+	eventRepository     IEventRepository
+	feedbackRepository  IFeedbackRepository
+}
+
+func NewAnalyticsService(analyticsRepo IAnalyticsRepository, eventRepo IEventRepository, feedbackRepo IFeedbackRepository) *AnalyticsService {
+	return &AnalyticsService{
+		analyticsRepository: analyticsRepo,
+		eventRepository:     eventRepo,
+		feedbackRepository:  feedbackRepo,
+	}
+}
+
+func (svc *AnalyticsService) RecordNewsletterSend(eventID uint, kind string, emails []string) error {
+	if kind != models.NewsletterAnnouncement && kind != models.NewsletterDeadlineReminder {
+		slog.Errorf("Could not record newsletter send: invalid kind %s", kind)
+		return fmt.Errorf("invalid newsletter kind %s", kind)
+	}
+
+	if _, err := svc.eventRepository.GetEventByID(eventID); err != nil {
+		slog.Errorf("Could not retrieve event: %v", err)
+		return err
+	}
+
+	send, recipients := newsletterSend(eventID, kind, emails)
+	if err := svc.analyticsRepository.SaveNewsletterSend(send, recipients); err != nil {
+		slog.Errorf("Could not record newsletter send: %v", err)
+		return err
+	}
+
+	slog.Info("Newsletter send successfully recorded")
+	return nil
+}
+
+// GetEventAnalytics reports how registrations came in before the deadline,
+// how many newsletter recipients registered after the first send, and how
+// the registered people showed up and rated the event.
+func (svc *AnalyticsService) GetEventAnalytics(eventID uint) (models.EventAnalytics, error) {
+	analytics := models.EventAnalytics{EventID: eventID}
+
+	if _, err := svc.eventRepository.GetEventByID(eventID); err != nil {
+		slog.Errorf("Could not retrieve event: %v", err)
+		return analytics, err
+	}
+
+	timeline, err := svc.analyticsRepository.CountRegistrationsByDeadline(eventID)
+	if err != nil {
+		slog.Errorf("Could not aggregate event registrations: %v", err)
+		return analytics, err
+	}
+	for i := range timeline {
+		analytics.Registrations += timeline[i].Registrations
+		timeline[i].Cumulative = analytics.Registrations
+	}
+	analytics.Timeline = timeline
+
+	sends, err := svc.analyticsRepository.GetNewsletterSends(eventID)
+	if err != nil {
+		slog.Errorf("Could not retrieve newsletter sends: %v", err)
+		return analytics, err
+	}
+	if len(sends) > 0 {
+		analytics.NewsletterSends = int64(len(sends))
+		if analytics.NewsletterRecipients, err = svc.analyticsRepository.CountNewsletterRecipients(eventID); err != nil {
+			slog.Errorf("Could not count newsletter recipients: %v", err)
+			return analytics, err
+		}
+		if analytics.NewsletterConverted, err = svc.analyticsRepository.CountRegistrationsFromNewsletter(eventID, sends[0].SentAt); err != nil {
+			slog.Errorf("Could not count newsletter registrations: %v", err)
+			return analytics, err
+		}
+		analytics.ConversionRate = rate(analytics.NewsletterConverted, analytics.NewsletterRecipients)
+	}
+
+	if analytics.Confirmed, analytics.CheckedIn, analytics.RepeatAttendees, err = svc.analyticsRepository.CountAttendance(eventID); err != nil {
+		slog.Errorf("Could not count event attendance: %v", err)
+		return analytics, err
+	}
+	analytics.AttendanceRate = rate(analytics.CheckedIn, analytics.Confirmed)
+
+	feedback, err := svc.feedbackRepository.GetFeedbackSummary(eventID)
+	if err != nil {
+		slog.Errorf("Could not summarize event feedback: %v", err)
+		return analytics, err
+	}
+	analytics.FeedbackResponses = feedback.Responses
+	analytics.AvgContent = feedback.AvgContent
+	analytics.AvgOrganization = feedback.AvgOrganization
+	analytics.AvgVenue = feedback.AvgVenue
+
+	slog.Info("Event analytics successfully generated")
+	return analytics, nil
+}
+
+// GetAnalyticsOverview sums up the events month by month, over the last year
+// unless a range is given.
+func (svc *AnalyticsService) GetAnalyticsOverview(from time.Time, to time.Time) ([]models.AnalyticsPeriod, error) {
+	if to.IsZero() {
+		to = time.Now()
+	}
+	if from.IsZero() {
+		from = to.Add(-models.DefaultAnalyticsRange)
+	}
+	if !to.After(from) {
+		slog.Error("Could not generate analytics overview: invalid range")
+		return nil, fmt.Errorf("end of the range must be after its start")
+	}
+
+	periods, err := svc.analyticsRepository.GetAnalyticsPeriods(from, to)
+	if err != nil {
+		slog.Errorf("Could not aggregate analytics periods: %v", err)
+		return nil, err
+	}
+	for i := range periods {
+		periods[i].AttendanceRate = rate(periods[i].CheckedIn, periods[i].Registrations)
+	}
+
+	slog.Info("Analytics overview successfully generated")
+	return periods, nil
+}
+
+// newsletterSend keeps the recipients lowercased and without duplicates, so
+// they can be matched against registration emails.
+func newsletterSend(eventID uint, kind string, emails []string) (models.NewsletterSend, []string) {
+	seen := make(map[string]bool, len(emails))
+	recipients := make([]string, 0, len(emails))
+	for _, email := range emails {
+		email = strings.ToLower(strings.TrimSpace(email))
+		if email != "" && !seen[email] {
+			seen[email] = true
+			recipients = append(recipients, email)
+		}
+	}
+
+	send := models.NewsletterSend{
+		EventID:    eventID,
+		Kind:       kind,
+		Recipients: len(recipients),
+		SentAt:     time.Now(),
+	}
+	return send, recipients
+}
+
+// rate returns part as a fraction of total, rounded to four decimals.
+func rate(part int64, total int64) float64 {
+	if total == 0 {
+		return 0
+	}
+	return math.Round(float64(part)/float64(total)*10000) / 10000
+}
@@ -0,0 +1,183 @@
+package service
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/catness812/faf-hub-backend/event_service/internal/models"
+	"github.com/catness812/faf-hub-backend/event_service/internal/util"
+	"github.com/gookit/slog"
+)
+
+type ICertificateRepository interface {
+	SaveCertificates(certificates []models.Certificate) error
+	GetCertificateByCode(code string) (models.Certificate, error)
+	GetEventCertificates(eventID uint) ([]models.Certificate, error)
+	GetUserEventCertificate(eventID uint, userID uint) (models.Certificate, error)
+}
+
+type CertificateService struct {
+	certificateRepository  ICertificateRepository
+	registrationRepository IRegistrationRepository
+	eventRepository        IEventRepository
+}
+
+func NewCertificateService(
+	certificateRepo ICertificateRepository,
+	registrationRepo IRegistrationRepository,
+	eventRepo IEventRepository,
+) *CertificateService {
+	return &CertificateService{
+		certificateRepository:  certificateRepo,
+		registrationRepository: registrationRepo,
+		eventRepository:        eventRepo,
+	}
+}
+
+// IssueCertificates creates a certificate for every attendee who checked in
+// and doesn't have one yet, and returns all certificates of the event.
+func (svc *CertificateService) IssueCertificates(eventID uint) ([]models.Certificate, models.Event, error) {
+	event, err := svc.getEndedEvent(eventID)
+	if err != nil {
+		return nil, event, err
+	}
+
+	attendees, err := svc.registrationRepository.GetEventAttendees(eventID)
+	if err != nil {
+		slog.Errorf("Could not retrieve event attendees: %v", err)
+		return nil, event, err
+	}
+
+	certificates := make([]models.Certificate, 0, len(attendees))
+	for _, attendee := range attendees {
+		certificate, err := newCertificate(attendee)
+		if err != nil {
+			slog.Errorf("Could not generate certificate code: %v", err)
+			return nil, event, err
+		}
+		certificates = append(certificates, certificate)
+	}
+
+	if err := svc.certificateRepository.SaveCertificates(certificates); err != nil {
+		slog.Errorf("Could not save certificates: %v", err)
+		return nil, event, err
+	}
+
+	certificates, err = svc.certificateRepository.GetEventCertificates(eventID)
+	if err != nil {
+		slog.Errorf("Could not retrieve certificates: %v", err)
+		return nil, event, err
+	}
+
+	slog.Infof("Successfully issued %d certificates", len(certificates))
+	return certificates, event, nil
+}
+
+func (svc *CertificateService) GetUserCertificate(eventID uint, userID uint) (models.Certificate, []byte, error) {
+	event, err := svc.getEndedEvent(eventID)
+	if err != nil {
+		return models.Certificate{}, nil, err
+	}
+
+	certificate, err := svc.certificateRepository.GetUserEventCertificate(eventID, userID)
+	if err != nil {
+		registration, err := svc.registrationRepository.GetUserEventRegistration(eventID, userID)
+		if err != nil {
+			slog.Errorf("Could not retrieve user event registration: %v", err)
+			return certificate, nil, err
+		}
+		if registration.Status != models.RegistrationConfirmed || registration.CheckedInAt == nil {
+			slog.Error("User didn't attend the event")
+			return certificate, nil, fmt.Errorf("certificates are only issued to participants who checked in")
+		}
+
+		certificate, err = newCertificate(registration)
+		if err != nil {
+			slog.Errorf("Could not generate certificate code: %v", err)
+			return certificate, nil, err
+		}
+		if err := svc.certificateRepository.SaveCertificates([]models.Certificate{certificate}); err != nil {
+			slog.Errorf("Could not save certificate: %v", err)
+			return certificate, nil, err
+		}
+		if certificate, err = svc.certificateRepository.GetUserEventCertificate(eventID, userID); err != nil {
+			slog.Errorf("Could not retrieve certificate: %v", err)
+			return certificate, nil, err
+		}
+	}
+
+	pdf, err := util.GenerateCertificatePDF(certificate, event)
+	if err != nil {
+		slog.Errorf("Could not generate certificate PDF: %v", err)
+		return certificate, nil, err
+	}
+
+	slog.Info("User certificate successfully retrieved")
+	return certificate, pdf, nil
+}
+
+func (svc *CertificateService) GetCertificate(code string) (models.Certificate, models.Event, []byte, error) {
+	certificate, event, err := svc.VerifyCertificate(code)
+	if err != nil {
+		return certificate, event, nil, err
+	}
+
+	pdf, err := util.GenerateCertificatePDF(certificate, event)
+	if err != nil {
+		slog.Errorf("Could not generate certificate PDF: %v", err)
+		return certificate, event, nil, err
+	}
+
+	slog.Info("Certificate successfully retrieved")
+	return certificate, event, pdf, nil
+}
+
+func (svc *CertificateService) VerifyCertificate(code string) (models.Certificate, models.Event, error) {
+	certificate, err := svc.certificateRepository.GetCertificateByCode(code)
+	if err != nil {
+		slog.Errorf("Could not retrieve certificate: %v", err)
+		return certificate, models.Event{}, err
+	}
+
+	event, err := svc.eventRepository.GetEventByID(certificate.EventID)
+	if err != nil {
+		slog.Errorf("Could not retrieve event: %v", err)
+		return certificate, event, err
+	}
+
+	slog.Info("Certificate successfully verified")
+	return certificate, event, nil
+}
+
+func (svc *CertificateService) getEndedEvent(eventID uint) (models.Event, error) {
+	event, err := svc.eventRepository.GetEventByID(eventID)
+	if err != nil {
+		slog.Errorf("Could not retrieve event: %v", err)
+		return event, err
+	}
+
+	if event.EndDateTime.After(time.Now()) {
+		slog.Error("Event hasn't ended yet")
+		return event, fmt.Errorf("certificates are available after the event ends")
+	}
+
+	return event, nil
+}
+
+func newCertificate(registration models.Registration) (models.Certificate, error) {
+	code, err := util.GenerateCertificateCode()
+	if err != nil {
+		return models.Certificate{}, err
+	}
+
+	return models.Certificate{
+		EventID:        registration.EventID,
+		RegistrationID: registration.ID,
+		UserID:         registration.UserID,
+		Code:           code,
+		FirstName:      registration.FirstName,
+		LastName:       registration.LastName,
+		Email:          registration.Email,
+		IssuedAt:       time.Now(),
+	}, nil
+}
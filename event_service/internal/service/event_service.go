@@ -1,9 +1,15 @@
 package service
 
 import (
+	"fmt"
+	"slices"
+	"strings"
 	"time"
+	"unicode"
 
 	"github.com/catness812/faf-hub-backend/event_service/internal/models"
+	"github.com/catness812/faf-hub-backend/event_service/internal/notification/pb"
+	"github.com/catness812/faf-hub-backend/event_service/internal/util"
 	"github.com/gookit/slog"
 )
 
@@ -12,23 +18,45 @@ type IEventRepository interface {
 	UpdateEvent(event models.Event) error
 	GetEventByID(eventID uint) (models.Event, error)
 	DeleteEvent(eventID uint) error
-	GetEvents() ([]models.Event, error)
+	CancelEvent(eventID uint, cancelledAt time.Time, reason string) error
+	SetAllowGuests(eventID uint, allowGuests bool) error
+	GetEvents(filter models.EventFilter) ([]models.Event, int64, string, error)
+	SearchEvents(search models.EventSearch, tsquery string) ([]models.EventSearchResult, int64, error)
 	GetEventsByIDs(eventIDs []uint) ([]models.Event, error)
+	GetRecommendationCandidates(tags []string, excludeIDs []uint) ([]models.Event, error)
 }
 
 type EventService struct {
-	eventRepository IEventRepository
+	eventRepository        IEventRepository
+	registrationRepository IRegistrationRepository
+	venueRepository        IVenueRepository
+	notificationClient     pb.NotificationServiceClient
 }
 
 func NewEventService(
 	eventRepo IEventRepository,
+	registrationRepo IRegistrationRepository,
+	venueRepo IVenueRepository,
+	notificationClient pb.NotificationServiceClient,
 ) *EventService {
 	return &EventService{
-		eventRepository: eventRepo,
+		eventRepository:        eventRepo,
+		registrationRepository: registrationRepo,
+		venueRepository:        venueRepo,
+		notificationClient:     notificationClient,
 	}
 }
 
 func (svc *EventService) CreateEvent(event models.Event) (uint, error) {
+	if err := prepareNewEvent(&event); err != nil {
+		slog.Errorf("Could not create new event: %v", err)
+		return 0, err
+	}
+	if err := bookRoom(svc.venueRepository, &event, nil); err != nil {
+		slog.Errorf("Could not create new event: %v", err)
+		return 0, err
+	}
+
 	eventID, err := svc.eventRepository.SaveEvent(&event)
 	if err != nil {
 		slog.Errorf("Could not create new event: %v", err)
@@ -45,6 +73,7 @@ func (svc *EventService) UpdateEvent(eventID uint, event models.Event) error {
 		slog.Errorf("Could not retrieve event: %v", err)
 		return err
 	}
+	oldEvent := newEvent
 
 	if event.Name != "" {
 		newEvent.Name = event.Name
@@ -64,25 +93,103 @@ func (svc *EventService) UpdateEvent(eventID uint, event models.Event) error {
 	if event.Cover != "" {
 		newEvent.Cover = event.Cover
 	}
+	if event.CoverMediaID != "" {
+		newEvent.CoverMediaID = event.CoverMediaID
+	}
 	if event.Description != "" {
 		newEvent.Description = event.Description
 	}
+	if event.MinTeamSize != 0 {
+		newEvent.MinTeamSize = event.MinTeamSize
+	}
+	if event.MaxTeamSize != 0 {
+		newEvent.MaxTeamSize = event.MaxTeamSize
+	}
+	if event.Timezone != "" {
+		newEvent.Timezone = event.Timezone
+	}
+	if event.Category != "" {
+		newEvent.Category = event.Category
+	}
+	if len(event.Tags) > 0 {
+		newEvent.Tags = normalizeTags(event.Tags)
+	}
+	if event.RoomID != nil && (newEvent.RoomID == nil || *newEvent.RoomID != *event.RoomID) {
+		newEvent.RoomID = event.RoomID
+		if event.Location == "" {
+			newEvent.Location = ""
+		}
+	}
+
+	if err := validateTeamSize(newEvent); err != nil {
+		slog.Errorf("Could not update event: %v", err)
+		return err
+	}
+	if err := validateTimezone(newEvent.Timezone); err != nil {
+		slog.Errorf("Could not update event: %v", err)
+		return err
+	}
+	if err := validateCategory(newEvent.Category); err != nil {
+		slog.Errorf("Could not update event: %v", err)
+		return err
+	}
+	if err := bookRoom(svc.venueRepository, &newEvent, &oldEvent); err != nil {
+		slog.Errorf("Could not update event: %v", err)
+		return err
+	}
 
 	if err := svc.eventRepository.UpdateEvent(newEvent); err != nil {
 		slog.Errorf("Could not update event: %v", err)
 		return err
 	}
 
+	// large events take a while to notify, so the update doesn't wait for it
+	if changes := eventChanges(oldEvent, newEvent); len(changes) > 0 {
+		go svc.notifyEventChanges(oldEvent, newEvent, changes)
+	}
+
 	slog.Info("Event successfully updated")
 	return nil
 }
 
+// notifyEventChanges lets the confirmed participants of an upcoming event know
+// what changed. The update is already saved, so failures are only logged.
+func (svc *EventService) notifyEventChanges(oldEvent models.Event, newEvent models.Event, changes []string) {
+	if newEvent.CancelledAt != nil || newEvent.EndDateTime.Before(time.Now()) {
+		return
+	}
+
+	emails, err := svc.registrationRepository.GetConfirmedEmails(newEvent.ID)
+	if err != nil {
+		slog.Errorf("Could not retrieve event registrations: %v", err)
+		return
+	}
+
+	intro := fmt.Sprintf("The details of %s, which you registered for, have changed:", oldEvent.Name)
+	body := intro + "\n" + strings.Join(changes, "\n")
+	if err := publishMail(svc.notificationClient, "event_changed", emails, newEvent.Name+" has been updated", body); err != nil {
+		slog.Errorf("Could not notify registrants about the event changes: %v", err)
+		return
+	}
+
+	slog.Infof("Notified %d registrants about %d event changes", len(emails), len(changes))
+}
+
+// DeleteEvent removes the event with all of its registrations. Events that
+// are taking place can only be deleted once they are cancelled.
 func (svc *EventService) DeleteEvent(eventID uint) error {
-	if _, err := svc.eventRepository.GetEventByID(eventID); err != nil {
+	event, err := svc.eventRepository.GetEventByID(eventID)
+	if err != nil {
 		slog.Errorf("Could not retrieve event: %v", err)
 		return err
 	}
 
+	now := time.Now()
+	if event.CancelledAt == nil && !event.StartDateTime.After(now) && event.EndDateTime.After(now) {
+		slog.Error("Could not delete event: event is in progress")
+		return fmt.Errorf("event is in progress and can't be deleted")
+	}
+
 	if err := svc.eventRepository.DeleteEvent(eventID); err != nil {
 		slog.Errorf("Could not delete event: %v", err)
 		return err
@@ -92,6 +199,66 @@ func (svc *EventService) DeleteEvent(eventID uint) error {
 	return nil
 }
 
+// CancelEvent keeps the event but closes it for registrations and reminders,
+// and lets every registrant know by email. It returns how many people were
+// notified. Once the event is cancelled, failing to send the emails is only
+// logged, since the cancellation can't be retried.
+func (svc *EventService) CancelEvent(eventID uint, reason string) (int, error) {
+	event, err := svc.eventRepository.GetEventByID(eventID)
+	if err != nil {
+		slog.Errorf("Could not retrieve event: %v", err)
+		return 0, err
+	}
+
+	if event.EndDateTime.Before(time.Now()) {
+		slog.Error("Could not cancel event: event already ended")
+		return 0, fmt.Errorf("event already ended")
+	}
+
+	regs, err := svc.registrationRepository.GetEventRegistrations(eventID)
+	if err != nil {
+		slog.Errorf("Could not retrieve event registrations: %v", err)
+		return 0, err
+	}
+
+	if err := svc.eventRepository.CancelEvent(eventID, time.Now(), strings.TrimSpace(reason)); err != nil {
+		slog.Errorf("Could not cancel event: %v", err)
+		return 0, err
+	}
+
+	seen := make(map[string]bool, len(regs))
+	emails := make([]string, 0, len(regs))
+	for _, reg := range regs {
+		email := strings.ToLower(reg.Email)
+		if reg.Status != models.RegistrationConfirmed || seen[email] {
+			continue
+		}
+		seen[email] = true
+		emails = append(emails, reg.Email)
+	}
+
+	subject, body := cancellationMessage(event, strings.TrimSpace(reason))
+	if err := publishMail(svc.notificationClient, "event_update", emails, subject, body); err != nil {
+		slog.Errorf("Event cancelled, but could not notify registrants about the cancellation: %v", err)
+		return 0, nil
+	}
+
+	slog.Infof("Event successfully cancelled, notified %d registrants", len(emails))
+	return len(emails), nil
+}
+
+// SetAllowGuests opens or closes the event to guest registrations. Guests that
+// already registered keep their registration.
+func (svc *EventService) SetAllowGuests(eventID uint, allowGuests bool) error {
+	if err := svc.eventRepository.SetAllowGuests(eventID, allowGuests); err != nil {
+		slog.Errorf("Could not update guest registrations: %v", err)
+		return err
+	}
+
+	slog.Infof("Guest registrations successfully set to %t for event %d", allowGuests, eventID)
+	return nil
+}
+
 func (svc *EventService) GetEventByID(eventID uint) (models.Event, error) {
 	event, err := svc.eventRepository.GetEventByID(eventID)
 	if err != nil {
@@ -103,13 +270,197 @@ func (svc *EventService) GetEventByID(eventID uint) (models.Event, error) {
 	return event, nil
 }
 
-func (svc *EventService) GetAllEvents() ([]models.Event, error) {
-	events, err := svc.eventRepository.GetEvents()
+func (svc *EventService) GetAllEvents(filter *models.EventFilter) ([]models.Event, int64, string, error) {
+	if filter.Page < 1 {
+		filter.Page = 1
+	}
+	if filter.PageSize < 1 {
+		filter.PageSize = models.DefaultPageSize
+	}
+	if filter.PageSize > models.MaxPageSize {
+		filter.PageSize = models.MaxPageSize
+	}
+	if filter.Sort == "" {
+		filter.Sort = "start"
+	}
+	if filter.When != "" && filter.When != "upcoming" && filter.When != "past" {
+		slog.Errorf("Could not retrieve events: invalid time filter %s", filter.When)
+		return nil, 0, "", fmt.Errorf("when must be either upcoming or past")
+	}
+	if err := validateCategory(filter.Category); err != nil {
+		slog.Errorf("Could not retrieve events: %v", err)
+		return nil, 0, "", err
+	}
+	filter.Tags = tagNames(normalizeTags(tagsFromNames(filter.Tags)))
+
+	events, total, nextCursor, err := svc.eventRepository.GetEvents(*filter)
 	if err != nil {
 		slog.Errorf("Could not retrieve events: %v", err)
-		return nil, err
+		return nil, 0, "", err
 	}
 
 	slog.Info("Events successfully retrieved")
-	return events, nil
+	return events, total, nextCursor, nil
+}
+
+// SearchEvents runs a full-text search over the event name, description and
+// location. Every word of the query has to match, as a prefix, so results
+// show up while the query is being typed.
+func (svc *EventService) SearchEvents(search *models.EventSearch) ([]models.EventSearchResult, int64, error) {
+	if search.Page < 1 {
+		search.Page = 1
+	}
+	if search.PageSize < 1 {
+		search.PageSize = models.DefaultPageSize
+	}
+	if search.PageSize > models.MaxPageSize {
+		search.PageSize = models.MaxPageSize
+	}
+	if search.Language == "" {
+		search.Language = models.DefaultSearchLanguage
+	}
+	if _, ok := models.SearchConfigs[search.Language]; !ok {
+		slog.Errorf("Could not search events: invalid language %s", search.Language)
+		return nil, 0, fmt.Errorf("language must be either en or ro")
+	}
+
+	tsquery := prefixQuery(search.Query)
+	if tsquery == "" {
+		slog.Error("Could not search events: empty query")
+		return nil, 0, fmt.Errorf("search query can't be empty")
+	}
+
+	results, total, err := svc.eventRepository.SearchEvents(*search, tsquery)
+	if err != nil {
+		slog.Errorf("Could not search events: %v", err)
+		return nil, 0, err
+	}
+
+	slog.Info("Events successfully searched")
+	return results, total, nil
+}
+
+// prepareNewEvent fills in the defaults of a new event and validates it.
+func prepareNewEvent(event *models.Event) error {
+	if event.MinTeamSize == 0 {
+		event.MinTeamSize = 1
+	}
+	if event.MaxTeamSize == 0 {
+		event.MaxTeamSize = event.MinTeamSize
+	}
+	if event.Timezone == "" {
+		event.Timezone = models.DefaultTimezone
+	}
+	if err := validateTeamSize(*event); err != nil {
+		return err
+	}
+	if err := validateTimezone(event.Timezone); err != nil {
+		return err
+	}
+	if err := validateCategory(event.Category); err != nil {
+		return err
+	}
+	event.Tags = normalizeTags(event.Tags)
+	return nil
+}
+
+func validateTeamSize(event models.Event) error {
+	if event.MinTeamSize < 1 {
+		return fmt.Errorf("minimum team size must be at least 1")
+	}
+	if event.MaxTeamSize < event.MinTeamSize {
+		return fmt.Errorf("maximum team size can't be less than the minimum team size")
+	}
+	return nil
+}
+
+func validateTimezone(timezone string) error {
+	if _, err := time.LoadLocation(timezone); err != nil {
+		return fmt.Errorf("unknown timezone %s", timezone)
+	}
+	return nil
+}
+
+// prefixQuery turns free text into a tsquery that requires every word as a
+// prefix. Only letters and digits are kept, so the tsquery syntax can't be
+// broken by the input.
+func prefixQuery(query string) string {
+	words := strings.FieldsFunc(strings.ToLower(query), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	if len(words) > models.MaxSearchTerms {
+		words = words[:models.MaxSearchTerms]
+	}
+	for i := range words {
+		words[i] += ":*"
+	}
+	return strings.Join(words, " & ")
+}
+
+func validateCategory(category string) error {
+	if category == "" || slices.Contains(models.Categories, category) {
+		return nil
+	}
+	return fmt.Errorf("category must be one of %s", strings.Join(models.Categories, ", "))
+}
+
+// normalizeTags lowercases the tags and drops the empty and repeated ones.
+func normalizeTags(tags []models.Tag) []models.Tag {
+	seen := make(map[string]bool, len(tags))
+	normalized := make([]models.Tag, 0, len(tags))
+	for _, tag := range tags {
+		name := strings.ToLower(strings.TrimSpace(tag.Name))
+		if name == "" || seen[name] {
+			continue
+		}
+		seen[name] = true
+		normalized = append(normalized, models.Tag{Name: name})
+	}
+	return normalized
+}
+
+func tagsFromNames(names []string) []models.Tag {
+	tags := make([]models.Tag, len(names))
+	for i, name := range names {
+		tags[i] = models.Tag{Name: name}
+	}
+	return tags
+}
+
+func tagNames(tags []models.Tag) []string {
+	names := make([]string, len(tags))
+	for i, tag := range tags {
+		names[i] = tag.Name
+	}
+	return names
+}
+
+func cancellationMessage(event models.Event, reason string) (string, string) {
+	body := fmt.Sprintf("We're sorry to let you know that %s, planned for %s, has been cancelled.", event.Name, event.StartDateTime.In(util.EventLocation(event)).Format("Monday, 2 January 15:04 MST"))
+	if reason != "" {
+		body += " Reason: " + reason
+	}
+	return event.Name + " has been cancelled", body
+}
+
+// eventChanges lists the changes that matter to participants, with the times
+// in the event's timezone.
+func eventChanges(oldEvent models.Event, newEvent models.Event) []string {
+	const layout = "Monday, 2 January 2006 15:04 MST"
+	oldLoc, newLoc := util.EventLocation(oldEvent), util.EventLocation(newEvent)
+
+	var changes []string
+	if oldEvent.Name != newEvent.Name {
+		changes = append(changes, fmt.Sprintf("Name: %s → %s", oldEvent.Name, newEvent.Name))
+	}
+	if !oldEvent.StartDateTime.Equal(newEvent.StartDateTime) {
+		changes = append(changes, fmt.Sprintf("Start: %s → %s", oldEvent.StartDateTime.In(oldLoc).Format(layout), newEvent.StartDateTime.In(newLoc).Format(layout)))
+	}
+	if !oldEvent.EndDateTime.Equal(newEvent.EndDateTime) {
+		changes = append(changes, fmt.Sprintf("End: %s → %s", oldEvent.EndDateTime.In(oldLoc).Format(layout), newEvent.EndDateTime.In(newLoc).Format(layout)))
+	}
+	if oldEvent.Location != newEvent.Location {
+		changes = append(changes, fmt.Sprintf("Location: %s → %s", oldEvent.Location, newEvent.Location))
+	}
+	return changes
 }
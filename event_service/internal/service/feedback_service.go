@@ -0,0 +1,114 @@
+package service
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/catness812/faf-hub-backend/event_service/internal/models"
+	"github.com/gookit/slog"
+)
+
+type IFeedbackRepository interface {
+	SaveFeedback(feedback models.Feedback) error
+	GetEventFeedback(eventID uint) ([]models.Feedback, error)
+	GetFeedbackSummary(eventID uint) (models.FeedbackSummary, error)
+	CountFeedbackRatings(eventID uint, column string) ([]models.Count, error)
+}
+
+type FeedbackService struct {
+	feedbackRepository     IFeedbackRepository
+	registrationRepository IRegistrationRepository
+	eventRepository        IEventRepository
+}
+
+func NewFeedbackService(
+	feedbackRepo IFeedbackRepository,
+	registrationRepo IRegistrationRepository,
+	eventRepo IEventRepository,
+) *FeedbackService {
+	return &FeedbackService{
+		feedbackRepository:     feedbackRepo,
+		registrationRepository: registrationRepo,
+		eventRepository:        eventRepo,
+	}
+}
+
+// SubmitFeedback saves the feedback of a participant who checked in, once the
+// event has ended. Each registration can submit feedback only once.
+func (svc *FeedbackService) SubmitFeedback(feedback models.Feedback) error {
+	event, err := svc.eventRepository.GetEventByID(feedback.EventID)
+	if err != nil {
+		slog.Errorf("Could not retrieve event: %v", err)
+		return err
+	}
+
+	if event.EndDateTime.After(time.Now()) {
+		slog.Error("Event hasn't ended yet")
+		return fmt.Errorf("feedback can be submitted after the event ends")
+	}
+
+	registration, err := svc.registrationRepository.GetUserEventRegistration(feedback.EventID, feedback.UserID)
+	if err != nil {
+		slog.Errorf("Could not retrieve user event registration: %v", err)
+		return err
+	}
+	if registration.Status != models.RegistrationConfirmed || registration.CheckedInAt == nil {
+		slog.Error("User didn't attend the event")
+		return fmt.Errorf("feedback can only be submitted by participants who checked in")
+	}
+
+	for _, rating := range []int{feedback.ContentRating, feedback.OrganizationRating, feedback.VenueRating} {
+		if rating < models.MinRating || rating > models.MaxRating {
+			slog.Errorf("Invalid rating: %d", rating)
+			return fmt.Errorf("ratings must be between %d and %d", models.MinRating, models.MaxRating)
+		}
+	}
+
+	feedback.RegistrationID = registration.ID
+	feedback.Comment = strings.TrimSpace(feedback.Comment)
+	if err := svc.feedbackRepository.SaveFeedback(feedback); err != nil {
+		slog.Errorf("Could not save feedback: %v", err)
+		return err
+	}
+
+	slog.Info("Event feedback successfully saved")
+	return nil
+}
+
+func (svc *FeedbackService) GetEventFeedback(eventID uint) (models.FeedbackSummary, []models.Feedback, error) {
+	if _, err := svc.eventRepository.GetEventByID(eventID); err != nil {
+		slog.Errorf("Could not retrieve event: %v", err)
+		return models.FeedbackSummary{}, nil, err
+	}
+
+	summary, err := svc.feedbackRepository.GetFeedbackSummary(eventID)
+	if err != nil {
+		slog.Errorf("Could not aggregate event feedback: %v", err)
+		return summary, nil, err
+	}
+
+	ratings := []struct {
+		column string
+		counts *[]models.Count
+	}{
+		{"content_rating", &summary.ContentRatings},
+		{"organization_rating", &summary.OrganizationRatings},
+		{"venue_rating", &summary.VenueRatings},
+	}
+	for _, r := range ratings {
+		if *r.counts, err = svc.feedbackRepository.CountFeedbackRatings(eventID, r.column); err != nil {
+			slog.Errorf("Could not aggregate event feedback: %v", err)
+			return summary, nil, err
+		}
+	}
+
+	feedback, err := svc.feedbackRepository.GetEventFeedback(eventID)
+	if err != nil {
+		slog.Errorf("Could not retrieve event feedback: %v", err)
+		return summary, nil, err
+	}
+
+	slog.Info("Event feedback successfully retrieved")
+	return summary, feedback, nil
+}
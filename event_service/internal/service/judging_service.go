@@ -0,0 +1,426 @@
+package service
+
+import (
+	"fmt"
+	"math"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/catness812/faf-hub-backend/event_service/internal/models"
+	"github.com/gookit/slog"
+)
+
+type IJudgingRepository interface {
+	SaveSubmission(submission *models.Submission) error
+	GetSubmissionByID(submissionID uint) (models.Submission, error)
+	GetEventSubmissions(eventID uint) ([]models.Submission, error)
+	SetSubmissionContent(submissionID uint, contentID uint) error
+	SaveJudge(judge models.Judge) error
+	DeleteJudge(eventID uint, userID uint) error
+	GetEventJudges(eventID uint) ([]models.Judge, error)
+	IsEventJudge(eventID uint, userID uint) (bool, error)
+	SaveCriterion(criterion *models.Criterion) (uint, error)
+	UpdateCriterion(criterion models.Criterion) error
+	GetCriterionByID(criterionID uint) (models.Criterion, error)
+	DeleteCriterion(criterionID uint) error
+	GetEventCriteria(eventID uint) ([]models.Criterion, error)
+	SaveScores(scores []models.Score) error
+	GetEventScores(eventID uint) ([]models.Score, error)
+}
+
+type JudgingService struct {
+	judgingRepository IJudgingRepository
+	teamRepository    ITeamRepository
+	eventRepository   IEventRepository
+}
+
+func NewJudgingService(
+	judgingRepo IJudgingRepository,
+	teamRepo ITeamRepository,
+	eventRepo IEventRepository,
+) *JudgingService {
+	return &JudgingService{
+		judgingRepository: judgingRepo,
+		teamRepository:    teamRepo,
+		eventRepository:   eventRepo,
+	}
+}
+
+// SubmitProject saves the project of the user's team for the event. Members
+// of the team can update it until the event ends.
+func (svc *JudgingService) SubmitProject(submission models.Submission) (uint, error) {
+	event, err := svc.eventRepository.GetEventByID(submission.EventID)
+	if err != nil {
+		slog.Errorf("Could not retrieve event: %v", err)
+		return 0, err
+	}
+	if event.CancelledAt != nil {
+		slog.Error("Could not submit project: event is cancelled")
+		return 0, fmt.Errorf("event is cancelled")
+	}
+	if event.EndDateTime.Before(time.Now()) {
+		slog.Error("Could not submit project: event already ended")
+		return 0, fmt.Errorf("submissions are closed, the event already ended")
+	}
+
+	team, err := svc.teamRepository.GetUserEventTeam(submission.EventID, submission.SubmittedBy)
+	if err != nil {
+		slog.Errorf("Could not retrieve user team: %v", err)
+		return 0, err
+	}
+	submission.TeamID = team.ID
+
+	submission.Title = strings.TrimSpace(submission.Title)
+	if submission.Title == "" {
+		slog.Error("Could not submit project: missing title")
+		return 0, fmt.Errorf("project title is required")
+	}
+	for _, link := range []string{submission.RepoURL, submission.DemoURL} {
+		if err := validateLink(link); err != nil {
+			slog.Errorf("Could not submit project: %v", err)
+			return 0, err
+		}
+	}
+
+	if err := svc.judgingRepository.SaveSubmission(&submission); err != nil {
+		slog.Errorf("Could not submit project: %v", err)
+		return 0, err
+	}
+
+	slog.Infof("Project successfully submitted for team %s: %s", team.Name, submission.Title)
+	return submission.ID, nil
+}
+
+func (svc *JudgingService) GetEventSubmissions(eventID uint) ([]models.Submission, error) {
+	submissions, err := svc.judgingRepository.GetEventSubmissions(eventID)
+	if err != nil {
+		slog.Errorf("Could not retrieve submissions: %v", err)
+		return nil, err
+	}
+
+	slog.Info("Submissions successfully retrieved")
+	return submissions, nil
+}
+
+func (svc *JudgingService) SetSubmissionContent(submissionID uint, contentID uint) error {
+	if err := svc.judgingRepository.SetSubmissionContent(submissionID, contentID); err != nil {
+		slog.Errorf("Could not update submission: %v", err)
+		return err
+	}
+
+	slog.Info("Submission successfully marked as published")
+	return nil
+}
+
+func (svc *JudgingService) AddJudge(judge models.Judge) error {
+	if _, err := svc.eventRepository.GetEventByID(judge.EventID); err != nil {
+		slog.Errorf("Could not retrieve event: %v", err)
+		return err
+	}
+
+	if err := svc.judgingRepository.SaveJudge(judge); err != nil {
+		slog.Errorf("Could not add judge: %v", err)
+		return err
+	}
+
+	slog.Infof("User %d successfully added as a judge of event %d", judge.UserID, judge.EventID)
+	return nil
+}
+
+func (svc *JudgingService) RemoveJudge(eventID uint, userID uint) error {
+	if err := svc.judgingRepository.DeleteJudge(eventID, userID); err != nil {
+		slog.Errorf("Could not remove judge: %v", err)
+		return err
+	}
+
+	slog.Info("Judge successfully removed")
+	return nil
+}
+
+func (svc *JudgingService) GetEventJudges(eventID uint) ([]models.Judge, error) {
+	judges, err := svc.judgingRepository.GetEventJudges(eventID)
+	if err != nil {
+		slog.Errorf("Could not retrieve judges: %v", err)
+		return nil, err
+	}
+
+	slog.Info("Judges successfully retrieved")
+	return judges, nil
+}
+
+func (svc *JudgingService) CreateCriterion(criterion models.Criterion) (uint, error) {
+	if _, err := svc.eventRepository.GetEventByID(criterion.EventID); err != nil {
+		slog.Errorf("Could not retrieve event: %v", err)
+		return 0, err
+	}
+
+	if criterion.Weight == 0 {
+		criterion.Weight = 1
+	}
+	if criterion.MaxScore == 0 {
+		criterion.MaxScore = models.DefaultCriterionMaxScore
+	}
+	if err := validateCriterion(criterion); err != nil {
+		slog.Errorf("Could not create criterion: %v", err)
+		return 0, err
+	}
+
+	criterionID, err := svc.judgingRepository.SaveCriterion(&criterion)
+	if err != nil {
+		slog.Errorf("Could not create criterion: %v", err)
+		return 0, err
+	}
+
+	slog.Infof("Criterion successfully created: %s", criterion.Name)
+	return criterionID, nil
+}
+
+// UpdateCriterion can't lower the maximum score, since scores already given
+// could then go over it.
+func (svc *JudgingService) UpdateCriterion(criterion models.Criterion) error {
+	newCriterion, err := svc.judgingRepository.GetCriterionByID(criterion.ID)
+	if err != nil {
+		slog.Errorf("Could not retrieve criterion: %v", err)
+		return err
+	}
+	if newCriterion.EventID != criterion.EventID {
+		slog.Error("Could not update criterion: criterion of another event")
+		return fmt.Errorf("criterion doesn't belong to this event")
+	}
+
+	if criterion.Name != "" {
+		newCriterion.Name = criterion.Name
+	}
+	if criterion.Description != "" {
+		newCriterion.Description = criterion.Description
+	}
+	if criterion.Weight != 0 {
+		newCriterion.Weight = criterion.Weight
+	}
+	if criterion.MaxScore != 0 {
+		if criterion.MaxScore < newCriterion.MaxScore {
+			slog.Error("Could not update criterion: lower maximum score")
+			return fmt.Errorf("maximum score can't be lowered")
+		}
+		newCriterion.MaxScore = criterion.MaxScore
+	}
+
+	if err := validateCriterion(newCriterion); err != nil {
+		slog.Errorf("Could not update criterion: %v", err)
+		return err
+	}
+
+	if err := svc.judgingRepository.UpdateCriterion(newCriterion); err != nil {
+		slog.Errorf("Could not update criterion: %v", err)
+		return err
+	}
+
+	slog.Info("Criterion successfully updated")
+	return nil
+}
+
+func (svc *JudgingService) DeleteCriterion(eventID uint, criterionID uint) error {
+	criterion, err := svc.judgingRepository.GetCriterionByID(criterionID)
+	if err != nil {
+		slog.Errorf("Could not retrieve criterion: %v", err)
+		return err
+	}
+	if criterion.EventID != eventID {
+		slog.Error("Could not delete criterion: criterion of another event")
+		return fmt.Errorf("criterion doesn't belong to this event")
+	}
+
+	if err := svc.judgingRepository.DeleteCriterion(criterionID); err != nil {
+		slog.Errorf("Could not delete criterion: %v", err)
+		return err
+	}
+
+	slog.Info("Criterion successfully deleted")
+	return nil
+}
+
+func (svc *JudgingService) GetEventCriteria(eventID uint) ([]models.Criterion, error) {
+	criteria, err := svc.judgingRepository.GetEventCriteria(eventID)
+	if err != nil {
+		slog.Errorf("Could not retrieve criteria: %v", err)
+		return nil, err
+	}
+
+	slog.Info("Criteria successfully retrieved")
+	return criteria, nil
+}
+
+// ScoreSubmission saves the scores a judge gives a submission, replacing the
+// ones they gave before for the same criteria. Judges can't score their own
+// team.
+func (svc *JudgingService) ScoreSubmission(submissionID uint, judgeID uint, scores []models.Score) error {
+	submission, err := svc.judgingRepository.GetSubmissionByID(submissionID)
+	if err != nil {
+		slog.Errorf("Could not retrieve submission: %v", err)
+		return err
+	}
+
+	judge, err := svc.judgingRepository.IsEventJudge(submission.EventID, judgeID)
+	if err != nil {
+		slog.Errorf("Could not check judge: %v", err)
+		return err
+	}
+	if !judge {
+		slog.Error("Could not score submission: user isn't a judge")
+		return fmt.Errorf("user isn't a judge of this event")
+	}
+	if submission.Team != nil {
+		for _, member := range submission.Team.Members {
+			if member.UserID == judgeID && member.Status == models.TeamMemberAccepted {
+				slog.Error("Could not score submission: judge is in the team")
+				return fmt.Errorf("judges can't score their own team")
+			}
+		}
+	}
+
+	criteria, err := svc.judgingRepository.GetEventCriteria(submission.EventID)
+	if err != nil {
+		slog.Errorf("Could not retrieve criteria: %v", err)
+		return err
+	}
+	maxScores := make(map[uint]int, len(criteria))
+	for _, criterion := range criteria {
+		maxScores[criterion.ID] = criterion.MaxScore
+	}
+
+	if len(scores) == 0 {
+		slog.Error("Could not score submission: no scores")
+		return fmt.Errorf("at least one score is required")
+	}
+	for i := range scores {
+		maxScore, ok := maxScores[scores[i].CriterionID]
+		if !ok {
+			slog.Error("Could not score submission: criterion of another event")
+			return fmt.Errorf("criterion %d doesn't belong to this event", scores[i].CriterionID)
+		}
+		if scores[i].Value < 0 || scores[i].Value > maxScore {
+			slog.Error("Could not score submission: score out of range")
+			return fmt.Errorf("score for criterion %d must be between 0 and %d", scores[i].CriterionID, maxScore)
+		}
+		scores[i].SubmissionID = submissionID
+		scores[i].JudgeID = judgeID
+	}
+
+	if err := svc.judgingRepository.SaveScores(scores); err != nil {
+		slog.Errorf("Could not score submission: %v", err)
+		return err
+	}
+
+	slog.Infof("Judge %d successfully scored submission %d", judgeID, submissionID)
+	return nil
+}
+
+// GetLeaderboard ranks the submissions of the event. Every criterion
+// contributes its weight times the average score the judges gave, relative to
+// its maximum score, so the final score goes from 0 to 100. Criteria nobody
+// scored yet count as 0.
+func (svc *JudgingService) GetLeaderboard(eventID uint) ([]models.LeaderboardEntry, error) {
+	submissions, err := svc.judgingRepository.GetEventSubmissions(eventID)
+	if err != nil {
+		slog.Errorf("Could not retrieve submissions: %v", err)
+		return nil, err
+	}
+
+	criteria, err := svc.judgingRepository.GetEventCriteria(eventID)
+	if err != nil {
+		slog.Errorf("Could not retrieve criteria: %v", err)
+		return nil, err
+	}
+
+	scores, err := svc.judgingRepository.GetEventScores(eventID)
+	if err != nil {
+		slog.Errorf("Could not retrieve scores: %v", err)
+		return nil, err
+	}
+
+	entries := leaderboard(submissions, criteria, scores)
+
+	slog.Info("Leaderboard successfully retrieved")
+	return entries, nil
+}
+
+func leaderboard(submissions []models.Submission, criteria []models.Criterion, scores []models.Score) []models.LeaderboardEntry {
+	type key struct{ submission, criterion uint }
+	sums := make(map[key]float64)
+	counts := make(map[key]int)
+	judges := make(map[uint]map[uint]bool)
+	for _, score := range scores {
+		k := key{score.SubmissionID, score.CriterionID}
+		sums[k] += float64(score.Value)
+		counts[k]++
+		if judges[score.SubmissionID] == nil {
+			judges[score.SubmissionID] = make(map[uint]bool)
+		}
+		judges[score.SubmissionID][score.JudgeID] = true
+	}
+
+	var totalWeight float64
+	for _, criterion := range criteria {
+		totalWeight += criterion.Weight
+	}
+
+	entries := make([]models.LeaderboardEntry, len(submissions))
+	for i, submission := range submissions {
+		var total float64
+		for _, criterion := range criteria {
+			k := key{submission.ID, criterion.ID}
+			if counts[k] > 0 {
+				average := sums[k] / float64(counts[k])
+				total += criterion.Weight * average / float64(criterion.MaxScore)
+			}
+		}
+		if totalWeight > 0 {
+			total = math.Round(total/totalWeight*10000) / 100
+		}
+		entries[i] = models.LeaderboardEntry{
+			Submission: submission,
+			Score:      total,
+			Judges:     len(judges[submission.ID]),
+		}
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].Score > entries[j].Score
+	})
+
+	// submissions with the same score share the rank
+	for i := range entries {
+		if i > 0 && entries[i].Score == entries[i-1].Score {
+			entries[i].Rank = entries[i-1].Rank
+		} else {
+			entries[i].Rank = i + 1
+		}
+	}
+	return entries
+}
+
+func validateCriterion(criterion models.Criterion) error {
+	if strings.TrimSpace(criterion.Name) == "" {
+		return fmt.Errorf("criterion name is required")
+	}
+	if criterion.Weight <= 0 {
+		return fmt.Errorf("criterion weight must be positive")
+	}
+	if criterion.MaxScore < 1 {
+		return fmt.Errorf("maximum score must be at least 1")
+	}
+	return nil
+}
+
+func validateLink(link string) error {
+	if link == "" {
+		return nil
+	}
+	u, err := url.ParseRequestURI(link)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("invalid link %s", link)
+	}
+	return nil
+}
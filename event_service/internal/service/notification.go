@@ -0,0 +1,45 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/catness812/faf-hub-backend/event_service/internal/notification/pb"
+)
+
+// mailBatchSize keeps the number of recipients per mail below the usual SMTP
+// limits.
+const mailBatchSize = 50
+
+// publishMail queues a mail in the notification_service format
+// "[emails];subject;body", split into batches of recipients.
+func publishMail(client pb.NotificationServiceClient, queue string, emails []string, subject string, body string) error {
+	_, err := publishMailBatches(client, queue, emails, subject, body)
+	return err
+}
+
+// publishMailBatches works like publishMail, but also returns how many of the
+// recipients were queued before a batch failed, since those get the mail
+// anyway.
+func publishMailBatches(client pb.NotificationServiceClient, queue string, emails []string, subject string, body string) (int, error) {
+	for start := 0; start < len(emails); start += mailBatchSize {
+		end := min(start+mailBatchSize, len(emails))
+
+		c, cancel := context.WithTimeout(context.Background(), time.Duration(5)*time.Second)
+		_, err := client.Publish(c, &pb.PublishRequest{
+			QueueName: queue,
+			Body:      "[" + strings.Join(emails[start:end], ", ") + "]" + ";" + messageSafe(subject) + ";" + messageSafe(body),
+		})
+		cancel()
+		if err != nil {
+			return start, err
+		}
+	}
+	return len(emails), nil
+}
+
+// messageSafe drops the separator of the notification message format.
+func messageSafe(s string) string {
+	return strings.ReplaceAll(s, ";", ",")
+}
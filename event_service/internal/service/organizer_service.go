@@ -0,0 +1,95 @@
+package service
+
+import (
+	"github.com/catness812/faf-hub-backend/event_service/internal/models"
+	"github.com/gookit/slog"
+)
+
+type IOrganizerRepository interface {
+	SaveOrganizer(organizer models.EventOrganizer) error
+	DeleteOrganizer(eventID uint, userID uint) error
+	GetEventOrganizers(eventID uint) ([]models.EventOrganizer, error)
+	IsEventOrganizer(eventID uint, userID uint) (bool, error)
+	GetUserOrganizedEventIDs(userID uint) ([]uint, error)
+}
+
+type OrganizerService struct {
+	organizerRepository IOrganizerRepository
+	eventRepository     IEventRepository
+}
+
+func NewOrganizerService(
+	organizerRepo IOrganizerRepository,
+	eventRepo IEventRepository,
+) *OrganizerService {
+	return &OrganizerService{
+		organizerRepository: organizerRepo,
+		eventRepository:     eventRepo,
+	}
+}
+
+func (svc *OrganizerService) AddOrganizer(organizer models.EventOrganizer) error {
+	if _, err := svc.eventRepository.GetEventByID(organizer.EventID); err != nil {
+		slog.Errorf("Could not retrieve event: %v", err)
+		return err
+	}
+
+	if err := svc.organizerRepository.SaveOrganizer(organizer); err != nil {
+		slog.Errorf("Could not add organizer: %v", err)
+		return err
+	}
+
+	slog.Infof("User %d successfully added as organizer of event %d", organizer.UserID, organizer.EventID)
+	return nil
+}
+
+func (svc *OrganizerService) RemoveOrganizer(eventID uint, userID uint) error {
+	if err := svc.organizerRepository.DeleteOrganizer(eventID, userID); err != nil {
+		slog.Errorf("Could not remove organizer: %v", err)
+		return err
+	}
+
+	slog.Info("Organizer successfully removed")
+	return nil
+}
+
+func (svc *OrganizerService) GetEventOrganizers(eventID uint) ([]models.EventOrganizer, error) {
+	organizers, err := svc.organizerRepository.GetEventOrganizers(eventID)
+	if err != nil {
+		slog.Errorf("Could not retrieve event organizers: %v", err)
+		return nil, err
+	}
+
+	slog.Info("Event organizers successfully retrieved")
+	return organizers, nil
+}
+
+func (svc *OrganizerService) IsEventOrganizer(eventID uint, userID uint) (bool, error) {
+	organizer, err := svc.organizerRepository.IsEventOrganizer(eventID, userID)
+	if err != nil {
+		slog.Errorf("Could not check event organizer: %v", err)
+		return false, err
+	}
+	return organizer, nil
+}
+
+func (svc *OrganizerService) GetOrganizedEvents(userID uint) ([]models.Event, error) {
+	eventIDs, err := svc.organizerRepository.GetUserOrganizedEventIDs(userID)
+	if err != nil {
+		slog.Errorf("Could not retrieve organized events: %v", err)
+		return nil, err
+	}
+
+	if len(eventIDs) == 0 {
+		return []models.Event{}, nil
+	}
+
+	events, err := svc.eventRepository.GetEventsByIDs(eventIDs)
+	if err != nil {
+		slog.Errorf("Could not retrieve organized events: %v", err)
+		return nil, err
+	}
+
+	slog.Info("Organized events successfully retrieved")
+	return events, nil
+}
@@ -0,0 +1,97 @@
+package service
+
+import (
+	"sort"
+	"time"
+
+	"github.com/catness812/faf-hub-backend/event_service/internal/models"
+	"github.com/gookit/slog"
+)
+
+const DefaultRecommendations = 10
+
+type RecommendationService struct {
+	eventRepository        IEventRepository
+	registrationRepository IRegistrationRepository
+}
+
+func NewRecommendationService(
+	eventRepo IEventRepository,
+	registrationRepo IRegistrationRepository,
+) *RecommendationService {
+	return &RecommendationService{
+		eventRepository:        eventRepo,
+		registrationRepository: registrationRepo,
+	}
+}
+
+// RecommendEvents ranks the upcoming events the user isn't registered for by
+// how many of their tags appeared on the past events the user attended, with
+// tags seen more often weighing more. Users without any history get the
+// soonest upcoming events.
+func (svc *RecommendationService) RecommendEvents(userID uint, limit int) ([]models.Event, []int, error) {
+	if limit < 1 {
+		limit = DefaultRecommendations
+	}
+	if limit > models.MaxPageSize {
+		limit = models.MaxPageSize
+	}
+
+	eventIDs, err := svc.registrationRepository.GetUserEventIDs(userID)
+	if err != nil {
+		slog.Errorf("Could not retrieve user events: %v", err)
+		return nil, nil, err
+	}
+
+	weights := make(map[string]int)
+	if len(eventIDs) > 0 {
+		events, err := svc.eventRepository.GetEventsByIDs(eventIDs)
+		if err != nil {
+			slog.Errorf("Could not retrieve user events: %v", err)
+			return nil, nil, err
+		}
+		now := time.Now()
+		for _, event := range events {
+			if event.EndDateTime.After(now) {
+				continue
+			}
+			for _, tag := range event.Tags {
+				weights[tag.Name]++
+			}
+		}
+	}
+
+	tags := make([]string, 0, len(weights))
+	for tag := range weights {
+		tags = append(tags, tag)
+	}
+
+	candidates, err := svc.eventRepository.GetRecommendationCandidates(tags, eventIDs)
+	if err != nil {
+		slog.Errorf("Could not retrieve upcoming events: %v", err)
+		return nil, nil, err
+	}
+
+	scores := make(map[uint]int, len(candidates))
+	for _, event := range candidates {
+		for _, tag := range event.Tags {
+			scores[event.ID] += weights[tag.Name]
+		}
+	}
+
+	// candidates are ordered by start time, so the sooner events win ties
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return scores[candidates[i].ID] > scores[candidates[j].ID]
+	})
+	if len(candidates) > limit {
+		candidates = candidates[:limit]
+	}
+
+	ranked := make([]int, len(candidates))
+	for i, event := range candidates {
+		ranked[i] = scores[event.ID]
+	}
+
+	slog.Infof("Successfully recommended %d events", len(candidates))
+	return candidates, ranked, nil
+}
@@ -2,17 +2,30 @@ package service
 
 import (
 	"fmt"
+	"net/mail"
+	"strings"
+	"time"
 
 	"github.com/catness812/faf-hub-backend/event_service/internal/models"
+	"github.com/catness812/faf-hub-backend/event_service/internal/util"
 	"github.com/gookit/slog"
 )
 
 type IRegistrationRepository interface {
-	SaveRegistration(registration models.Registration) error
+	SaveRegistration(registration *models.Registration) error
+	SaveRegistrations(registrations []models.Registration) error
+	GetRegistrationByID(registrationID uint) (models.Registration, error)
 	GetUserEventRegistration(eventID uint, userID uint) (models.Registration, error)
+	GetEventRegistrationByEmail(eventID uint, email string) (models.Registration, error)
+	GetGuestRegistrationByToken(token string) (models.Registration, error)
+	ClaimGuestRegistrations(userID uint, email string) (int64, error)
 	GetEventRegistrations(eventID uint) ([]models.Registration, error)
+	GetConfirmedEmails(eventID uint) ([]string, error)
+	SearchEventRegistrations(eventID uint, filter models.RegistrationFilter) ([]models.Registration, int64, error)
 	GetUserEventIDs(userID uint) ([]uint, error)
 	UpdateRegistration(registration models.Registration) error
+	CheckInRegistration(registrationID uint, checkedInAt time.Time) error
+	GetEventAttendees(eventID uint) ([]models.Registration, error)
 }
 
 type RegistrationService struct {
@@ -30,40 +43,263 @@ func NewRegistrationService(
 	}
 }
 
-func (svc *RegistrationService) RegisterForEvent(registration models.Registration) error {
-	if _, err := svc.eventRepository.GetEventByID(registration.EventID); err != nil {
+func (svc *RegistrationService) RegisterForEvent(registration models.Registration) (string, error) {
+	if err := svc.checkEventRegistration(registration); err != nil {
+		return "", err
+	}
+
+	registration.Status = models.RegistrationConfirmed
+	if err := svc.registrationRepository.SaveRegistration(&registration); err != nil {
+		slog.Errorf("Could not save registration: %v", err)
+		return "", err
+	}
+
+	slog.Info("Event registration successfully saved")
+	return util.GenerateTicket(registration.ID, registration.EventID, registration.UserID), nil
+}
+
+// RegisterForEvents registers for several events with the same checks as
+// RegisterForEvent. Every event is checked before anything is saved, and the
+// registrations are saved all or nothing. The tickets follow the order of the
+// registrations.
+func (svc *RegistrationService) RegisterForEvents(registrations []models.Registration) ([]string, error) {
+	for i := range registrations {
+		if err := svc.checkEventRegistration(registrations[i]); err != nil {
+			return nil, err
+		}
+		registrations[i].Status = models.RegistrationConfirmed
+	}
+
+	if err := svc.registrationRepository.SaveRegistrations(registrations); err != nil {
+		slog.Errorf("Could not save registrations: %v", err)
+		return nil, err
+	}
+
+	tickets := make([]string, len(registrations))
+	for i, registration := range registrations {
+		tickets[i] = util.GenerateTicket(registration.ID, registration.EventID, registration.UserID)
+	}
+
+	slog.Infof("%d event registrations successfully saved", len(registrations))
+	return tickets, nil
+}
+
+// checkEventRegistration makes sure the event takes registrations and the
+// user isn't registered for it yet.
+func (svc *RegistrationService) checkEventRegistration(registration models.Registration) error {
+	event, err := svc.eventRepository.GetEventByID(registration.EventID)
+	if err != nil {
 		slog.Errorf("Could not retrieve event: %v", err)
 		return err
 	}
 
+	if event.CancelledAt != nil {
+		slog.Error("Event is cancelled")
+		return fmt.Errorf("event is cancelled")
+	}
+
 	if _, err := svc.registrationRepository.GetUserEventRegistration(registration.EventID, registration.UserID); err == nil {
 		slog.Error("Registration already exists")
 		return fmt.Errorf("registration already exists")
 	}
+	return nil
+}
 
-	if err := svc.registrationRepository.SaveRegistration(registration); err != nil {
-		slog.Errorf("Could not save registration: %v", err)
-		return err
+// RegisterGuest saves a pending registration for someone without an account
+// and returns the token of the link that confirms it. Registering again while
+// the link is still valid leaves the registration as is and returns the same
+// link, while an expired link gets replaced together with the details.
+func (svc *RegistrationService) RegisterGuest(registration models.Registration) (string, error) {
+	event, err := svc.eventRepository.GetEventByID(registration.EventID)
+	if err != nil {
+		slog.Errorf("Could not retrieve event: %v", err)
+		return "", err
 	}
 
-	slog.Info("Event registration successfully saved")
-	return nil
+	if event.CancelledAt != nil {
+		slog.Error("Event is cancelled")
+		return "", fmt.Errorf("event is cancelled")
+	}
+	if !event.AllowGuests {
+		slog.Error("Event doesn't allow guest registrations")
+		return "", fmt.Errorf("event doesn't accept guest registrations")
+	}
+
+	// only the bare address is kept, so it works as a recipient, fits the
+	// notification message format and matches the email of a later account
+	addr, err := mail.ParseAddress(strings.TrimSpace(registration.Email))
+	if err != nil || strings.Contains(addr.Address, ";") {
+		slog.Errorf("Could not register guest: invalid email %q", registration.Email)
+		return "", fmt.Errorf("invalid email address")
+	}
+	registration.Email = strings.ToLower(addr.Address)
+	registration.FirstName = strings.TrimSpace(registration.FirstName)
+	registration.LastName = strings.TrimSpace(registration.LastName)
+
+	existing, err := svc.registrationRepository.GetEventRegistrationByEmail(registration.EventID, registration.Email)
+	found := err == nil
+	if found {
+		if !existing.Guest || existing.Status != models.RegistrationPending {
+			slog.Error("Registration already exists")
+			return "", fmt.Errorf("registration already exists")
+		}
+		if time.Since(existing.UpdatedAt) <= models.GuestLinkTTL {
+			slog.Info("Guest registration is still waiting for confirmation")
+			return existing.GuestToken, nil
+		}
+	}
+
+	token, err := util.GenerateGuestToken()
+	if err != nil {
+		slog.Errorf("Could not generate guest link: %v", err)
+		return "", err
+	}
+
+	registration.UserID = 0
+	registration.Guest = true
+	registration.GuestToken = token
+	registration.Status = models.RegistrationPending
+
+	if found {
+		registration.ID = existing.ID
+		if err := svc.registrationRepository.UpdateRegistration(registration); err != nil {
+			slog.Errorf("Could not update guest registration: %v", err)
+			return "", err
+		}
+	} else if err := svc.registrationRepository.SaveRegistration(&registration); err != nil {
+		slog.Errorf("Could not save guest registration: %v", err)
+		return "", err
+	}
+
+	slog.Info("Guest registration successfully saved, waiting for confirmation")
+	return token, nil
+}
+
+// ConfirmGuestRegistration confirms the guest registration the link points to
+// and returns it with its ticket. The link keeps returning the ticket after
+// the registration is confirmed, so confirmed reports whether this call was
+// the one that confirmed it.
+func (svc *RegistrationService) ConfirmGuestRegistration(token string) (registration models.Registration, ticket string, confirmed bool, err error) {
+	registration, err = svc.registrationRepository.GetGuestRegistrationByToken(token)
+	if err != nil {
+		slog.Errorf("Could not retrieve guest registration: %v", err)
+		return registration, "", false, err
+	}
+
+	switch registration.Status {
+	case models.RegistrationCancelled:
+		slog.Error("Guest registration is cancelled")
+		return registration, "", false, fmt.Errorf("registration is cancelled")
+	case models.RegistrationPending:
+		if time.Since(registration.UpdatedAt) > models.GuestLinkTTL {
+			slog.Error("Guest link expired")
+			return registration, "", false, fmt.Errorf("guest link expired, please register again")
+		}
+
+		event, err := svc.eventRepository.GetEventByID(registration.EventID)
+		if err != nil {
+			slog.Errorf("Could not retrieve event: %v", err)
+			return registration, "", false, err
+		}
+		if event.CancelledAt != nil {
+			slog.Error("Event is cancelled")
+			return registration, "", false, fmt.Errorf("event is cancelled")
+		}
+
+		registration.Status = models.RegistrationConfirmed
+		if err := svc.registrationRepository.UpdateRegistration(registration); err != nil {
+			slog.Errorf("Could not confirm guest registration: %v", err)
+			return registration, "", false, err
+		}
+		confirmed = true
+	}
+
+	slog.Info("Guest registration successfully confirmed")
+	return registration, util.GenerateTicket(registration.ID, registration.EventID, registration.UserID), confirmed, nil
+}
+
+// ClaimGuestRegistrations links the guest registrations made with the email of
+// a new account to it. Their tickets change since they now belong to the user.
+func (svc *RegistrationService) ClaimGuestRegistrations(userID uint, email string) (int, error) {
+	claimed, err := svc.registrationRepository.ClaimGuestRegistrations(userID, strings.TrimSpace(email))
+	if err != nil {
+		slog.Errorf("Could not claim guest registrations: %v", err)
+		return 0, err
+	}
+
+	slog.Infof("Successfully claimed %d guest registrations for user %d", claimed, userID)
+	return int(claimed), nil
 }
 
-func (svc *RegistrationService) GetEventRegistrations(eventID uint) ([]models.Registration, error) {
+func (svc *RegistrationService) GetEventRegistrations(eventID uint, filter *models.RegistrationFilter) ([]models.Registration, int64, error) {
 	if _, err := svc.eventRepository.GetEventByID(eventID); err != nil {
 		slog.Errorf("Could not retrieve event: %v", err)
-		return nil, err
+		return nil, 0, err
+	}
+
+	if err := validateRegistrationStatus(filter.Status); err != nil {
+		slog.Errorf("Could not retrieve event registrations: %v", err)
+		return nil, 0, err
+	}
+	if filter.Page < 1 {
+		filter.Page = 1
+	}
+	if filter.PageSize < 1 {
+		filter.PageSize = models.DefaultPageSize
+	}
+	if filter.PageSize > models.MaxPageSize {
+		filter.PageSize = models.MaxPageSize
 	}
 
-	regs, err := svc.registrationRepository.GetEventRegistrations(eventID)
+	regs, total, err := svc.registrationRepository.SearchEventRegistrations(eventID, *filter)
 	if err != nil {
 		slog.Errorf("Could not retrieve event registrations: %v", err)
-		return nil, err
+		return nil, 0, err
 	}
 
 	slog.Info("Event registrations successfully retrieved")
-	return regs, nil
+	return regs, total, nil
+}
+
+// ExportEventRegistrations returns every registration matching the filter as
+// a CSV or XLSX file, along with the file name.
+func (svc *RegistrationService) ExportEventRegistrations(eventID uint, filter models.RegistrationFilter, format string) ([]byte, string, error) {
+	event, err := svc.eventRepository.GetEventByID(eventID)
+	if err != nil {
+		slog.Errorf("Could not retrieve event: %v", err)
+		return nil, "", err
+	}
+
+	if err := validateRegistrationStatus(filter.Status); err != nil {
+		slog.Errorf("Could not export event registrations: %v", err)
+		return nil, "", err
+	}
+
+	filter.PageSize = 0
+	regs, _, err := svc.registrationRepository.SearchEventRegistrations(eventID, filter)
+	if err != nil {
+		slog.Errorf("Could not retrieve event registrations: %v", err)
+		return nil, "", err
+	}
+
+	var file []byte
+	switch format {
+	case "", "csv":
+		format = "csv"
+		file, err = util.RegistrationsCSV(regs)
+	case "xlsx":
+		file, err = util.RegistrationsXLSX(regs)
+	default:
+		slog.Errorf("Could not export event registrations: unsupported format %s", format)
+		return nil, "", fmt.Errorf("format must be either csv or xlsx")
+	}
+	if err != nil {
+		slog.Errorf("Could not export event registrations: %v", err)
+		return nil, "", err
+	}
+
+	slog.Info("Event registrations successfully exported")
+	return file, fmt.Sprintf("%s-registrations.%s", util.Slugify(event.Name), format), nil
 }
 
 func (svc *RegistrationService) GetUserEventRegistration(eventID uint, userID uint) (models.Registration, error) {
@@ -143,3 +379,65 @@ func (svc *RegistrationService) UpdateRegistration(registration models.Registrat
 	slog.Info("User registration successfully updated")
 	return nil
 }
+
+func (svc *RegistrationService) GetTicket(eventID uint, userID uint) (string, error) {
+	registration, err := svc.registrationRepository.GetUserEventRegistration(eventID, userID)
+	if err != nil {
+		slog.Errorf("Could not retrieve user event registration: %v", err)
+		return "", err
+	}
+
+	if registration.Status != models.RegistrationConfirmed {
+		slog.Error("Registration is not confirmed")
+		return "", fmt.Errorf("registration is not confirmed")
+	}
+
+	slog.Info("Ticket successfully retrieved")
+	return util.GenerateTicket(registration.ID, registration.EventID, registration.UserID), nil
+}
+
+func (svc *RegistrationService) CheckIn(eventID uint, ticket string) (models.Registration, error) {
+	registrationID, ticketEventID, userID, err := util.ParseTicket(ticket)
+	if err != nil {
+		slog.Errorf("Could not validate ticket: %v", err)
+		return models.Registration{}, err
+	}
+
+	if ticketEventID != eventID {
+		slog.Error("Ticket belongs to another event")
+		return models.Registration{}, fmt.Errorf("ticket is not valid for this event")
+	}
+
+	registration, err := svc.registrationRepository.GetRegistrationByID(registrationID)
+	if err != nil {
+		slog.Errorf("Could not retrieve registration: %v", err)
+		return registration, err
+	}
+
+	if registration.EventID != eventID || registration.UserID != userID {
+		slog.Error("Ticket doesn't match the registration")
+		return registration, fmt.Errorf("invalid ticket")
+	}
+	if registration.Status != models.RegistrationConfirmed {
+		slog.Error("Registration is not confirmed")
+		return registration, fmt.Errorf("registration is not confirmed")
+	}
+
+	checkedInAt := time.Now()
+	if err := svc.registrationRepository.CheckInRegistration(registration.ID, checkedInAt); err != nil {
+		slog.Errorf("Could not check in registration: %v", err)
+		return registration, err
+	}
+	registration.CheckedInAt = &checkedInAt
+
+	slog.Info("Registration successfully checked in")
+	return registration, nil
+}
+
+func validateRegistrationStatus(status string) error {
+	switch status {
+	case "", models.RegistrationPending, models.RegistrationConfirmed, models.RegistrationCancelled:
+		return nil
+	}
+	return fmt.Errorf("status must be one of %s, %s or %s", models.RegistrationPending, models.RegistrationConfirmed, models.RegistrationCancelled)
+}
@@ -0,0 +1,263 @@
+package service
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/catness812/faf-hub-backend/event_service/internal/models"
+	"github.com/catness812/faf-hub-backend/event_service/internal/notification/pb"
+	"github.com/catness812/faf-hub-backend/event_service/internal/util"
+	"github.com/gookit/slog"
+)
+
+type IReminderRepository interface {
+	ClaimReminder(eventID uint, kind string) (bool, error)
+	ReleaseReminder(eventID uint, kind string) error
+	GetEventsStartingBetween(from time.Time, to time.Time, kind string) ([]models.Event, error)
+	GetEventsClosingBetween(from time.Time, to time.Time, kind string) ([]models.Event, error)
+}
+
+type INewsletterRepository interface {
+	GetNewsletterEmails() ([]string, error)
+}
+
+type ReminderService struct {
+	reminderRepository     IReminderRepository
+	registrationRepository IRegistrationRepository
+	volunteerRepository    IVolunteerRepository
+	eventRepository        IEventRepository
+	newsletterRepository   INewsletterRepository
+	analyticsRepository    IAnalyticsRepository
+	notificationClient     pb.NotificationServiceClient
+	startOffsets           []time.Duration
+	deadlineOffsets        []time.Duration
+	shiftOffset            time.Duration
+}
+
+func NewReminderService(
+	reminderRepo IReminderRepository,
+	registrationRepo IRegistrationRepository,
+	volunteerRepo IVolunteerRepository,
+	eventRepo IEventRepository,
+	newsletterRepo INewsletterRepository,
+	analyticsRepo IAnalyticsRepository,
+	notificationClient pb.NotificationServiceClient,
+	startOffsets []time.Duration,
+	deadlineOffsets []time.Duration,
+	shiftOffset time.Duration,
+) *ReminderService {
+	return &ReminderService{
+		reminderRepository:     reminderRepo,
+		registrationRepository: registrationRepo,
+		volunteerRepository:    volunteerRepo,
+		eventRepository:        eventRepo,
+		newsletterRepository:   newsletterRepo,
+		analyticsRepository:    analyticsRepo,
+		notificationClient:     notificationClient,
+		startOffsets:           sortedOffsets(startOffsets),
+		deadlineOffsets:        sortedOffsets(deadlineOffsets),
+		shiftOffset:            shiftOffset,
+	}
+}
+
+// Start checks for due reminders every interval. It is safe to run on several
+// replicas since every reminder is claimed in the database before it's sent.
+func (svc *ReminderService) Start(interval time.Duration) {
+	slog.Infof("Reminder scheduler started, checking every %v", interval)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		svc.SendDueReminders(time.Now())
+		<-ticker.C
+	}
+}
+
+func (svc *ReminderService) SendDueReminders(now time.Time) {
+	svc.sendReminders(now, "start", svc.startOffsets, svc.reminderRepository.GetEventsStartingBetween, svc.participantEmails, startReminder, nil)
+	svc.sendReminders(now, "deadline", svc.deadlineOffsets, svc.reminderRepository.GetEventsClosingBetween, svc.unregisteredSubscriberEmails, deadlineReminder, svc.recordDeadlineNewsletter)
+	svc.sendShiftReminders(now)
+}
+
+// sendShiftReminders emails every volunteer whose shift starts within the
+// shift offset. Each signup is claimed before its reminder is sent.
+func (svc *ReminderService) sendShiftReminders(now time.Time) {
+	signups, err := svc.volunteerRepository.GetSignupsStartingBetween(now, now.Add(svc.shiftOffset))
+	if err != nil {
+		slog.Errorf("Could not retrieve volunteer shifts due for reminders: %v", err)
+		return
+	}
+
+	events := make(map[uint]models.Event)
+	for _, signup := range signups {
+		claimed, err := svc.volunteerRepository.ClaimShiftReminder(signup.ID)
+		if err != nil {
+			slog.Errorf("Could not claim shift reminder: %v", err)
+			continue
+		}
+		if !claimed {
+			continue
+		}
+
+		event, ok := events[signup.Shift.EventID]
+		if !ok {
+			if event, err = svc.eventRepository.GetEventByID(signup.Shift.EventID); err == nil {
+				events[event.ID] = event
+			}
+		}
+		if err == nil {
+			subject, body := shiftReminder(signup, event)
+			err = publishMail(svc.notificationClient, "reminder", []string{signup.Email}, subject, body)
+		}
+		if err != nil {
+			slog.Errorf("Could not send shift reminder for signup %d: %v", signup.ID, err)
+			if err := svc.volunteerRepository.ReleaseShiftReminder(signup.ID); err != nil {
+				slog.Errorf("Could not release shift reminder: %v", err)
+			}
+			continue
+		}
+
+		slog.Infof("Sent shift reminder for volunteer shift %d to user %d", signup.ShiftID, signup.UserID)
+	}
+}
+
+// sendReminders goes through the offsets from the shortest one, so an event
+// that is already within a short offset only gets that reminder and the
+// longer ones are marked as sent. A reminder that was queued for some of the
+// recipients counts as sent. When set, sent is called with the recipients of
+// every reminder that went out.
+func (svc *ReminderService) sendReminders(
+	now time.Time,
+	prefix string,
+	offsets []time.Duration,
+	dueEvents func(from time.Time, to time.Time, kind string) ([]models.Event, error),
+	recipients func(event models.Event) ([]string, error),
+	message func(event models.Event) (string, string),
+	sent func(event models.Event, emails []string),
+) {
+	for i, offset := range offsets {
+		kind := reminderKind(prefix, offset)
+
+		events, err := dueEvents(now, now.Add(offset), kind)
+		if err != nil {
+			slog.Errorf("Could not retrieve events due for %s reminders: %v", kind, err)
+			continue
+		}
+
+		for _, event := range events {
+			claimed, err := svc.reminderRepository.ClaimReminder(event.ID, kind)
+			if err != nil {
+				slog.Errorf("Could not claim %s reminder: %v", kind, err)
+				continue
+			}
+			if !claimed {
+				continue
+			}
+
+			for _, longer := range offsets[i+1:] {
+				if _, err := svc.reminderRepository.ClaimReminder(event.ID, reminderKind(prefix, longer)); err != nil {
+					slog.Errorf("Could not claim %s reminder: %v", reminderKind(prefix, longer), err)
+				}
+			}
+
+			emails, err := recipients(event)
+			queued := 0
+			if err == nil {
+				subject, body := message(event)
+				queued, err = publishMailBatches(svc.notificationClient, "reminder", emails, subject, body)
+			}
+			if err != nil && queued == 0 {
+				slog.Errorf("Could not send %s reminder for event %d: %v", kind, event.ID, err)
+				if err := svc.reminderRepository.ReleaseReminder(event.ID, kind); err != nil {
+					slog.Errorf("Could not release %s reminder: %v", kind, err)
+				}
+				continue
+			}
+			if err != nil {
+				// Releasing the reminder would send it twice to the batches
+				// that were already queued, so the rest miss out on it.
+				slog.Errorf("Could not send %s reminder for event %d to %d of %d recipients: %v", kind, event.ID, len(emails)-queued, len(emails), err)
+				emails = emails[:queued]
+			}
+
+			slog.Infof("Sent %s reminder for event %d to %d recipients", kind, event.ID, len(emails))
+			if sent != nil {
+				sent(event, emails)
+			}
+		}
+	}
+}
+
+func (svc *ReminderService) participantEmails(event models.Event) ([]string, error) {
+	regs, err := svc.registrationRepository.GetEventRegistrations(event.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	emails := make([]string, 0, len(regs))
+	for _, reg := range regs {
+		if reg.Status == models.RegistrationConfirmed {
+			emails = append(emails, reg.Email)
+		}
+	}
+	return emails, nil
+}
+
+func (svc *ReminderService) unregisteredSubscriberEmails(event models.Event) ([]string, error) {
+	subscribers, err := svc.newsletterRepository.GetNewsletterEmails()
+	if err != nil {
+		return nil, err
+	}
+
+	regs, err := svc.registrationRepository.GetEventRegistrations(event.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	registered := make(map[string]bool, len(regs))
+	for _, reg := range regs {
+		registered[strings.ToLower(reg.Email)] = true
+	}
+
+	emails := make([]string, 0, len(subscribers))
+	for _, email := range subscribers {
+		if !registered[strings.ToLower(email)] {
+			emails = append(emails, email)
+		}
+	}
+	return emails, nil
+}
+
+// recordDeadlineNewsletter keeps the subscribers reminded of the deadline for
+// the newsletter conversion of the event analytics.
+func (svc *ReminderService) recordDeadlineNewsletter(event models.Event, emails []string) {
+	if len(emails) == 0 {
+		return
+	}
+	send, recipients := newsletterSend(event.ID, models.NewsletterDeadlineReminder, emails)
+	if err := svc.analyticsRepository.SaveNewsletterSend(send, recipients); err != nil {
+		slog.Errorf("Could not record newsletter send for event %d: %v", event.ID, err)
+	}
+}
+
+func startReminder(event models.Event) (string, string) {
+	return "Reminder: " + event.Name + " is coming up",
+		fmt.Sprintf("Don't forget, %s starts on %s at %s. See you there!", event.Name, event.StartDateTime.In(util.EventLocation(event)).Format("Monday, 2 January 15:04 MST"), event.Location)
+}
+
+func deadlineReminder(event models.Event) (string, string) {
+	return "Registration for " + event.Name + " closes soon",
+		fmt.Sprintf("Registration for %s closes on %s. Don't miss your chance to join!", event.Name, event.ApplicationDeadline.In(util.EventLocation(event)).Format("Monday, 2 January 15:04 MST"))
+}
+
+func reminderKind(prefix string, offset time.Duration) string {
+	return prefix + "_" + offset.String()
+}
+
+func sortedOffsets(offsets []time.Duration) []time.Duration {
+	sorted := append([]time.Duration(nil), offsets...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	return sorted
+}
@@ -0,0 +1,69 @@
+package service
+
+import (
+	"github.com/catness812/faf-hub-backend/event_service/internal/models"
+	"github.com/gookit/slog"
+)
+
+type IReportRepository interface {
+	CountRegistrationsBy(eventID uint, expr string, all bool) ([]models.Count, error)
+	CountTeams(eventID uint, minSize int) (int64, int64, int64, error)
+	CountSoloParticipants(eventID uint) (int64, error)
+}
+
+type ReportService struct {
+	reportRepository IReportRepository
+	eventRepository  IEventRepository
+}
+
+func NewReportService(reportRepo IReportRepository, eventRepo IEventRepository) *ReportService {
+	return &ReportService{
+		reportRepository: reportRepo,
+		eventRepository:  eventRepo,
+	}
+}
+
+// GetEventReport aggregates the logistics numbers of an event. Everything
+// except the status breakdown only counts confirmed registrations.
+func (svc *ReportService) GetEventReport(eventID uint) (models.EventReport, error) {
+	report := models.EventReport{EventID: eventID}
+
+	event, err := svc.eventRepository.GetEventByID(eventID)
+	if err != nil {
+		slog.Errorf("Could not retrieve event: %v", err)
+		return report, err
+	}
+
+	breakdowns := []struct {
+		expr   string
+		all    bool
+		counts *[]models.Count
+	}{
+		{"status", true, &report.ByStatus},
+		{"UPPER(TRIM(shirt_size))", false, &report.ShirtSizes},
+		{"LOWER(TRIM(food_preferences))", false, &report.FoodPreferences},
+		{"UPPER(TRIM(academic_group))", false, &report.AcademicGroups},
+	}
+	for _, b := range breakdowns {
+		if *b.counts, err = svc.reportRepository.CountRegistrationsBy(eventID, b.expr, b.all); err != nil {
+			slog.Errorf("Could not aggregate event registrations: %v", err)
+			return report, err
+		}
+	}
+	for _, c := range report.ByStatus {
+		report.Registrations += c.Count
+	}
+
+	if report.Teams, report.CompleteTeams, report.TeamMembers, err = svc.reportRepository.CountTeams(eventID, event.MinTeamSize); err != nil {
+		slog.Errorf("Could not aggregate event teams: %v", err)
+		return report, err
+	}
+
+	if report.SoloParticipants, err = svc.reportRepository.CountSoloParticipants(eventID); err != nil {
+		slog.Errorf("Could not count solo participants: %v", err)
+		return report, err
+	}
+
+	slog.Info("Event report successfully generated")
+	return report, nil
+}
@@ -0,0 +1,211 @@
+package service
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/catness812/faf-hub-backend/event_service/internal/models"
+	"github.com/catness812/faf-hub-backend/event_service/internal/util"
+	"github.com/gookit/slog"
+)
+
+type ISeriesRepository interface {
+	SaveSeries(series *models.EventSeries) error
+	GetSeriesByID(seriesID uint) (models.EventSeries, error)
+	GetOccurrence(eventID uint) (models.Event, error)
+	GetUserOccurrenceIDs(seriesID uint, userID uint) ([]uint, error)
+	GetSeriesAttendance(seriesID uint) ([]models.OccurrenceAttendance, error)
+}
+
+// IEventRegistrar registers for events with all the checks of a single event
+// registration.
+type IEventRegistrar interface {
+	RegisterForEvents(registrations []models.Registration) ([]string, error)
+}
+
+// IEventCanceller cancels an event and notifies its registrants.
+type IEventCanceller interface {
+	CancelEvent(eventID uint, reason string) (int, error)
+}
+
+type SeriesService struct {
+	seriesRepository ISeriesRepository
+	eventRegistrar   IEventRegistrar
+	eventCanceller   IEventCanceller
+}
+
+func NewSeriesService(seriesRepo ISeriesRepository, eventRegistrar IEventRegistrar, eventCanceller IEventCanceller) *SeriesService {
+	return &SeriesService{
+		seriesRepository: seriesRepo,
+		eventRegistrar:   eventRegistrar,
+		eventCanceller:   eventCanceller,
+	}
+}
+
+// CreateSeries creates an event for every occurrence of the series rule,
+// using the first occurrence for the time of day and the duration. The
+// occurrences are regular events, so they can be edited one by one.
+func (svc *SeriesService) CreateSeries(series models.EventSeries, start time.Time, end time.Time) (models.EventSeries, error) {
+	if series.Timezone == "" {
+		series.Timezone = models.DefaultTimezone
+	}
+	loc, err := time.LoadLocation(series.Timezone)
+	if err != nil {
+		slog.Errorf("Could not create event series: %v", err)
+		return series, fmt.Errorf("unknown timezone %s", series.Timezone)
+	}
+
+	if !end.After(start) {
+		slog.Error("Could not create event series: invalid duration")
+		return series, fmt.Errorf("end must be after start")
+	}
+
+	starts, err := util.ExpandRecurrence(series.RRule, start, loc)
+	if err != nil {
+		slog.Errorf("Could not create event series: %v", err)
+		return series, err
+	}
+
+	duration := end.Sub(start)
+	series.Occurrences = make([]models.Event, len(starts))
+	for i, occurrenceStart := range starts {
+		series.Occurrences[i] = models.Event{
+			Name:                series.Name,
+			StartDateTime:       occurrenceStart,
+			EndDateTime:         occurrenceStart.Add(duration),
+			Timezone:            series.Timezone,
+			Location:            series.Location,
+			ApplicationDeadline: occurrenceStart,
+			Cover:               series.Cover,
+			CoverMediaID:        series.CoverMediaID,
+			Description:         series.Description,
+			MinTeamSize:         1,
+			MaxTeamSize:         1,
+		}
+	}
+
+	if err := svc.seriesRepository.SaveSeries(&series); err != nil {
+		slog.Errorf("Could not create event series: %v", err)
+		return series, err
+	}
+
+	slog.Infof("Event series successfully created with %d occurrences: %s", len(series.Occurrences), series.Name)
+	return series, nil
+}
+
+func (svc *SeriesService) GetSeries(seriesID uint) (models.EventSeries, error) {
+	series, err := svc.seriesRepository.GetSeriesByID(seriesID)
+	if err != nil {
+		slog.Errorf("Could not retrieve event series: %v", err)
+		return series, err
+	}
+
+	slog.Info("Event series successfully retrieved")
+	return series, nil
+}
+
+// CancelOccurrence cancels a single occurrence the same way as any other
+// event, so its registrants are notified. It returns how many were.
+func (svc *SeriesService) CancelOccurrence(eventID uint, reason string) (int, error) {
+	if _, err := svc.seriesRepository.GetOccurrence(eventID); err != nil {
+		slog.Errorf("Could not retrieve occurrence: %v", err)
+		return 0, err
+	}
+
+	notified, err := svc.eventCanceller.CancelEvent(eventID, reason)
+	if err != nil {
+		slog.Errorf("Could not cancel occurrence: %v", err)
+		return 0, err
+	}
+
+	slog.Info("Occurrence successfully cancelled")
+	return notified, nil
+}
+
+// RegisterForSeries registers the user for all upcoming occurrences of the
+// series, or only for the given ones, the same way as for a single event. The
+// occurrences are registered for all or nothing, and the tickets of the new
+// registrations are returned.
+func (svc *SeriesService) RegisterForSeries(seriesID uint, registration models.Registration, allOccurrences bool, eventIDs []uint) ([]models.OccurrenceTicket, error) {
+	series, err := svc.seriesRepository.GetSeriesByID(seriesID)
+	if err != nil {
+		slog.Errorf("Could not retrieve event series: %v", err)
+		return nil, err
+	}
+
+	registeredIDs, err := svc.seriesRepository.GetUserOccurrenceIDs(seriesID, registration.UserID)
+	if err != nil {
+		slog.Errorf("Could not retrieve series registrations: %v", err)
+		return nil, err
+	}
+	registered := make(map[uint]bool, len(registeredIDs))
+	for _, id := range registeredIDs {
+		registered[id] = true
+	}
+
+	selected := make(map[uint]bool, len(eventIDs))
+	for _, id := range eventIDs {
+		selected[id] = true
+	}
+
+	now := time.Now()
+	upcoming := 0
+	var occurrences []models.Event
+	for _, occurrence := range series.Occurrences {
+		if !allOccurrences && !selected[occurrence.ID] {
+			continue
+		}
+		delete(selected, occurrence.ID)
+		if occurrence.CancelledAt != nil || occurrence.StartDateTime.Before(now) || occurrence.ApplicationDeadline.Before(now) {
+			continue
+		}
+		upcoming++
+		if !registered[occurrence.ID] {
+			occurrences = append(occurrences, occurrence)
+		}
+	}
+
+	if len(selected) > 0 {
+		slog.Error("Could not register for event series: unknown occurrences")
+		return nil, fmt.Errorf("some of the events aren't occurrences of this series")
+	}
+	if upcoming == 0 {
+		slog.Error("Could not register for event series: no upcoming occurrences")
+		return nil, fmt.Errorf("there are no upcoming occurrences to register for")
+	}
+
+	registrations := make([]models.Registration, len(occurrences))
+	for i, occurrence := range occurrences {
+		registrations[i] = registration
+		registrations[i].EventID = occurrence.ID
+	}
+	created, err := svc.eventRegistrar.RegisterForEvents(registrations)
+	if err != nil {
+		slog.Errorf("Could not register for event series: %v", err)
+		return nil, err
+	}
+
+	tickets := make([]models.OccurrenceTicket, len(created))
+	for i, ticket := range created {
+		tickets[i] = models.OccurrenceTicket{EventID: registrations[i].EventID, Ticket: ticket}
+	}
+
+	slog.Infof("Successfully registered for %d occurrences", len(tickets))
+	return tickets, nil
+}
+
+func (svc *SeriesService) GetSeriesAttendance(seriesID uint) ([]models.OccurrenceAttendance, error) {
+	if _, err := svc.seriesRepository.GetSeriesByID(seriesID); err != nil {
+		slog.Errorf("Could not retrieve event series: %v", err)
+		return nil, err
+	}
+
+	attendance, err := svc.seriesRepository.GetSeriesAttendance(seriesID)
+	if err != nil {
+		slog.Errorf("Could not retrieve series attendance: %v", err)
+		return nil, err
+	}
+
+	slog.Info("Series attendance successfully retrieved")
+	return attendance, nil
+}
@@ -0,0 +1,496 @@
+package service
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/catness812/faf-hub-backend/event_service/internal/models"
+	"github.com/gookit/slog"
+)
+
+type ITeamRepository interface {
+	SaveTeam(team *models.Team) (uint, error)
+	UpdateTeam(team models.Team) error
+	DeleteTeam(teamID uint) error
+	GetTeamByID(teamID uint) (models.Team, error)
+	GetEventTeams(eventID uint) ([]models.Team, error)
+	GetUserEventTeam(eventID uint, userID uint) (models.Team, error)
+	SaveTeamMember(member models.TeamMember) error
+	UpdateTeamMember(member models.TeamMember) error
+	AcceptTeamInvite(member models.TeamMember, maxTeamSize int) error
+	DeclineTeamInvite(member models.TeamMember) error
+	GetTeamMemberByID(memberID uint) (models.TeamMember, error)
+	GetUserPendingInvites(userID uint) ([]models.TeamMember, error)
+	GetEventSoloRegistrations(eventID uint) ([]models.Registration, error)
+	SaveMatchingProfile(profile models.MatchingProfile) error
+	DeleteMatchingProfile(eventID uint, userID uint) error
+	GetEventMatchingCandidates(eventID uint) ([]models.MatchingProfile, error)
+	SaveTeams(teams []models.Team) error
+}
+
+type TeamService struct {
+	teamRepository         ITeamRepository
+	registrationRepository IRegistrationRepository
+	eventRepository        IEventRepository
+}
+
+func NewTeamService(
+	teamRepo ITeamRepository,
+	registrationRepo IRegistrationRepository,
+	eventRepo IEventRepository,
+) *TeamService {
+	return &TeamService{
+		teamRepository:         teamRepo,
+		registrationRepository: registrationRepo,
+		eventRepository:        eventRepo,
+	}
+}
+
+func (svc *TeamService) CreateTeam(eventID uint, captainID uint, name string) (uint, error) {
+	if _, err := svc.eventRepository.GetEventByID(eventID); err != nil {
+		slog.Errorf("Could not retrieve event: %v", err)
+		return 0, err
+	}
+
+	registration, err := svc.registrationRepository.GetUserEventRegistration(eventID, captainID)
+	if err != nil {
+		slog.Errorf("Could not retrieve user event registration: %v", err)
+		return 0, err
+	}
+
+	if _, err := svc.teamRepository.GetUserEventTeam(eventID, captainID); err == nil {
+		slog.Error("User is already in a team for this event")
+		return 0, fmt.Errorf("user is already in a team for this event")
+	}
+
+	team := models.Team{
+		EventID:   eventID,
+		Name:      name,
+		CaptainID: captainID,
+		Members: []models.TeamMember{{
+			UserID: captainID,
+			Email:  registration.Email,
+			Status: models.TeamMemberAccepted,
+		}},
+	}
+
+	teamID, err := svc.teamRepository.SaveTeam(&team)
+	if err != nil {
+		slog.Errorf("Could not create team: %v", err)
+		return 0, err
+	}
+
+	slog.Infof("Team successfully created: %s", team.Name)
+	return teamID, nil
+}
+
+func (svc *TeamService) InviteTeamMember(teamID uint, captainID uint, inviteeID uint, inviteeEmail string) error {
+	team, err := svc.getCaptainTeam(teamID, captainID)
+	if err != nil {
+		return err
+	}
+
+	event, err := svc.eventRepository.GetEventByID(team.EventID)
+	if err != nil {
+		slog.Errorf("Could not retrieve event: %v", err)
+		return err
+	}
+
+	var registration models.Registration
+	if inviteeID != 0 {
+		registration, err = svc.registrationRepository.GetUserEventRegistration(team.EventID, inviteeID)
+	} else if inviteeEmail != "" {
+		registration, err = svc.registrationRepository.GetEventRegistrationByEmail(team.EventID, inviteeEmail)
+	} else {
+		err = fmt.Errorf("invitee user id or email is required")
+	}
+	if err != nil {
+		slog.Errorf("Could not retrieve invitee event registration: %v", err)
+		return err
+	}
+	if registration.Guest {
+		slog.Error("Invitee is a guest")
+		return fmt.Errorf("guests can't join teams, the invitee needs an account")
+	}
+
+	if _, err := svc.teamRepository.GetUserEventTeam(team.EventID, registration.UserID); err == nil {
+		slog.Error("Invitee is already in a team for this event")
+		return fmt.Errorf("invitee is already in a team for this event")
+	}
+
+	size := 0
+	for _, member := range team.Members {
+		if member.UserID == registration.UserID && member.Status == models.TeamMemberPending {
+			slog.Error("Invitee already has a pending invite")
+			return fmt.Errorf("invitee already has a pending invite")
+		}
+		if member.Status != models.TeamMemberDeclined {
+			size++
+		}
+	}
+	if size >= event.MaxTeamSize {
+		slog.Error("Team is full")
+		return fmt.Errorf("team can have at most %d members", event.MaxTeamSize)
+	}
+
+	if err := svc.teamRepository.SaveTeamMember(models.TeamMember{
+		TeamID: team.ID,
+		UserID: registration.UserID,
+		Email:  registration.Email,
+		Status: models.TeamMemberPending,
+	}); err != nil {
+		slog.Errorf("Could not save team invite: %v", err)
+		return err
+	}
+
+	slog.Info("Team invite successfully saved")
+	return nil
+}
+
+func (svc *TeamService) RespondTeamInvite(memberID uint, userID uint, accept bool) error {
+	member, err := svc.teamRepository.GetTeamMemberByID(memberID)
+	if err != nil {
+		slog.Errorf("Could not retrieve team invite: %v", err)
+		return err
+	}
+
+	if member.UserID != userID {
+		slog.Error("Team invite belongs to another user")
+		return fmt.Errorf("team invite doesn't exist")
+	}
+	if member.Status != models.TeamMemberPending {
+		slog.Error("Team invite was already answered")
+		return fmt.Errorf("team invite was already answered")
+	}
+
+	if !accept {
+		if err := svc.teamRepository.DeclineTeamInvite(member); err != nil {
+			slog.Errorf("Could not update team invite: %v", err)
+			return err
+		}
+		slog.Info("Team invite successfully declined")
+		return nil
+	}
+
+	team, err := svc.teamRepository.GetTeamByID(member.TeamID)
+	if err != nil {
+		slog.Errorf("Could not retrieve team: %v", err)
+		return err
+	}
+
+	event, err := svc.eventRepository.GetEventByID(team.EventID)
+	if err != nil {
+		slog.Errorf("Could not retrieve event: %v", err)
+		return err
+	}
+
+	if err := svc.teamRepository.AcceptTeamInvite(member, event.MaxTeamSize); err != nil {
+		slog.Errorf("Could not accept team invite: %v", err)
+		return err
+	}
+
+	slog.Info("Team invite successfully accepted")
+	return nil
+}
+
+func (svc *TeamService) TransferTeamCaptain(teamID uint, captainID uint, newCaptainID uint) error {
+	team, err := svc.getCaptainTeam(teamID, captainID)
+	if err != nil {
+		return err
+	}
+
+	isMember := false
+	for _, member := range team.Members {
+		if member.UserID == newCaptainID && member.Status == models.TeamMemberAccepted {
+			isMember = true
+			break
+		}
+	}
+	if !isMember {
+		slog.Error("New captain is not a team member")
+		return fmt.Errorf("new captain must be a member of the team")
+	}
+
+	team.CaptainID = newCaptainID
+	if err := svc.teamRepository.UpdateTeam(team); err != nil {
+		slog.Errorf("Could not update team: %v", err)
+		return err
+	}
+
+	slog.Info("Team captain successfully transferred")
+	return nil
+}
+
+func (svc *TeamService) DisbandTeam(teamID uint, captainID uint) error {
+	if _, err := svc.getCaptainTeam(teamID, captainID); err != nil {
+		return err
+	}
+
+	if err := svc.teamRepository.DeleteTeam(teamID); err != nil {
+		slog.Errorf("Could not disband team: %v", err)
+		return err
+	}
+
+	slog.Info("Team successfully disbanded")
+	return nil
+}
+
+func (svc *TeamService) GetUserTeam(eventID uint, userID uint) (models.Team, error) {
+	team, err := svc.teamRepository.GetUserEventTeam(eventID, userID)
+	if err != nil {
+		slog.Errorf("Could not retrieve user team: %v", err)
+		return team, err
+	}
+
+	event, err := svc.eventRepository.GetEventByID(eventID)
+	if err != nil {
+		slog.Errorf("Could not retrieve event: %v", err)
+		return team, err
+	}
+	team.Complete = countAccepted(team) >= event.MinTeamSize
+
+	slog.Info("User team successfully retrieved")
+	return team, nil
+}
+
+func (svc *TeamService) GetUserTeamInvites(userID uint) ([]models.TeamMember, error) {
+	invites, err := svc.teamRepository.GetUserPendingInvites(userID)
+	if err != nil {
+		slog.Errorf("Could not retrieve team invites: %v", err)
+		return nil, err
+	}
+
+	slog.Info("Team invites successfully retrieved")
+	return invites, nil
+}
+
+func (svc *TeamService) GetEventTeams(eventID uint) ([]models.Team, []models.Registration, error) {
+	event, err := svc.eventRepository.GetEventByID(eventID)
+	if err != nil {
+		slog.Errorf("Could not retrieve event: %v", err)
+		return nil, nil, err
+	}
+
+	teams, err := svc.teamRepository.GetEventTeams(eventID)
+	if err != nil {
+		slog.Errorf("Could not retrieve event teams: %v", err)
+		return nil, nil, err
+	}
+	for i := range teams {
+		teams[i].Complete = countAccepted(teams[i]) >= event.MinTeamSize
+	}
+
+	solo, err := svc.teamRepository.GetEventSoloRegistrations(eventID)
+	if err != nil {
+		slog.Errorf("Could not retrieve solo participants: %v", err)
+		return nil, nil, err
+	}
+
+	slog.Info("Event teams successfully retrieved")
+	return teams, solo, nil
+}
+
+func (svc *TeamService) OptInTeamMatching(profile models.MatchingProfile) error {
+	if _, err := svc.registrationRepository.GetUserEventRegistration(profile.EventID, profile.UserID); err != nil {
+		slog.Errorf("Could not retrieve user event registration: %v", err)
+		return err
+	}
+
+	if _, err := svc.teamRepository.GetUserEventTeam(profile.EventID, profile.UserID); err == nil {
+		slog.Error("User is already in a team for this event")
+		return fmt.Errorf("user is already in a team for this event")
+	}
+
+	profile.Skills = normalizeList(profile.Skills)
+	profile.Interests = normalizeList(profile.Interests)
+
+	if err := svc.teamRepository.SaveMatchingProfile(profile); err != nil {
+		slog.Errorf("Could not save matching profile: %v", err)
+		return err
+	}
+
+	slog.Info("Matching profile successfully saved")
+	return nil
+}
+
+func (svc *TeamService) OptOutTeamMatching(eventID uint, userID uint) error {
+	if err := svc.teamRepository.DeleteMatchingProfile(eventID, userID); err != nil {
+		slog.Errorf("Could not delete matching profile: %v", err)
+		return err
+	}
+
+	slog.Info("Matching profile successfully deleted")
+	return nil
+}
+
+// MatchTeams groups the solo participants who opted in to matching into
+// proposed teams. Each member gets a pending invite, so a team only forms
+// once its members accept.
+func (svc *TeamService) MatchTeams(eventID uint) ([]models.Team, error) {
+	event, err := svc.eventRepository.GetEventByID(eventID)
+	if err != nil {
+		slog.Errorf("Could not retrieve event: %v", err)
+		return nil, err
+	}
+
+	profiles, err := svc.teamRepository.GetEventMatchingCandidates(eventID)
+	if err != nil {
+		slog.Errorf("Could not retrieve matching candidates: %v", err)
+		return nil, err
+	}
+
+	regs, err := svc.registrationRepository.GetEventRegistrations(eventID)
+	if err != nil {
+		slog.Errorf("Could not retrieve event registrations: %v", err)
+		return nil, err
+	}
+	regsByUser := make(map[uint]models.Registration, len(regs))
+	for _, reg := range regs {
+		regsByUser[reg.UserID] = reg
+	}
+
+	candidates := make([]matchCandidate, 0, len(profiles))
+	for _, profile := range profiles {
+		if reg, ok := regsByUser[profile.UserID]; ok {
+			candidates = append(candidates, matchCandidate{profile: profile, registration: reg})
+		}
+	}
+
+	groups := proposeTeams(candidates, event.MinTeamSize, event.MaxTeamSize)
+	if len(groups) == 0 {
+		slog.Error("Not enough participants for team matching")
+		return nil, fmt.Errorf("not enough participants opted in to form a team of %d", event.MinTeamSize)
+	}
+
+	existing, err := svc.teamRepository.GetEventTeams(eventID)
+	if err != nil {
+		slog.Errorf("Could not retrieve event teams: %v", err)
+		return nil, err
+	}
+
+	teams := make([]models.Team, len(groups))
+	for i, group := range groups {
+		members := make([]models.TeamMember, len(group))
+		for j, candidate := range group {
+			members[j] = models.TeamMember{
+				UserID: candidate.registration.UserID,
+				Email:  candidate.registration.Email,
+				Status: models.TeamMemberPending,
+			}
+		}
+		teams[i] = models.Team{
+			EventID:   eventID,
+			Name:      fmt.Sprintf("Team %d", len(existing)+i+1),
+			CaptainID: group[0].registration.UserID,
+			Matched:   true,
+			Members:   members,
+		}
+	}
+
+	if err := svc.teamRepository.SaveTeams(teams); err != nil {
+		slog.Errorf("Could not save proposed teams: %v", err)
+		return nil, err
+	}
+
+	slog.Infof("Successfully proposed %d teams", len(teams))
+	return teams, nil
+}
+
+func (svc *TeamService) getCaptainTeam(teamID uint, captainID uint) (models.Team, error) {
+	team, err := svc.teamRepository.GetTeamByID(teamID)
+	if err != nil {
+		slog.Errorf("Could not retrieve team: %v", err)
+		return team, err
+	}
+
+	if team.CaptainID != captainID {
+		slog.Error("User is not the team captain")
+		return team, fmt.Errorf("only the team captain can manage the team")
+	}
+
+	return team, nil
+}
+
+func countAccepted(team models.Team) int {
+	count := 0
+	for _, member := range team.Members {
+		if member.Status == models.TeamMemberAccepted {
+			count++
+		}
+	}
+	return count
+}
+
+type matchCandidate struct {
+	profile      models.MatchingProfile
+	registration models.Registration
+}
+
+// proposeTeams splits candidates into as many teams as needed to respect the
+// maximum size while keeping every team at or above the minimum. Candidates
+// are ordered by their main skill, main interest and academic group and then
+// dealt out snake-style, so people with similar profiles land in different
+// teams. The same input always yields the same teams.
+func proposeTeams(candidates []matchCandidate, minSize int, maxSize int) [][]matchCandidate {
+	n := len(candidates)
+	if minSize < 1 {
+		minSize = 1
+	}
+	if maxSize < minSize {
+		maxSize = minSize
+	}
+	if n < minSize || maxSize < 2 {
+		return nil
+	}
+
+	count := (n + maxSize - 1) / maxSize
+	if count*minSize > n {
+		count = n / minSize
+	}
+
+	sorted := make([]matchCandidate, n)
+	copy(sorted, candidates)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		a, b := sorted[i], sorted[j]
+		if x, y := firstItem(a.profile.Skills), firstItem(b.profile.Skills); x != y {
+			return x < y
+		}
+		if x, y := firstItem(a.profile.Interests), firstItem(b.profile.Interests); x != y {
+			return x < y
+		}
+		if x, y := a.registration.AcademicGroup, b.registration.AcademicGroup; x != y {
+			return x < y
+		}
+		return a.profile.UserID < b.profile.UserID
+	})
+	if len(sorted) > count*maxSize {
+		sorted = sorted[:count*maxSize]
+	}
+
+	groups := make([][]matchCandidate, count)
+	for i, candidate := range sorted {
+		pos := i % count
+		if (i/count)%2 == 1 {
+			pos = count - 1 - pos
+		}
+		groups[pos] = append(groups[pos], candidate)
+	}
+	return groups
+}
+
+func normalizeList(list string) string {
+	var items []string
+	for _, item := range strings.Split(list, ",") {
+		item = strings.ToLower(strings.TrimSpace(item))
+		if item != "" {
+			items = append(items, item)
+		}
+	}
+	return strings.Join(items, ", ")
+}
+
+func firstItem(list string) string {
+	item, _, _ := strings.Cut(list, ",")
+	return item
+}
@@ -0,0 +1,67 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/catness812/faf-hub-backend/event_service/internal/models"
+	"gorm.io/gorm"
+)
+
+// The fakes embed the interfaces, so calling a method they don't override
+// fails the test with a nil pointer panic.
+
+type fakeTeamRepository struct {
+	ITeamRepository
+	team  models.Team
+	saved []models.TeamMember
+}
+
+func (repo *fakeTeamRepository) GetTeamByID(teamID uint) (models.Team, error) {
+	return repo.team, nil
+}
+
+func (repo *fakeTeamRepository) SaveTeamMember(member models.TeamMember) error {
+	repo.saved = append(repo.saved, member)
+	return nil
+}
+
+type fakeEventRepository struct {
+	IEventRepository
+	event models.Event
+}
+
+func (repo *fakeEventRepository) GetEventByID(eventID uint) (models.Event, error) {
+	return repo.event, nil
+}
+
+type fakeRegistrationRepository struct {
+	IRegistrationRepository
+	registration models.Registration
+}
+
+func (repo *fakeRegistrationRepository) GetEventRegistrationByEmail(eventID uint, email string) (models.Registration, error) {
+	return repo.registration, nil
+}
+
+func TestInviteTeamMemberRejectsGuests(t *testing.T) {
+	teamRepo := &fakeTeamRepository{team: models.Team{
+		Model:     gorm.Model{ID: 1},
+		EventID:   1,
+		CaptainID: 7,
+		Members:   []models.TeamMember{{UserID: 7, Status: models.TeamMemberAccepted}},
+	}}
+	eventRepo := &fakeEventRepository{event: models.Event{Model: gorm.Model{ID: 1}, MaxTeamSize: 4}}
+	registrationRepo := &fakeRegistrationRepository{registration: models.Registration{
+		EventID: 1,
+		Email:   "guest@example.com",
+		Guest:   true,
+	}}
+	svc := NewTeamService(teamRepo, registrationRepo, eventRepo)
+
+	if err := svc.InviteTeamMember(1, 7, 0, "guest@example.com"); err == nil {
+		t.Fatal("inviting a guest registration succeeded")
+	}
+	if len(teamRepo.saved) > 0 {
+		t.Errorf("saved a team invite for a guest: %+v", teamRepo.saved)
+	}
+}
@@ -0,0 +1,252 @@
+package service
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/catness812/faf-hub-backend/event_service/internal/models"
+	"github.com/gookit/slog"
+)
+
+type ITemplateRepository interface {
+	SaveTemplate(template *models.EventTemplate) (uint, error)
+	GetTemplateByID(templateID uint) (models.EventTemplate, error)
+	GetTemplates() ([]models.EventTemplate, error)
+	DeleteTemplate(templateID uint) error
+	SaveEventCopy(event *models.Event, speakers []models.Speaker, sessions []models.Session, organizers []models.EventOrganizer) error
+}
+
+type TemplateService struct {
+	templateRepository  ITemplateRepository
+	eventRepository     IEventRepository
+	agendaRepository    IAgendaRepository
+	organizerRepository IOrganizerRepository
+	venueRepository     IVenueRepository
+}
+
+func NewTemplateService(
+	templateRepo ITemplateRepository,
+	eventRepo IEventRepository,
+	agendaRepo IAgendaRepository,
+	organizerRepo IOrganizerRepository,
+	venueRepo IVenueRepository,
+) *TemplateService {
+	return &TemplateService{
+		templateRepository:  templateRepo,
+		eventRepository:     eventRepo,
+		agendaRepository:    agendaRepo,
+		organizerRepository: organizerRepo,
+		venueRepository:     venueRepo,
+	}
+}
+
+// DuplicateEvent copies the event with its agenda and organizers so that it
+// starts at the given time, shifting every other time by the same amount.
+// Registrations, teams and feedback stay with the original event.
+func (svc *TemplateService) DuplicateEvent(eventID uint, start time.Time, name string) (uint, error) {
+	event, err := svc.eventRepository.GetEventByID(eventID)
+	if err != nil {
+		slog.Errorf("Could not retrieve event: %v", err)
+		return 0, err
+	}
+
+	if start.IsZero() {
+		slog.Error("Could not duplicate event: missing start")
+		return 0, fmt.Errorf("start of the new event is required")
+	}
+	shift := start.Sub(event.StartDateTime)
+
+	sessions, err := svc.agendaRepository.GetEventSessions(eventID)
+	if err != nil {
+		slog.Errorf("Could not retrieve event sessions: %v", err)
+		return 0, err
+	}
+	speakers, err := svc.agendaRepository.GetEventSpeakers(eventID)
+	if err != nil {
+		slog.Errorf("Could not retrieve event speakers: %v", err)
+		return 0, err
+	}
+	organizers, err := svc.organizerRepository.GetEventOrganizers(eventID)
+	if err != nil {
+		slog.Errorf("Could not retrieve event organizers: %v", err)
+		return 0, err
+	}
+
+	// copy the whole event so new settings carry over, then drop what
+	// belongs to the original only
+	newEvent := event
+	newEvent.ID = 0
+	newEvent.CreatedAt = time.Time{}
+	newEvent.UpdatedAt = time.Time{}
+	newEvent.StartDateTime = event.StartDateTime.Add(shift)
+	newEvent.EndDateTime = event.EndDateTime.Add(shift)
+	newEvent.ApplicationDeadline = event.ApplicationDeadline.Add(shift)
+	newEvent.SeriesID = nil
+	newEvent.CancelledAt = nil
+	newEvent.CancellationReason = ""
+	if strings.TrimSpace(name) != "" {
+		newEvent.Name = strings.TrimSpace(name)
+	}
+	if err := prepareNewEvent(&newEvent); err != nil {
+		slog.Errorf("Could not duplicate event: %v", err)
+		return 0, err
+	}
+	if err := bookRoom(svc.venueRepository, &newEvent, nil); err != nil {
+		slog.Errorf("Could not duplicate event: %v", err)
+		return 0, err
+	}
+
+	for i := range sessions {
+		sessions[i].StartTime = sessions[i].StartTime.Add(shift)
+		sessions[i].EndTime = sessions[i].EndTime.Add(shift)
+	}
+
+	if err := svc.templateRepository.SaveEventCopy(&newEvent, speakers, sessions, organizers); err != nil {
+		slog.Errorf("Could not duplicate event: %v", err)
+		return 0, err
+	}
+
+	slog.Infof("Event successfully duplicated: %s", newEvent.Name)
+	return newEvent.ID, nil
+}
+
+// CreateTemplate saves a new template, or one based on an existing event
+// when eventID is set.
+func (svc *TemplateService) CreateTemplate(template models.EventTemplate, eventID uint) (uint, error) {
+	if eventID != 0 {
+		event, err := svc.eventRepository.GetEventByID(eventID)
+		if err != nil {
+			slog.Errorf("Could not retrieve event: %v", err)
+			return 0, err
+		}
+		template = templateFromEvent(template.Name, event)
+	}
+
+	template.Name = strings.TrimSpace(template.Name)
+	if template.Name == "" {
+		slog.Error("Could not create event template: missing name")
+		return 0, fmt.Errorf("template name is required")
+	}
+	if template.DurationMinutes <= 0 {
+		slog.Error("Could not create event template: invalid duration")
+		return 0, fmt.Errorf("template duration must be positive")
+	}
+	if template.DeadlineMinutesBefore < 0 {
+		slog.Error("Could not create event template: invalid deadline")
+		return 0, fmt.Errorf("deadline can't be after the start")
+	}
+
+	// validate the details the same way as the events made from the template
+	event := eventFromTemplate(template, time.Now())
+	if err := prepareNewEvent(&event); err != nil {
+		slog.Errorf("Could not create event template: %v", err)
+		return 0, err
+	}
+	template.Timezone = event.Timezone
+	template.MinTeamSize = event.MinTeamSize
+	template.MaxTeamSize = event.MaxTeamSize
+	template.Tags = strings.Join(tagNames(event.Tags), ",")
+
+	templateID, err := svc.templateRepository.SaveTemplate(&template)
+	if err != nil {
+		slog.Errorf("Could not create event template: %v", err)
+		return 0, err
+	}
+
+	slog.Infof("Event template successfully created: %s", template.Name)
+	return templateID, nil
+}
+
+func (svc *TemplateService) GetTemplates() ([]models.EventTemplate, error) {
+	templates, err := svc.templateRepository.GetTemplates()
+	if err != nil {
+		slog.Errorf("Could not retrieve event templates: %v", err)
+		return nil, err
+	}
+
+	slog.Info("Event templates successfully retrieved")
+	return templates, nil
+}
+
+func (svc *TemplateService) DeleteTemplate(templateID uint) error {
+	if err := svc.templateRepository.DeleteTemplate(templateID); err != nil {
+		slog.Errorf("Could not delete event template: %v", err)
+		return err
+	}
+
+	slog.Info("Event template successfully deleted")
+	return nil
+}
+
+func (svc *TemplateService) CreateEventFromTemplate(templateID uint, start time.Time, name string) (uint, error) {
+	template, err := svc.templateRepository.GetTemplateByID(templateID)
+	if err != nil {
+		slog.Errorf("Could not retrieve event template: %v", err)
+		return 0, err
+	}
+
+	if start.IsZero() {
+		slog.Error("Could not create event from template: missing start")
+		return 0, fmt.Errorf("start of the new event is required")
+	}
+
+	event := eventFromTemplate(template, start)
+	if strings.TrimSpace(name) != "" {
+		event.Name = strings.TrimSpace(name)
+	}
+	if err := prepareNewEvent(&event); err != nil {
+		slog.Errorf("Could not create event from template: %v", err)
+		return 0, err
+	}
+
+	eventID, err := svc.eventRepository.SaveEvent(&event)
+	if err != nil {
+		slog.Errorf("Could not create event from template: %v", err)
+		return 0, err
+	}
+
+	slog.Infof("Event successfully created from template %s: %s", template.Name, event.Name)
+	return eventID, nil
+}
+
+func templateFromEvent(name string, event models.Event) models.EventTemplate {
+	return models.EventTemplate{
+		Name:                  name,
+		EventName:             event.Name,
+		Description:           event.Description,
+		Location:              event.Location,
+		Cover:                 event.Cover,
+		CoverMediaID:          event.CoverMediaID,
+		Timezone:              event.Timezone,
+		Category:              event.Category,
+		Tags:                  strings.Join(tagNames(event.Tags), ","),
+		MinTeamSize:           event.MinTeamSize,
+		MaxTeamSize:           event.MaxTeamSize,
+		DurationMinutes:       int(event.EndDateTime.Sub(event.StartDateTime).Minutes()),
+		DeadlineMinutesBefore: max(int(event.StartDateTime.Sub(event.ApplicationDeadline).Minutes()), 0),
+	}
+}
+
+func eventFromTemplate(template models.EventTemplate, start time.Time) models.Event {
+	var tags []models.Tag
+	if template.Tags != "" {
+		tags = tagsFromNames(strings.Split(template.Tags, ","))
+	}
+
+	return models.Event{
+		Name:                template.EventName,
+		StartDateTime:       start,
+		EndDateTime:         start.Add(time.Duration(template.DurationMinutes) * time.Minute),
+		Timezone:            template.Timezone,
+		Location:            template.Location,
+		ApplicationDeadline: start.Add(-time.Duration(template.DeadlineMinutesBefore) * time.Minute),
+		Cover:               template.Cover,
+		CoverMediaID:        template.CoverMediaID,
+		Description:         template.Description,
+		MinTeamSize:         template.MinTeamSize,
+		MaxTeamSize:         template.MaxTeamSize,
+		Category:            template.Category,
+		Tags:                tags,
+	}
+}
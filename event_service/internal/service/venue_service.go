@@ -0,0 +1,282 @@
+package service
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/catness812/faf-hub-backend/event_service/internal/models"
+	"github.com/gookit/slog"
+)
+
+type IVenueRepository interface {
+	SaveVenue(venue *models.Venue) (uint, error)
+	GetVenueByID(venueID uint) (models.Venue, error)
+	GetVenues() ([]models.Venue, error)
+	DeleteVenue(venueID uint) error
+	SaveRoom(room *models.Room) (uint, error)
+	UpdateRoom(room models.Room) error
+	GetRoomByID(roomID uint) (models.Room, error)
+	GetBookedRoomByID(roomID uint) (models.Room, error)
+	DeleteRoom(roomID uint) error
+	GetRoomBookings(roomID uint, from time.Time, to time.Time) ([]models.Event, error)
+	GetOverlappingBooking(roomID uint, start time.Time, end time.Time, excludeID uint) (models.Event, bool, error)
+	CountUpcomingBookings(roomID uint) (int64, error)
+}
+
+type VenueService struct {
+	venueRepository IVenueRepository
+}
+
+func NewVenueService(venueRepo IVenueRepository) *VenueService {
+	return &VenueService{
+		venueRepository: venueRepo,
+	}
+}
+
+func (svc *VenueService) CreateVenue(venue models.Venue) (uint, error) {
+	venue.Name = strings.TrimSpace(venue.Name)
+	if venue.Name == "" {
+		slog.Error("Could not create venue: missing name")
+		return 0, fmt.Errorf("venue name is required")
+	}
+
+	venueID, err := svc.venueRepository.SaveVenue(&venue)
+	if err != nil {
+		slog.Errorf("Could not create venue: %v", err)
+		return 0, err
+	}
+
+	slog.Infof("Venue successfully created: %s", venue.Name)
+	return venueID, nil
+}
+
+func (svc *VenueService) GetVenues() ([]models.Venue, error) {
+	venues, err := svc.venueRepository.GetVenues()
+	if err != nil {
+		slog.Errorf("Could not retrieve venues: %v", err)
+		return nil, err
+	}
+
+	slog.Info("Venues successfully retrieved")
+	return venues, nil
+}
+
+func (svc *VenueService) DeleteVenue(venueID uint) error {
+	if err := svc.venueRepository.DeleteVenue(venueID); err != nil {
+		slog.Errorf("Could not delete venue: %v", err)
+		return err
+	}
+
+	slog.Info("Venue successfully deleted")
+	return nil
+}
+
+func (svc *VenueService) CreateRoom(room models.Room) (uint, error) {
+	if _, err := svc.venueRepository.GetVenueByID(room.VenueID); err != nil {
+		slog.Errorf("Could not retrieve venue: %v", err)
+		return 0, err
+	}
+
+	if err := prepareRoom(&room); err != nil {
+		slog.Errorf("Could not create room: %v", err)
+		return 0, err
+	}
+
+	roomID, err := svc.venueRepository.SaveRoom(&room)
+	if err != nil {
+		slog.Errorf("Could not create room: %v", err)
+		return 0, err
+	}
+
+	slog.Infof("Room successfully created: %s", room.Name)
+	return roomID, nil
+}
+
+func (svc *VenueService) UpdateRoom(room models.Room) error {
+	newRoom, err := svc.venueRepository.GetRoomByID(room.ID)
+	if err != nil {
+		slog.Errorf("Could not retrieve room: %v", err)
+		return err
+	}
+
+	if room.Name != "" {
+		newRoom.Name = room.Name
+	}
+	if room.Capacity != 0 {
+		newRoom.Capacity = room.Capacity
+	}
+	if room.Equipment != "" {
+		newRoom.Equipment = room.Equipment
+	}
+
+	if err := prepareRoom(&newRoom); err != nil {
+		slog.Errorf("Could not update room: %v", err)
+		return err
+	}
+
+	if err := svc.venueRepository.UpdateRoom(newRoom); err != nil {
+		slog.Errorf("Could not update room: %v", err)
+		return err
+	}
+
+	slog.Info("Room successfully updated")
+	return nil
+}
+
+// DeleteRoom refuses to remove rooms that upcoming events are booked in.
+func (svc *VenueService) DeleteRoom(roomID uint) error {
+	upcoming, err := svc.venueRepository.CountUpcomingBookings(roomID)
+	if err != nil {
+		slog.Errorf("Could not retrieve room bookings: %v", err)
+		return err
+	}
+	if upcoming > 0 {
+		slog.Error("Could not delete room: room has upcoming bookings")
+		return fmt.Errorf("room is booked for %d upcoming events", upcoming)
+	}
+
+	if err := svc.venueRepository.DeleteRoom(roomID); err != nil {
+		slog.Errorf("Could not delete room: %v", err)
+		return err
+	}
+
+	slog.Info("Room successfully deleted")
+	return nil
+}
+
+// GetRoomAvailability returns the bookings of the room between from and to,
+// and the free time slots left around them. The range defaults to the next
+// week.
+func (svc *VenueService) GetRoomAvailability(roomID uint, from time.Time, to time.Time) (models.RoomAvailability, error) {
+	var availability models.RoomAvailability
+
+	if from.IsZero() {
+		from = time.Now()
+	}
+	if to.IsZero() {
+		to = from.Add(models.DefaultAvailabilityRange)
+	}
+	if !to.After(from) {
+		slog.Error("Could not retrieve room availability: invalid range")
+		return availability, fmt.Errorf("end of the range must be after its start")
+	}
+	if to.Sub(from) > models.MaxAvailabilityRange {
+		slog.Error("Could not retrieve room availability: range too long")
+		return availability, fmt.Errorf("range can't be longer than %d days", int(models.MaxAvailabilityRange.Hours()/24))
+	}
+
+	room, err := svc.venueRepository.GetRoomByID(roomID)
+	if err != nil {
+		slog.Errorf("Could not retrieve room: %v", err)
+		return availability, err
+	}
+
+	bookings, err := svc.venueRepository.GetRoomBookings(roomID, from, to)
+	if err != nil {
+		slog.Errorf("Could not retrieve room bookings: %v", err)
+		return availability, err
+	}
+
+	availability = models.RoomAvailability{
+		Room:     room,
+		Bookings: bookings,
+		Free:     freeSlots(bookings, from, to),
+	}
+
+	slog.Info("Room availability successfully retrieved")
+	return availability, nil
+}
+
+// bookRoom checks that the room of the event exists and isn't taken by
+// another event at the same time. Events without a location get the room as
+// their location. When previous is given and neither the room nor the times
+// changed, the booking stands as is, even if the room was deleted since.
+func bookRoom(venueRepo IVenueRepository, event *models.Event, previous *models.Event) error {
+	if event.RoomID == nil {
+		return nil
+	}
+
+	if previous != nil && !bookingChanged(*previous, *event) {
+		if event.Location != "" {
+			return nil
+		}
+		room, err := venueRepo.GetBookedRoomByID(*event.RoomID)
+		if err != nil {
+			return err
+		}
+		event.Location = roomLocation(room)
+		return nil
+	}
+
+	room, err := venueRepo.GetRoomByID(*event.RoomID)
+	if err != nil {
+		return err
+	}
+
+	other, found, err := venueRepo.GetOverlappingBooking(room.ID, event.StartDateTime, event.EndDateTime, event.ID)
+	if err != nil {
+		return err
+	}
+	if found {
+		return fmt.Errorf("%s is already booked for %s from %s to %s", room.Name, other.Name,
+			other.StartDateTime.Format(time.RFC3339), other.EndDateTime.Format(time.RFC3339))
+	}
+
+	if event.Location == "" {
+		event.Location = roomLocation(room)
+	}
+	return nil
+}
+
+func bookingChanged(previous models.Event, event models.Event) bool {
+	if previous.RoomID == nil || *previous.RoomID != *event.RoomID {
+		return true
+	}
+	return !previous.StartDateTime.Equal(event.StartDateTime) || !previous.EndDateTime.Equal(event.EndDateTime)
+}
+
+func roomLocation(room models.Room) string {
+	if room.Venue == nil {
+		return room.Name
+	}
+	return room.Name + ", " + room.Venue.Name
+}
+
+func prepareRoom(room *models.Room) error {
+	room.Name = strings.TrimSpace(room.Name)
+	if room.Name == "" {
+		return fmt.Errorf("room name is required")
+	}
+	if room.Capacity < 0 {
+		return fmt.Errorf("room capacity can't be negative")
+	}
+
+	var equipment []string
+	for _, item := range strings.Split(room.Equipment, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			equipment = append(equipment, item)
+		}
+	}
+	room.Equipment = strings.Join(equipment, ",")
+	return nil
+}
+
+// freeSlots returns the gaps between the bookings, which are ordered by
+// start time, within the range.
+func freeSlots(bookings []models.Event, from time.Time, to time.Time) []models.TimeSlot {
+	var slots []models.TimeSlot
+	cursor := from
+	for _, booking := range bookings {
+		if booking.StartDateTime.After(cursor) {
+			slots = append(slots, models.TimeSlot{Start: cursor, End: booking.StartDateTime})
+		}
+		if booking.EndDateTime.After(cursor) {
+			cursor = booking.EndDateTime
+		}
+	}
+	if to.After(cursor) {
+		slots = append(slots, models.TimeSlot{Start: cursor, End: to})
+	}
+	return slots
+}
@@ -0,0 +1,279 @@
+package service
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/catness812/faf-hub-backend/event_service/internal/models"
+	"github.com/catness812/faf-hub-backend/event_service/internal/util"
+	"github.com/gookit/slog"
+)
+
+type IVolunteerRepository interface {
+	SaveRole(role *models.VolunteerRole) (uint, error)
+	GetRoleByID(roleID uint) (models.VolunteerRole, error)
+	GetEventRoles(eventID uint) ([]models.VolunteerRole, error)
+	DeleteRole(roleID uint) error
+	SaveShift(shift *models.VolunteerShift) (uint, error)
+	UpdateShift(shift models.VolunteerShift, rescheduled bool) error
+	GetShiftByID(shiftID uint) (models.VolunteerShift, error)
+	DeleteShift(shiftID uint) error
+	GetEventShifts(eventID uint) ([]models.VolunteerShift, error)
+	SaveSignup(signup models.VolunteerSignup) error
+	DeleteSignup(shiftID uint, userID uint) error
+	GetSignupsStartingBetween(from time.Time, to time.Time) ([]models.VolunteerSignup, error)
+	ClaimShiftReminder(signupID uint) (bool, error)
+	ReleaseShiftReminder(signupID uint) error
+}
+
+type VolunteerService struct {
+	volunteerRepository IVolunteerRepository
+	eventRepository     IEventRepository
+}
+
+func NewVolunteerService(
+	volunteerRepo IVolunteerRepository,
+	eventRepo IEventRepository,
+) *VolunteerService {
+	return &VolunteerService{
+		volunteerRepository: volunteerRepo,
+		eventRepository:     eventRepo,
+	}
+}
+
+func (svc *VolunteerService) CreateRole(role models.VolunteerRole) (uint, error) {
+	if _, err := svc.eventRepository.GetEventByID(role.EventID); err != nil {
+		slog.Errorf("Could not retrieve event: %v", err)
+		return 0, err
+	}
+
+	role.Name = strings.TrimSpace(role.Name)
+	if role.Name == "" {
+		slog.Error("Could not create volunteer role: missing name")
+		return 0, fmt.Errorf("volunteer role name is required")
+	}
+
+	roleID, err := svc.volunteerRepository.SaveRole(&role)
+	if err != nil {
+		slog.Errorf("Could not create volunteer role: %v", err)
+		return 0, err
+	}
+
+	slog.Infof("Volunteer role successfully created: %s", role.Name)
+	return roleID, nil
+}
+
+// DeleteRole removes the role of the event with all of its shifts.
+func (svc *VolunteerService) DeleteRole(eventID uint, roleID uint) error {
+	role, err := svc.volunteerRepository.GetRoleByID(roleID)
+	if err != nil {
+		slog.Errorf("Could not retrieve volunteer role: %v", err)
+		return err
+	}
+	if role.EventID != eventID {
+		slog.Error("Could not delete volunteer role: role of another event")
+		return fmt.Errorf("volunteer role doesn't belong to this event")
+	}
+
+	if err := svc.volunteerRepository.DeleteRole(roleID); err != nil {
+		slog.Errorf("Could not delete volunteer role: %v", err)
+		return err
+	}
+
+	slog.Info("Volunteer role successfully deleted")
+	return nil
+}
+
+func (svc *VolunteerService) CreateShift(shift models.VolunteerShift) (uint, error) {
+	role, err := svc.volunteerRepository.GetRoleByID(shift.RoleID)
+	if err != nil {
+		slog.Errorf("Could not retrieve volunteer role: %v", err)
+		return 0, err
+	}
+	if role.EventID != shift.EventID {
+		slog.Error("Could not create volunteer shift: role of another event")
+		return 0, fmt.Errorf("volunteer role doesn't belong to this event")
+	}
+
+	if shift.Slots == 0 {
+		shift.Slots = 1
+	}
+	if err := validateShift(shift); err != nil {
+		slog.Errorf("Could not create volunteer shift: %v", err)
+		return 0, err
+	}
+
+	shiftID, err := svc.volunteerRepository.SaveShift(&shift)
+	if err != nil {
+		slog.Errorf("Could not create volunteer shift: %v", err)
+		return 0, err
+	}
+
+	slog.Infof("Volunteer shift successfully created for %s", role.Name)
+	return shiftID, nil
+}
+
+func (svc *VolunteerService) UpdateShift(shift models.VolunteerShift) error {
+	newShift, err := svc.volunteerRepository.GetShiftByID(shift.ID)
+	if err != nil {
+		slog.Errorf("Could not retrieve volunteer shift: %v", err)
+		return err
+	}
+	if newShift.EventID != shift.EventID {
+		slog.Error("Could not update volunteer shift: shift of another event")
+		return fmt.Errorf("volunteer shift doesn't belong to this event")
+	}
+
+	if shift.Description != "" {
+		newShift.Description = shift.Description
+	}
+	rescheduled := false
+	if !shift.StartTime.IsZero() {
+		rescheduled = !shift.StartTime.Equal(newShift.StartTime)
+		newShift.StartTime = shift.StartTime
+	}
+	if !shift.EndTime.IsZero() {
+		newShift.EndTime = shift.EndTime
+	}
+	if shift.Slots != 0 {
+		newShift.Slots = shift.Slots
+	}
+
+	if err := validateShift(newShift); err != nil {
+		slog.Errorf("Could not update volunteer shift: %v", err)
+		return err
+	}
+	if newShift.Slots < len(newShift.Signups) {
+		slog.Error("Could not update volunteer shift: fewer slots than signups")
+		return fmt.Errorf("%d volunteers already signed up for this shift", len(newShift.Signups))
+	}
+
+	if err := svc.volunteerRepository.UpdateShift(newShift, rescheduled); err != nil {
+		slog.Errorf("Could not update volunteer shift: %v", err)
+		return err
+	}
+
+	slog.Info("Volunteer shift successfully updated")
+	return nil
+}
+
+func (svc *VolunteerService) DeleteShift(eventID uint, shiftID uint) error {
+	shift, err := svc.volunteerRepository.GetShiftByID(shiftID)
+	if err != nil {
+		slog.Errorf("Could not retrieve volunteer shift: %v", err)
+		return err
+	}
+	if shift.EventID != eventID {
+		slog.Error("Could not delete volunteer shift: shift of another event")
+		return fmt.Errorf("volunteer shift doesn't belong to this event")
+	}
+
+	if err := svc.volunteerRepository.DeleteShift(shiftID); err != nil {
+		slog.Errorf("Could not delete volunteer shift: %v", err)
+		return err
+	}
+
+	slog.Info("Volunteer shift successfully deleted")
+	return nil
+}
+
+// GetEventShifts returns the volunteer roles of the event and its shifts with
+// the volunteers signed up for them.
+func (svc *VolunteerService) GetEventShifts(eventID uint) ([]models.VolunteerRole, []models.VolunteerShift, error) {
+	if _, err := svc.eventRepository.GetEventByID(eventID); err != nil {
+		slog.Errorf("Could not retrieve event: %v", err)
+		return nil, nil, err
+	}
+
+	roles, err := svc.volunteerRepository.GetEventRoles(eventID)
+	if err != nil {
+		slog.Errorf("Could not retrieve volunteer roles: %v", err)
+		return nil, nil, err
+	}
+
+	shifts, err := svc.volunteerRepository.GetEventShifts(eventID)
+	if err != nil {
+		slog.Errorf("Could not retrieve volunteer shifts: %v", err)
+		return nil, nil, err
+	}
+
+	slog.Info("Volunteer shifts successfully retrieved")
+	return roles, shifts, nil
+}
+
+// SignUpForShift adds the user to an upcoming shift with free slots, unless
+// they already volunteer somewhere else at the same time.
+func (svc *VolunteerService) SignUpForShift(signup models.VolunteerSignup) error {
+	shift, err := svc.volunteerRepository.GetShiftByID(signup.ShiftID)
+	if err != nil {
+		slog.Errorf("Could not retrieve volunteer shift: %v", err)
+		return err
+	}
+
+	event, err := svc.eventRepository.GetEventByID(shift.EventID)
+	if err != nil {
+		slog.Errorf("Could not retrieve event: %v", err)
+		return err
+	}
+	if event.CancelledAt != nil {
+		slog.Error("Could not sign up for volunteer shift: event is cancelled")
+		return fmt.Errorf("event is cancelled")
+	}
+	if !shift.StartTime.After(time.Now()) {
+		slog.Error("Could not sign up for volunteer shift: shift already started")
+		return fmt.Errorf("volunteer shift already started")
+	}
+
+	signup.Name = strings.TrimSpace(signup.Name)
+	signup.Email = strings.TrimSpace(signup.Email)
+	if signup.Name == "" || signup.Email == "" {
+		slog.Error("Could not sign up for volunteer shift: missing contact details")
+		return fmt.Errorf("name and email are required")
+	}
+
+	if err := svc.volunteerRepository.SaveSignup(signup); err != nil {
+		slog.Errorf("Could not sign up for volunteer shift: %v", err)
+		return err
+	}
+
+	slog.Infof("User %d successfully signed up for volunteer shift %d", signup.UserID, signup.ShiftID)
+	return nil
+}
+
+func (svc *VolunteerService) CancelSignup(shiftID uint, userID uint) error {
+	if err := svc.volunteerRepository.DeleteSignup(shiftID, userID); err != nil {
+		slog.Errorf("Could not cancel volunteer signup: %v", err)
+		return err
+	}
+
+	slog.Info("Volunteer signup successfully cancelled")
+	return nil
+}
+
+func validateShift(shift models.VolunteerShift) error {
+	if !shift.EndTime.After(shift.StartTime) {
+		return fmt.Errorf("shift end must be after its start")
+	}
+	if shift.Slots < 1 {
+		return fmt.Errorf("shift must have at least 1 slot")
+	}
+	return nil
+}
+
+func roleName(shift models.VolunteerShift) string {
+	if shift.Role == nil {
+		return "a volunteer"
+	}
+	return shift.Role.Name
+}
+
+func shiftReminder(signup models.VolunteerSignup, event models.Event) (string, string) {
+	shift := signup.Shift
+	return "Reminder: your volunteer shift at " + event.Name,
+		strings.TrimSpace(fmt.Sprintf("Hi %s, thank you for volunteering as %s at %s! Your shift starts on %s and ends at %s. %s",
+			signup.Name, roleName(*shift), event.Name,
+			shift.StartTime.In(util.EventLocation(event)).Format("Monday, 2 January 15:04 MST"),
+			shift.EndTime.In(util.EventLocation(event)).Format("15:04"),
+			shift.Description))
+}
@@ -0,0 +1,83 @@
+package util
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/catness812/faf-hub-backend/event_service/internal/models"
+	"github.com/jung-kurt/gofpdf"
+)
+
+// the core PDF fonts only cover cp1252, which lacks some Romanian letters
+var diacritics = strings.NewReplacer("ș", "s", "ş", "s", "Ș", "S", "Ş", "S", "ț", "t", "ţ", "t", "Ț", "T", "Ţ", "T", "ă", "a", "Ă", "A")
+
+func GenerateCertificateCode() (string, error) {
+	b := make([]byte, 6)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return strings.ToUpper(hex.EncodeToString(b)), nil
+}
+
+func GenerateCertificatePDF(certificate models.Certificate, event models.Event) ([]byte, error) {
+	pdf := gofpdf.New("L", "mm", "A4", "")
+	tr := pdf.UnicodeTranslatorFromDescriptor("")
+	text := func(s string) string {
+		return tr(diacritics.Replace(s))
+	}
+
+	pdf.SetTitle("Certificate of Participation", true)
+	pdf.AddPage()
+	pdf.SetLineWidth(1.5)
+	pdf.Rect(10, 10, 277, 190, "D")
+
+	pdf.SetY(40)
+	pdf.SetFont("Helvetica", "B", 32)
+	pdf.CellFormat(0, 16, text("Certificate of Participation"), "", 1, "C", false, 0, "")
+
+	pdf.Ln(8)
+	pdf.SetFont("Helvetica", "", 16)
+	pdf.CellFormat(0, 10, text("This certifies that"), "", 1, "C", false, 0, "")
+
+	// guests may register without a name
+	name := strings.TrimSpace(certificate.FirstName + " " + certificate.LastName)
+	if name == "" {
+		name = certificate.Email
+	}
+	pdf.SetFont("Helvetica", "B", 26)
+	pdf.CellFormat(0, 16, text(name), "", 1, "C", false, 0, "")
+
+	pdf.SetFont("Helvetica", "", 16)
+	pdf.CellFormat(0, 10, text("has participated in"), "", 1, "C", false, 0, "")
+
+	pdf.SetFont("Helvetica", "B", 22)
+	pdf.CellFormat(0, 14, text(event.Name), "", 1, "C", false, 0, "")
+
+	pdf.SetFont("Helvetica", "", 14)
+	pdf.CellFormat(0, 10, text(formatEventDates(event)), "", 1, "C", false, 0, "")
+
+	pdf.SetY(170)
+	pdf.SetFont("Helvetica", "", 11)
+	pdf.CellFormat(0, 6, text("Verification code: "+certificate.Code), "", 1, "C", false, 0, "")
+	pdf.CellFormat(0, 6, text(fmt.Sprintf("Verify at %s/certificates/%s/verify", os.Getenv("APP_URL"), certificate.Code)), "", 1, "C", false, 0, "")
+
+	var buf bytes.Buffer
+	if err := pdf.Output(&buf); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func formatEventDates(event models.Event) string {
+	loc := EventLocation(event)
+	start := event.StartDateTime.In(loc).Format("2 January 2006")
+	end := event.EndDateTime.In(loc).Format("2 January 2006")
+	if start == end {
+		return start
+	}
+	return start + " - " + end
+}
@@ -0,0 +1,32 @@
+package util
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+)
+
+type cursor struct {
+	Value string `json:"v"`
+	ID    uint   `json:"id"`
+}
+
+// EncodeCursor returns an opaque token pointing right after the row with the
+// given sort value and id.
+func EncodeCursor(value string, id uint) string {
+	data, _ := json.Marshal(cursor{Value: value, ID: id})
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func DecodeCursor(token string) (string, uint, error) {
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return "", 0, fmt.Errorf("invalid cursor")
+	}
+
+	var c cursor
+	if err := json.Unmarshal(data, &c); err != nil || c.ID == 0 {
+		return "", 0, fmt.Errorf("invalid cursor")
+	}
+	return c.Value, c.ID, nil
+}
@@ -0,0 +1,158 @@
+package util
+
+import (
+	"bytes"
+	"encoding/csv"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/catness812/faf-hub-backend/event_service/internal/models"
+	"github.com/xuri/excelize/v2"
+)
+
+var registrationColumns = []string{
+	"ID", "First name", "Last name", "Email", "Phone number", "Academic group", "Team members",
+	"Shirt size", "Food preferences", "Status", "Checked in at", "Registered at",
+}
+
+var nonSlugChars = strings.NewReplacer(" ", "-", "/", "-", "\\", "-", "\"", "", "'", "")
+
+func RegistrationsCSV(registrations []models.Registration) ([]byte, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+
+	if err := w.Write(registrationColumns); err != nil {
+		return nil, err
+	}
+	for _, reg := range registrations {
+		if err := w.Write(registrationRow(reg)); err != nil {
+			return nil, err
+		}
+	}
+
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// RegistrationsXLSX writes the registrations on the first sheet and the
+// shirt size and food preference totals on a second one, for catering and
+// merch orders.
+func RegistrationsXLSX(registrations []models.Registration) ([]byte, error) {
+	f := excelize.NewFile()
+	defer f.Close()
+
+	const sheet = "Registrations"
+	if err := f.SetSheetName("Sheet1", sheet); err != nil {
+		return nil, err
+	}
+
+	rows := make([][]string, 0, len(registrations)+1)
+	rows = append(rows, registrationColumns)
+	for _, reg := range registrations {
+		rows = append(rows, registrationRow(reg))
+	}
+	if err := writeRows(f, sheet, 1, rows); err != nil {
+		return nil, err
+	}
+
+	shirts := make(map[string]int)
+	food := make(map[string]int)
+	for _, reg := range registrations {
+		if reg.Status != models.RegistrationConfirmed {
+			continue
+		}
+		shirts[strings.ToUpper(strings.TrimSpace(reg.ShirtSize))]++
+		food[strings.ToLower(strings.TrimSpace(reg.FoodPreferences))]++
+	}
+
+	const summary = "Summary"
+	if _, err := f.NewSheet(summary); err != nil {
+		return nil, err
+	}
+	summaryRows := append([][]string{{"Shirt size", "Count"}}, countRows(shirts)...)
+	summaryRows = append(summaryRows, []string{}, []string{"Food preferences", "Count"})
+	summaryRows = append(summaryRows, countRows(food)...)
+	if err := writeRows(f, summary, 1, summaryRows); err != nil {
+		return nil, err
+	}
+
+	buf, err := f.WriteToBuffer()
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func Slugify(s string) string {
+	return strings.ToLower(nonSlugChars.Replace(strings.TrimSpace(s)))
+}
+
+func registrationRow(reg models.Registration) []string {
+	checkedIn := ""
+	if reg.CheckedInAt != nil {
+		checkedIn = reg.CheckedInAt.Format(time.DateTime)
+	}
+
+	row := []string{
+		strconv.FormatUint(uint64(reg.ID), 10),
+		reg.FirstName,
+		reg.LastName,
+		reg.Email,
+		strconv.Itoa(reg.PhoneNumber),
+		reg.AcademicGroup,
+		reg.TeamMembers,
+		reg.ShirtSize,
+		reg.FoodPreferences,
+		reg.Status,
+		checkedIn,
+		reg.CreatedAt.Format(time.DateTime),
+	}
+	for i := range row {
+		row[i] = escapeFormula(row[i])
+	}
+	return row
+}
+
+// escapeFormula keeps spreadsheet apps from evaluating user input as a formula.
+func escapeFormula(s string) string {
+	if s != "" && strings.ContainsRune("=+-@", rune(s[0])) {
+		return "'" + s
+	}
+	return s
+}
+
+func countRows(counts map[string]int) [][]string {
+	keys := make([]string, 0, len(counts))
+	for k := range counts {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	rows := make([][]string, len(keys))
+	for i, k := range keys {
+		rows[i] = []string{k, strconv.Itoa(counts[k])}
+	}
+	return rows
+}
+
+func writeRows(f *excelize.File, sheet string, start int, rows [][]string) error {
+	for i, row := range rows {
+		cell, err := excelize.CoordinatesToCellName(1, start+i)
+		if err != nil {
+			return err
+		}
+		values := make([]interface{}, len(row))
+		for j := range row {
+			values[j] = row[j]
+		}
+		if err := f.SetSheetRow(sheet, cell, &values); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,52 @@
+package util
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/teambition/rrule-go"
+)
+
+// MaxOccurrences caps how many events a single recurrence rule can create.
+const MaxOccurrences = 100
+
+// ExpandRecurrence returns the start times of every occurrence of an RRULE.
+// Only the DAILY, WEEKLY and MONTHLY frequencies are supported and the rule
+// must end with either COUNT or UNTIL. The occurrences are computed in the
+// event's timezone, so they keep their local time across DST changes.
+func ExpandRecurrence(rule string, start time.Time, loc *time.Location) ([]time.Time, error) {
+	rule = strings.TrimPrefix(strings.TrimSpace(rule), "RRULE:")
+	option, err := rrule.StrToROptionInLocation(rule, loc)
+	if err != nil {
+		return nil, fmt.Errorf("invalid recurrence rule: %v", err)
+	}
+
+	switch option.Freq {
+	case rrule.DAILY, rrule.WEEKLY, rrule.MONTHLY:
+	default:
+		return nil, fmt.Errorf("only DAILY, WEEKLY and MONTHLY recurrence rules are supported")
+	}
+	if option.Count == 0 && option.Until.IsZero() {
+		return nil, fmt.Errorf("recurrence rule needs either COUNT or UNTIL")
+	}
+
+	option.Dtstart = start.In(loc)
+	r, err := rrule.NewRRule(*option)
+	if err != nil {
+		return nil, fmt.Errorf("invalid recurrence rule: %v", err)
+	}
+
+	var occurrences []time.Time
+	next := r.Iterator()
+	for t, ok := next(); ok; t, ok = next() {
+		if len(occurrences) == MaxOccurrences {
+			return nil, fmt.Errorf("recurrence rule can't have more than %d occurrences", MaxOccurrences)
+		}
+		occurrences = append(occurrences, t)
+	}
+	if len(occurrences) == 0 {
+		return nil, fmt.Errorf("recurrence rule has no occurrences")
+	}
+	return occurrences, nil
+}
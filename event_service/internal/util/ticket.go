@@ -0,0 +1,70 @@
+package util
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/gookit/slog"
+)
+
+// MinSecretLength is the shortest signing secret accepted at startup.
+const MinSecretLength = 32
+
+var ticketSecret []byte
+
+// LoadTicketSecret reads TICKET_SECRET once at startup. Without it anyone
+// could sign a valid ticket, so the service refuses to start.
+func LoadTicketSecret() {
+	secret := os.Getenv("TICKET_SECRET")
+	if len(secret) < MinSecretLength {
+		slog.Fatalf("TICKET_SECRET must be set to at least %d characters", MinSecretLength)
+	}
+	ticketSecret = []byte(secret)
+}
+
+// GenerateTicket signs the registration with TICKET_SECRET so the ticket can
+// be validated at check-in without storing it.
+func GenerateTicket(registrationID uint, eventID uint, userID uint) string {
+	payload := fmt.Sprintf("%d:%d:%d", registrationID, eventID, userID)
+	encoded := base64.RawURLEncoding.EncodeToString([]byte(payload))
+	return encoded + "." + sign(encoded)
+}
+
+func ParseTicket(ticket string) (registrationID uint, eventID uint, userID uint, err error) {
+	encoded, signature, found := strings.Cut(ticket, ".")
+	if !found || !hmac.Equal([]byte(signature), []byte(sign(encoded))) {
+		return 0, 0, 0, fmt.Errorf("invalid ticket")
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return 0, 0, 0, fmt.Errorf("invalid ticket")
+	}
+
+	if _, err := fmt.Sscanf(string(payload), "%d:%d:%d", &registrationID, &eventID, &userID); err != nil {
+		return 0, 0, 0, fmt.Errorf("invalid ticket")
+	}
+
+	return registrationID, eventID, userID, nil
+}
+
+// GenerateGuestToken returns the random token of the link that confirms a
+// guest registration.
+func GenerateGuestToken() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+func sign(data string) string {
+	mac := hmac.New(sha256.New, ticketSecret)
+	mac.Write([]byte(data))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
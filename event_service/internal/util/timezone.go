@@ -0,0 +1,18 @@
+package util
+
+import (
+	"time"
+
+	"github.com/catness812/faf-hub-backend/event_service/internal/models"
+)
+
+// EventLocation returns the timezone the event times should be shown in.
+func EventLocation(event models.Event) *time.Location {
+	if loc, err := time.LoadLocation(event.Timezone); err == nil && event.Timezone != "" {
+		return loc
+	}
+	if loc, err := time.LoadLocation(models.DefaultTimezone); err == nil {
+		return loc
+	}
+	return time.UTC
+}
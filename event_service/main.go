@@ -5,12 +5,18 @@ import (
 	"net"
 	"net/http"
 	"os"
+	"strings"
+	"time"
+	_ "time/tzdata"
 
 	"github.com/catness812/faf-hub-backend/event_service/internal/controller/rpc"
+	"github.com/catness812/faf-hub-backend/event_service/internal/notification"
 	"github.com/catness812/faf-hub-backend/event_service/internal/pb"
 	"github.com/catness812/faf-hub-backend/event_service/internal/repository"
 	"github.com/catness812/faf-hub-backend/event_service/internal/service"
+	"github.com/catness812/faf-hub-backend/event_service/internal/util"
 	"github.com/catness812/faf-hub-backend/event_service/pkg/database/postgres"
+	"github.com/catness812/faf-hub-backend/event_service/pkg/database/redis"
 	"github.com/gookit/slog"
 	grpcprom "github.com/grpc-ecosystem/go-grpc-middleware/providers/prometheus"
 	"github.com/joho/godotenv"
@@ -27,16 +33,76 @@ func init() {
 }
 
 func main() {
+	util.LoadTicketSecret()
 	db := postgres.LoadDatabase()
+	redisClient := redis.Connect()
+	notificationClient := notification.InitNotificationServiceClient(os.Getenv("NOTIFICATION_SVC_PORT"))
 	eventRepo := repository.NewEventRepository(db)
 	registrationRepo := repository.NewRegistrationRepository(db)
-	eventSvc := service.NewEventService(eventRepo)
+	teamRepo := repository.NewTeamRepository(db)
+	certificateRepo := repository.NewCertificateRepository(db)
+	reportRepo := repository.NewReportRepository(db)
+	feedbackRepo := repository.NewFeedbackRepository(db)
+	reminderRepo := repository.NewReminderRepository(db)
+	seriesRepo := repository.NewSeriesRepository(db)
+	agendaRepo := repository.NewAgendaRepository(db)
+	organizerRepo := repository.NewOrganizerRepository(db)
+	templateRepo := repository.NewTemplateRepository(db)
+	venueRepo := repository.NewVenueRepository(db)
+	volunteerRepo := repository.NewVolunteerRepository(db)
+	judgingRepo := repository.NewJudgingRepository(db)
+	analyticsRepo := repository.NewAnalyticsRepository(db)
+	newsletterRepo := repository.NewNewsletterRepository(redisClient)
+	eventSvc := service.NewEventService(eventRepo, registrationRepo, venueRepo, notificationClient)
 	registrationSvc := service.NewRegistrationService(registrationRepo, eventRepo)
+	teamSvc := service.NewTeamService(teamRepo, registrationRepo, eventRepo)
+	certificateSvc := service.NewCertificateService(certificateRepo, registrationRepo, eventRepo)
+	reportSvc := service.NewReportService(reportRepo, eventRepo)
+	feedbackSvc := service.NewFeedbackService(feedbackRepo, registrationRepo, eventRepo)
+	seriesSvc := service.NewSeriesService(seriesRepo, registrationSvc, eventSvc)
+	agendaSvc := service.NewAgendaService(agendaRepo, registrationRepo, eventRepo)
+	recommendationSvc := service.NewRecommendationService(eventRepo, registrationRepo)
+	organizerSvc := service.NewOrganizerService(organizerRepo, eventRepo)
+	templateSvc := service.NewTemplateService(templateRepo, eventRepo, agendaRepo, organizerRepo, venueRepo)
+	venueSvc := service.NewVenueService(venueRepo)
+	volunteerSvc := service.NewVolunteerService(volunteerRepo, eventRepo)
+	judgingSvc := service.NewJudgingService(judgingRepo, teamRepo, eventRepo)
+	analyticsSvc := service.NewAnalyticsService(analyticsRepo, eventRepo, feedbackRepo)
+	reminderSvc := service.NewReminderService(
+		reminderRepo,
+		registrationRepo,
+		volunteerRepo,
+		eventRepo,
+		newsletterRepo,
+		analyticsRepo,
+		notificationClient,
+		durationsFromEnv("REMINDER_OFFSETS", []time.Duration{24 * time.Hour, time.Hour}),
+		durationsFromEnv("DEADLINE_REMINDER_OFFSETS", []time.Duration{24 * time.Hour}),
+		durationsFromEnv("SHIFT_REMINDER_OFFSET", []time.Duration{24 * time.Hour})[0],
+	)
+
+	go reminderSvc.Start(durationsFromEnv("REMINDER_INTERVAL", []time.Duration{time.Minute})[0])
 
-	grpcStart(eventSvc, registrationSvc)
+	grpcStart(eventSvc, registrationSvc, teamSvc, certificateSvc, reportSvc, feedbackSvc, seriesSvc, agendaSvc, recommendationSvc, organizerSvc, templateSvc, venueSvc, volunteerSvc, judgingSvc, analyticsSvc)
 }
 
-func grpcStart(eventSvc rpc.IEventService, registrationSvc rpc.IRegistrationService) {
+func grpcStart(
+	eventSvc rpc.IEventService,
+	registrationSvc rpc.IRegistrationService,
+	teamSvc rpc.ITeamService,
+	certificateSvc rpc.ICertificateService,
+	reportSvc rpc.IReportService,
+	feedbackSvc rpc.IFeedbackService,
+	seriesSvc rpc.ISeriesService,
+	agendaSvc rpc.IAgendaService,
+	recommendationSvc rpc.IRecommendationService,
+	organizerSvc rpc.IOrganizerService,
+	templateSvc rpc.ITemplateService,
+	venueSvc rpc.IVenueService,
+	volunteerSvc rpc.IVolunteerService,
+	judgingSvc rpc.IJudgingService,
+	analyticsSvc rpc.IAnalyticsService,
+) {
 	lis, err := net.Listen("tcp", fmt.Sprintf(":%v", os.Getenv("EVENT_SVC_PORT")))
 	if err != nil {
 		slog.Error(err)
@@ -49,8 +115,21 @@ func grpcStart(eventSvc rpc.IEventService, registrationSvc rpc.IRegistrationServ
 		grpc.UnaryInterceptor(srvMetrics.UnaryServerInterceptor()),
 	)
 	server := &rpc.Server{
-		EventService:        eventSvc,
-		RegistrationService: registrationSvc,
+		EventService:          eventSvc,
+		RegistrationService:   registrationSvc,
+		TeamService:           teamSvc,
+		CertificateService:    certificateSvc,
+		ReportService:         reportSvc,
+		FeedbackService:       feedbackSvc,
+		SeriesService:         seriesSvc,
+		AgendaService:         agendaSvc,
+		RecommendationService: recommendationSvc,
+		OrganizerService:      organizerSvc,
+		TemplateService:       templateSvc,
+		VenueService:          venueSvc,
+		VolunteerService:      volunteerSvc,
+		JudgingService:        judgingSvc,
+		AnalyticsService:      analyticsSvc,
 	}
 
 	pb.RegisterEventServiceServer(s, server)
@@ -76,3 +155,23 @@ func srvMetrics() *grpcprom.ServerMetrics {
 
 	return srvMetrics
 }
+
+// durationsFromEnv parses a comma separated list of durations, such as
+// "24h,1h", falling back to the defaults when the variable is unset or invalid.
+func durationsFromEnv(key string, fallback []time.Duration) []time.Duration {
+	value := os.Getenv(key)
+	if value == "" {
+		return fallback
+	}
+
+	var durations []time.Duration
+	for _, part := range strings.Split(value, ",") {
+		d, err := time.ParseDuration(strings.TrimSpace(part))
+		if err != nil || d <= 0 {
+			slog.Errorf("Invalid duration %q in %s, using the defaults", part, key)
+			return fallback
+		}
+		durations = append(durations, d)
+	}
+	return durations
+}
@@ -14,13 +14,127 @@ import (
 
 func LoadDatabase() *gorm.DB {
 	db := connect()
-	err := db.AutoMigrate(&models.Event{}, &models.Registration{})
+	if err := migrateEventTimes(db); err != nil {
+		slog.Error(err)
+	}
+	err := db.AutoMigrate(&models.EventSeries{}, &models.Venue{}, &models.Room{}, &models.Event{}, &models.Registration{}, &models.Team{}, &models.TeamMember{}, &models.MatchingProfile{}, &models.Certificate{}, &models.Feedback{}, &models.ReminderLog{}, &models.Speaker{}, &models.Session{}, &models.SessionBookmark{}, &models.EventOrganizer{}, &models.EventTemplate{}, &models.VolunteerRole{}, &models.VolunteerShift{}, &models.VolunteerSignup{}, &models.Submission{}, &models.Judge{}, &models.Criterion{}, &models.Score{}, &models.NewsletterSend{}, &models.NewsletterRecipient{})
 	if err != nil {
 		slog.Error(err)
 	}
+	if err := migrateEventSearch(db); err != nil {
+		slog.Error(err)
+	}
+	if err := migrateRoomBookings(db); err != nil {
+		slog.Error(err)
+	}
+	if err := migrateNewsletterRecipients(db); err != nil {
+		slog.Error(err)
+	}
 	return db
 }
 
+// migrateEventSearch adds a generated tsvector column with a GIN index for
+// every search language. The name weighs more than the description, which
+// weighs more than the location. The columns are kept out of the model, so
+// AutoMigrate leaves them alone.
+func migrateEventSearch(db *gorm.DB) error {
+	for lang, config := range models.SearchConfigs {
+		vector := fmt.Sprintf("setweight(to_tsvector('%[1]s', coalesce(name, '')), 'A') || "+
+			"setweight(to_tsvector('%[1]s', coalesce(description, '')), 'B') || "+
+			"setweight(to_tsvector('%[1]s', coalesce(location, '')), 'C')", config)
+		statements := []string{
+			fmt.Sprintf("ALTER TABLE events ADD COLUMN IF NOT EXISTS search_%s tsvector GENERATED ALWAYS AS (%s) STORED", lang, vector),
+			fmt.Sprintf("CREATE INDEX IF NOT EXISTS idx_events_search_%[1]s ON events USING GIN (search_%[1]s)", lang),
+		}
+		for _, statement := range statements {
+			if err := db.Exec(statement).Error; err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// migrateRoomBookings adds an exclusion constraint on the room and the time
+// range of the events, so two concurrent bookings of the same room can't
+// both be saved.
+func migrateRoomBookings(db *gorm.DB) error {
+	var count int64
+	err := db.Raw("SELECT COUNT(*) FROM pg_constraint WHERE conname = ?", models.RoomBookingConstraint).Scan(&count).Error
+	if err != nil || count > 0 {
+		return err
+	}
+
+	statements := []string{
+		"CREATE EXTENSION IF NOT EXISTS btree_gist",
+		fmt.Sprintf("ALTER TABLE events ADD CONSTRAINT %s EXCLUDE USING gist "+
+			"(room_id WITH =, tstzrange(start_date_time, end_date_time) WITH &&) "+
+			"WHERE (room_id IS NOT NULL AND cancelled_at IS NULL AND deleted_at IS NULL)", models.RoomBookingConstraint),
+	}
+	for _, statement := range statements {
+		if err := db.Exec(statement).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// migrateNewsletterRecipients moves the recipients that newsletter sends used
+// to keep as a comma separated column into their own table.
+func migrateNewsletterRecipients(db *gorm.DB) error {
+	if !db.Migrator().HasColumn("newsletter_sends", "emails") {
+		return nil
+	}
+
+	return db.Transaction(func(tx *gorm.DB) error {
+		statements := []string{
+			"INSERT INTO newsletter_recipients (send_id, email) " +
+				"SELECT DISTINCT id, unnest(string_to_array(emails, ',')) FROM newsletter_sends WHERE emails <> '' " +
+				"ON CONFLICT DO NOTHING",
+			"ALTER TABLE newsletter_sends DROP COLUMN emails",
+		}
+		for _, statement := range statements {
+			if err := tx.Exec(statement).Error; err != nil {
+				return err
+			}
+		}
+		slog.Info("Successfully migrated newsletter recipients")
+		return nil
+	})
+}
+
+// migrateEventTimes converts the event times to timestamptz before AutoMigrate
+// gets to them, since a plain type change would interpret the stored values
+// in the database server's timezone. Start and end times were always saved
+// in UTC, while deadlines were dates, which become the end of that day in the
+// default event timezone.
+func migrateEventTimes(db *gorm.DB) error {
+	if !db.Migrator().HasTable(&models.Event{}) {
+		return nil
+	}
+
+	var dataType string
+	err := db.Raw("SELECT data_type FROM information_schema.columns WHERE table_name = 'events' AND column_name = 'application_deadline'").Scan(&dataType).Error
+	if err != nil || dataType != "date" {
+		return err
+	}
+
+	return db.Transaction(func(tx *gorm.DB) error {
+		statements := []string{
+			"ALTER TABLE events ALTER COLUMN start_date_time TYPE timestamptz USING start_date_time AT TIME ZONE 'UTC'",
+			"ALTER TABLE events ALTER COLUMN end_date_time TYPE timestamptz USING end_date_time AT TIME ZONE 'UTC'",
+			fmt.Sprintf("ALTER TABLE events ALTER COLUMN application_deadline TYPE timestamptz USING (application_deadline + interval '1 day' - interval '1 second') AT TIME ZONE '%s'", models.DefaultTimezone),
+		}
+		for _, statement := range statements {
+			if err := tx.Exec(statement).Error; err != nil {
+				return err
+			}
+		}
+		slog.Info("Successfully migrated event times to timestamptz")
+		return nil
+	})
+}
+
 func connect() *gorm.DB {
 	var err error
 
@@ -9,18 +9,21 @@ import (
 	"time"
 
 	"github.com/catness812/faf-hub-backend/gateway/internal/content/pb"
+	"github.com/catness812/faf-hub-backend/gateway/internal/media"
 	"github.com/catness812/faf-hub-backend/gateway/models"
 	"github.com/gofiber/fiber/v2"
 	"github.com/gookit/slog"
 )
 
 type ContentController struct {
-	client pb.ContentServiceClient
+	client       pb.ContentServiceClient
+	mediaLibrary media.CoverLibrary
 }
 
-func NewContentController(client pb.ContentServiceClient) *ContentController {
+func NewContentController(client pb.ContentServiceClient, mediaLibrary media.CoverLibrary) *ContentController {
 	return &ContentController{
-		client: client,
+		client:       client,
+		mediaLibrary: mediaLibrary,
 	}
 }
 
@@ -37,6 +40,11 @@ func (ctrl *ContentController) PostContent(ctx *fiber.Ctx) error {
 		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": "invalid content type"})
 	}
 
+	if err := media.ApplyCover(ctrl.mediaLibrary, content.CoverMediaID, &content.Cover); err != nil {
+		slog.Errorf("Error resolving cover media: %v", err.Error())
+		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
 	var images []string
 	if content.Images != "" {
 		imagesStr := strings.ReplaceAll(content.Images, " ", "")
@@ -48,12 +56,13 @@ func (ctrl *ContentController) PostContent(ctx *fiber.Ctx) error {
 
 	res, err := ctrl.client.PostContent(c, &pb.PostContentRequest{
 		Content: &pb.Content{
-			Type:    content.Type,
-			Name:    content.Name,
-			Authors: content.Authors,
-			Cover:   content.Cover,
-			Text:    content.Text,
-			Images:  images,
+			Type:         content.Type,
+			Name:         content.Name,
+			Authors:      content.Authors,
+			Cover:        content.Cover,
+			CoverMediaId: content.CoverMediaID,
+			Text:         content.Text,
+			Images:       images,
 		},
 	})
 
@@ -79,6 +88,11 @@ func (ctrl *ContentController) EditContent(ctx *fiber.Ctx) error {
 		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
 	}
 
+	if err := media.ApplyCover(ctrl.mediaLibrary, content.Content.CoverMediaID, &content.Content.Cover); err != nil {
+		slog.Errorf("Error resolving cover media: %v", err.Error())
+		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
 	var images []string
 	if content.Content.Images != "" {
 		imagesStr := strings.ReplaceAll(content.Content.Images, " ", "")
@@ -89,12 +103,13 @@ func (ctrl *ContentController) EditContent(ctx *fiber.Ctx) error {
 	defer cancel()
 
 	res, err := ctrl.client.EditContent(c, &pb.EditContentRequest{
-		ContentId: int32(content.ContentID),
-		Name:      content.Content.Name,
-		Authors:   content.Content.Authors,
-		Cover:     content.Content.Cover,
-		Text:      content.Content.Text,
-		Images:    images,
+		ContentId:    int32(content.ContentID),
+		Name:         content.Content.Name,
+		Authors:      content.Content.Authors,
+		Cover:        content.Content.Cover,
+		CoverMediaId: content.Content.CoverMediaID,
+		Text:         content.Content.Text,
+		Images:       images,
 	})
 
 	if err != nil {
@@ -157,13 +172,15 @@ func (ctrl *ContentController) GetContent(ctx *fiber.Ctx) error {
 	}
 
 	content := models.Content{
-		Type:    res.Content.Type,
-		Name:    res.Content.Name,
-		Authors: res.Content.Authors,
-		Cover:   res.Content.Cover,
-		Text:    res.Content.Text,
-		Views:   int(res.Content.Views),
-		Images:  strings.Join(images, ", "),
+		Type:         res.Content.Type,
+		Name:         res.Content.Name,
+		Authors:      res.Content.Authors,
+		Cover:        res.Content.Cover,
+		CoverMediaID: res.Content.CoverMediaId,
+		CoverURLs:    media.CoverURLs(res.Content.CoverMediaId),
+		Text:         res.Content.Text,
+		Views:        int(res.Content.Views),
+		Images:       strings.Join(images, ", "),
 	}
 	content.ID = uint(id)
 	content.CreatedAt = res.Content.Date.AsTime()
@@ -195,12 +212,14 @@ func (ctrl *ContentController) GetArticles(ctx *fiber.Ctx) error {
 		}
 
 		articles[i] = models.Content{
-			Name:    res.Content[i].Name,
-			Authors: res.Content[i].Authors,
-			Cover:   res.Content[i].Cover,
-			Text:    res.Content[i].Text,
-			Views:   int(res.Content[i].Views),
-			Images:  strings.Join(images, ", "),
+			Name:         res.Content[i].Name,
+			Authors:      res.Content[i].Authors,
+			Cover:        res.Content[i].Cover,
+			CoverMediaID: res.Content[i].CoverMediaId,
+			CoverURLs:    media.CoverURLs(res.Content[i].CoverMediaId),
+			Text:         res.Content[i].Text,
+			Views:        int(res.Content[i].Views),
+			Images:       strings.Join(images, ", "),
 		}
 		articles[i].ID = uint(res.Content[i].ContentId)
 		articles[i].CreatedAt = res.Content[i].Date.AsTime()
@@ -233,12 +252,14 @@ func (ctrl *ContentController) GetProjects(ctx *fiber.Ctx) error {
 		}
 
 		projects[i] = models.Content{
-			Name:    res.Content[i].Name,
-			Authors: res.Content[i].Authors,
-			Cover:   res.Content[i].Cover,
-			Text:    res.Content[i].Text,
-			Views:   int(res.Content[i].Views),
-			Images:  strings.Join(images, ", "),
+			Name:         res.Content[i].Name,
+			Authors:      res.Content[i].Authors,
+			Cover:        res.Content[i].Cover,
+			CoverMediaID: res.Content[i].CoverMediaId,
+			CoverURLs:    media.CoverURLs(res.Content[i].CoverMediaId),
+			Text:         res.Content[i].Text,
+			Views:        int(res.Content[i].Views),
+			Images:       strings.Join(images, ", "),
 		}
 		projects[i].ID = uint(res.Content[i].ContentId)
 		projects[i].CreatedAt = res.Content[i].Date.AsTime()
@@ -0,0 +1,343 @@
+package event
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/catness812/faf-hub-backend/gateway/internal/event/pb"
+	"github.com/catness812/faf-hub-backend/gateway/internal/util"
+	"github.com/catness812/faf-hub-backend/gateway/models"
+	"github.com/gofiber/fiber/v2"
+	"github.com/gookit/slog"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (ctrl *EventController) CreateSpeaker(ctx *fiber.Ctx) error {
+	var speaker models.Speaker
+
+	if err := ctx.BodyParser(&speaker); err != nil {
+		slog.Errorf("Invalid request format: %v", err)
+		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	c, cancel := context.WithTimeout(context.Background(), time.Duration(5)*time.Second)
+	defer cancel()
+
+	res, err := ctrl.client.CreateSpeaker(c, &pb.CreateSpeakerRequest{
+		Speaker: speakerToPb(speaker),
+	})
+
+	if err != nil {
+		slog.Errorf("Error creating speaker: %v", err.Error())
+		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	slog.Info("Speaker created successfully")
+	return ctx.Status(http.StatusOK).JSON(fiber.Map{"message": res.Message, "speaker_id": res.SpeakerId})
+}
+
+func (ctrl *EventController) EditSpeaker(ctx *fiber.Ctx) error {
+	var speaker models.Speaker
+
+	if err := ctx.BodyParser(&speaker); err != nil {
+		slog.Errorf("Invalid request format: %v", err)
+		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	c, cancel := context.WithTimeout(context.Background(), time.Duration(5)*time.Second)
+	defer cancel()
+
+	res, err := ctrl.client.EditSpeaker(c, &pb.EditSpeakerRequest{
+		Speaker: speakerToPb(speaker),
+	})
+
+	if err != nil {
+		slog.Errorf("Error updating speaker: %v", err.Error())
+		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	slog.Info("Speaker updated successfully")
+	return ctx.Status(http.StatusOK).JSON(fiber.Map{"message": res.Message})
+}
+
+func (ctrl *EventController) DeleteSpeaker(ctx *fiber.Ctx) error {
+	sid := ctx.Params("id")
+	speaker_id, err := strconv.Atoi(sid)
+	if err != nil {
+		slog.Errorf("Error converting string to int: %v", err)
+		return err
+	}
+
+	c, cancel := context.WithTimeout(context.Background(), time.Duration(5)*time.Second)
+	defer cancel()
+
+	res, err := ctrl.client.DeleteSpeaker(c, &pb.DeleteSpeakerRequest{
+		SpeakerId: int32(speaker_id),
+	})
+
+	if err != nil {
+		slog.Errorf("Error deleting speaker: %v", err.Error())
+		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	slog.Info("Speaker deleted successfully")
+	return ctx.Status(http.StatusOK).JSON(fiber.Map{"message": res.Message})
+}
+
+func (ctrl *EventController) CreateSession(ctx *fiber.Ctx) error {
+	var session models.Session
+
+	if err := ctx.BodyParser(&session); err != nil {
+		slog.Errorf("Invalid request format: %v", err)
+		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	c, cancel := context.WithTimeout(context.Background(), time.Duration(5)*time.Second)
+	defer cancel()
+
+	res, err := ctrl.client.CreateSession(c, &pb.CreateSessionRequest{
+		Session: sessionToPb(session),
+	})
+
+	if err != nil {
+		slog.Errorf("Error creating session: %v", err.Error())
+		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	slog.Info("Session created successfully")
+	return ctx.Status(http.StatusOK).JSON(fiber.Map{"message": res.Message, "session_id": res.SessionId})
+}
+
+func (ctrl *EventController) EditSession(ctx *fiber.Ctx) error {
+	var session models.Session
+
+	if err := ctx.BodyParser(&session); err != nil {
+		slog.Errorf("Invalid request format: %v", err)
+		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	c, cancel := context.WithTimeout(context.Background(), time.Duration(5)*time.Second)
+	defer cancel()
+
+	res, err := ctrl.client.EditSession(c, &pb.EditSessionRequest{
+		Session: sessionToPb(session),
+	})
+
+	if err != nil {
+		slog.Errorf("Error updating session: %v", err.Error())
+		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	slog.Info("Session updated successfully")
+	return ctx.Status(http.StatusOK).JSON(fiber.Map{"message": res.Message})
+}
+
+func (ctrl *EventController) DeleteSession(ctx *fiber.Ctx) error {
+	sid := ctx.Params("id")
+	session_id, err := strconv.Atoi(sid)
+	if err != nil {
+		slog.Errorf("Error converting string to int: %v", err)
+		return err
+	}
+
+	c, cancel := context.WithTimeout(context.Background(), time.Duration(5)*time.Second)
+	defer cancel()
+
+	res, err := ctrl.client.DeleteSession(c, &pb.DeleteSessionRequest{
+		SessionId: int32(session_id),
+	})
+
+	if err != nil {
+		slog.Errorf("Error deleting session: %v", err.Error())
+		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	slog.Info("Session deleted successfully")
+	return ctx.Status(http.StatusOK).JSON(fiber.Map{"message": res.Message})
+}
+
+func (ctrl *EventController) GetEventAgenda(ctx *fiber.Ctx) error {
+	sid := ctx.Params("id")
+	event_id, err := strconv.Atoi(sid)
+	if err != nil {
+		slog.Errorf("Error converting string to int: %v", err)
+		return err
+	}
+
+	c, cancel := context.WithTimeout(context.Background(), time.Duration(5)*time.Second)
+	defer cancel()
+
+	res, err := ctrl.client.GetEventAgenda(c, &pb.GetEventAgendaRequest{
+		EventId: int32(event_id),
+	})
+
+	if err != nil {
+		slog.Errorf("Error retrieving event agenda: %v", err.Error())
+		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	speakers := make([]models.Speaker, len(res.Speakers))
+
+	for i := range speakers {
+		speakers[i] = speakerFromPb(res.Speakers[i])
+	}
+
+	slog.Info("Event agenda retrieved successfully")
+	return ctx.Status(http.StatusOK).JSON(fiber.Map{"sessions": sessionsFromPb(res.Sessions), "speakers": speakers})
+}
+
+func (ctrl *EventController) BookmarkSession(ctx *fiber.Ctx) error {
+	sid := ctx.Params("id")
+	session_id, err := strconv.Atoi(sid)
+	if err != nil {
+		slog.Errorf("Error converting string to int: %v", err)
+		return err
+	}
+
+	user_id, err := util.CurrentUserID(ctx)
+	if err != nil {
+		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	c, cancel := context.WithTimeout(context.Background(), time.Duration(5)*time.Second)
+	defer cancel()
+
+	res, err := ctrl.client.BookmarkSession(c, &pb.BookmarkSessionRequest{
+		SessionId: int32(session_id),
+		UserId:    int32(user_id),
+	})
+
+	if err != nil {
+		slog.Errorf("Error bookmarking session: %v", err.Error())
+		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	slog.Info("Session bookmarked successfully")
+	return ctx.Status(http.StatusOK).JSON(fiber.Map{"message": res.Message})
+}
+
+func (ctrl *EventController) RemoveBookmark(ctx *fiber.Ctx) error {
+	sid := ctx.Params("id")
+	session_id, err := strconv.Atoi(sid)
+	if err != nil {
+		slog.Errorf("Error converting string to int: %v", err)
+		return err
+	}
+
+	user_id, err := util.CurrentUserID(ctx)
+	if err != nil {
+		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	c, cancel := context.WithTimeout(context.Background(), time.Duration(5)*time.Second)
+	defer cancel()
+
+	res, err := ctrl.client.RemoveBookmark(c, &pb.RemoveBookmarkRequest{
+		SessionId: int32(session_id),
+		UserId:    int32(user_id),
+	})
+
+	if err != nil {
+		slog.Errorf("Error removing bookmark: %v", err.Error())
+		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	slog.Info("Bookmark removed successfully")
+	return ctx.Status(http.StatusOK).JSON(fiber.Map{"message": res.Message})
+}
+
+func (ctrl *EventController) GetUserAgenda(ctx *fiber.Ctx) error {
+	sid := ctx.Params("id")
+	event_id, err := strconv.Atoi(sid)
+	if err != nil {
+		slog.Errorf("Error converting string to int: %v", err)
+		return err
+	}
+
+	user_id, err := util.CurrentUserID(ctx)
+	if err != nil {
+		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	c, cancel := context.WithTimeout(context.Background(), time.Duration(5)*time.Second)
+	defer cancel()
+
+	res, err := ctrl.client.GetUserAgenda(c, &pb.GetUserAgendaRequest{
+		EventId: int32(event_id),
+		UserId:  int32(user_id),
+	})
+
+	if err != nil {
+		slog.Errorf("Error retrieving user agenda: %v", err.Error())
+		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	slog.Info("User agenda retrieved successfully")
+	return ctx.Status(http.StatusOK).JSON(fiber.Map{"sessions": sessionsFromPb(res.Sessions)})
+}
+
+func speakerFromPb(speaker *pb.Speaker) models.Speaker {
+	return models.Speaker{
+		ID:      uint(speaker.SpeakerId),
+		EventID: uint(speaker.EventId),
+		Name:    speaker.Name,
+		Title:   speaker.Title,
+		Bio:     speaker.Bio,
+		Photo:   speaker.Photo,
+		Links:   speaker.Links,
+	}
+}
+
+func speakerToPb(speaker models.Speaker) *pb.Speaker {
+	return &pb.Speaker{
+		SpeakerId: int32(speaker.ID),
+		EventId:   int32(speaker.EventID),
+		Name:      speaker.Name,
+		Title:     speaker.Title,
+		Bio:       speaker.Bio,
+		Photo:     speaker.Photo,
+		Links:     speaker.Links,
+	}
+}
+
+func sessionToPb(session models.Session) *pb.Session {
+	newSession := &pb.Session{
+		SessionId: int32(session.ID),
+		EventId:   int32(session.EventID),
+		SpeakerId: int32(session.SpeakerID),
+		Title:     session.Title,
+		Desc:      session.Description,
+		Room:      session.Room,
+	}
+	if !session.StartTime.IsZero() {
+		newSession.Start = timestamppb.New(session.StartTime)
+	}
+	if !session.EndTime.IsZero() {
+		newSession.End = timestamppb.New(session.EndTime)
+	}
+	return newSession
+}
+
+func sessionsFromPb(sessions []*pb.Session) []models.Session {
+	newSessions := make([]models.Session, len(sessions))
+
+	for i, session := range sessions {
+		newSessions[i] = models.Session{
+			ID:          uint(session.SessionId),
+			EventID:     uint(session.EventId),
+			SpeakerID:   uint(session.SpeakerId),
+			Title:       session.Title,
+			Description: session.Desc,
+			Room:        session.Room,
+			StartTime:   session.Start.AsTime(),
+			EndTime:     session.End.AsTime(),
+		}
+		if session.Speaker != nil {
+			speaker := speakerFromPb(session.Speaker)
+			newSessions[i].Speaker = &speaker
+		}
+	}
+	return newSessions
+}
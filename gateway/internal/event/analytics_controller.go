@@ -0,0 +1,103 @@
+package event
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/catness812/faf-hub-backend/gateway/internal/event/pb"
+	"github.com/catness812/faf-hub-backend/gateway/models"
+	"github.com/gofiber/fiber/v2"
+	"github.com/gookit/slog"
+)
+
+func (ctrl *EventController) GetEventAnalytics(ctx *fiber.Ctx) error {
+	sid := ctx.Params("id")
+	event_id, err := strconv.Atoi(sid)
+	if err != nil {
+		slog.Errorf("Error converting string to int: %v", err)
+		return err
+	}
+
+	c, cancel := context.WithTimeout(context.Background(), time.Duration(5)*time.Second)
+	defer cancel()
+
+	res, err := ctrl.client.GetEventAnalytics(c, &pb.GetEventAnalyticsRequest{
+		EventId: int32(event_id),
+	})
+
+	if err != nil {
+		slog.Errorf("Error retrieving event analytics: %v", err.Error())
+		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	timeline := make([]models.RegistrationTimelinePoint, len(res.Analytics.Timeline))
+	for i, point := range res.Analytics.Timeline {
+		timeline[i] = models.RegistrationTimelinePoint{
+			DaysBeforeDeadline: int(point.DaysBeforeDeadline),
+			Registrations:      point.Registrations,
+			Cumulative:         point.Cumulative,
+		}
+	}
+
+	analytics := models.EventAnalytics{
+		EventID:              uint(res.Analytics.EventId),
+		Registrations:        res.Analytics.Registrations,
+		Timeline:             timeline,
+		NewsletterSends:      res.Analytics.NewsletterSends,
+		NewsletterRecipients: res.Analytics.NewsletterRecipients,
+		NewsletterConverted:  res.Analytics.NewsletterConverted,
+		ConversionRate:       res.Analytics.ConversionRate,
+		Confirmed:            res.Analytics.Confirmed,
+		CheckedIn:            res.Analytics.CheckedIn,
+		AttendanceRate:       res.Analytics.AttendanceRate,
+		RepeatAttendees:      res.Analytics.RepeatAttendees,
+		FeedbackResponses:    res.Analytics.FeedbackResponses,
+		AvgContent:           res.Analytics.AvgContent,
+		AvgOrganization:      res.Analytics.AvgOrganization,
+		AvgVenue:             res.Analytics.AvgVenue,
+	}
+
+	slog.Info("Event analytics retrieved successfully")
+	return ctx.Status(http.StatusOK).JSON(fiber.Map{"analytics": analytics})
+}
+
+func (ctrl *EventController) GetAnalyticsOverview(ctx *fiber.Ctx) error {
+	var err error
+	req := &pb.GetAnalyticsOverviewRequest{}
+	if req.From, err = parseQueryTime(ctx.Query("from")); err != nil {
+		slog.Errorf("Error parsing from date: %v", err.Error())
+		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+	if req.To, err = parseQueryTime(ctx.Query("to")); err != nil {
+		slog.Errorf("Error parsing to date: %v", err.Error())
+		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	c, cancel := context.WithTimeout(context.Background(), time.Duration(5)*time.Second)
+	defer cancel()
+
+	res, err := ctrl.client.GetAnalyticsOverview(c, req)
+
+	if err != nil {
+		slog.Errorf("Error retrieving analytics overview: %v", err.Error())
+		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	periods := make([]models.AnalyticsPeriod, len(res.Periods))
+	for i, period := range res.Periods {
+		periods[i] = models.AnalyticsPeriod{
+			Period:          period.Period,
+			Events:          period.Events,
+			Registrations:   period.Registrations,
+			CheckedIn:       period.CheckedIn,
+			AttendanceRate:  period.AttendanceRate,
+			RepeatAttendees: period.RepeatAttendees,
+			AvgRating:       period.AvgRating,
+		}
+	}
+
+	slog.Info("Analytics overview retrieved successfully")
+	return ctx.Status(http.StatusOK).JSON(fiber.Map{"periods": periods})
+}
@@ -0,0 +1,110 @@
+package event
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/catness812/faf-hub-backend/gateway/internal/event/pb"
+	"github.com/catness812/faf-hub-backend/gateway/internal/util"
+	"github.com/catness812/faf-hub-backend/gateway/models"
+	"github.com/gofiber/fiber/v2"
+	"github.com/gookit/slog"
+)
+
+func (ctrl *EventController) GetEventCalendar(ctx *fiber.Ctx) error {
+	sid := ctx.Params("id")
+	event_id, err := strconv.Atoi(sid)
+	if err != nil {
+		slog.Errorf("Error converting string to int: %v", err)
+		return err
+	}
+
+	c, cancel := context.WithTimeout(context.Background(), time.Duration(5)*time.Second)
+	defer cancel()
+
+	res, err := ctrl.client.GetEvent(c, &pb.GetEventRequest{
+		EventId: int32(event_id),
+	})
+
+	if err != nil {
+		slog.Errorf("Error retrieving event: %v", err.Error())
+		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	event := eventFromPb(res.Event)
+
+	slog.Info("Event calendar retrieved successfully")
+	return sendCalendar(ctx, "event-"+sid+".ics", util.EventsCalendar(event.Name, []models.Event{event}))
+}
+
+func (ctrl *EventController) GetCalendar(ctx *fiber.Ctx) error {
+	c, cancel := context.WithTimeout(context.Background(), time.Duration(5)*time.Second)
+	defer cancel()
+
+	res, err := ctrl.client.GetEvents(c, &pb.GetEventsRequest{
+		When:     "upcoming",
+		PageSize: 100,
+	})
+
+	if err != nil {
+		slog.Errorf("Error retrieving events: %v", err.Error())
+		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	events := make([]models.Event, len(res.Events))
+
+	for i := range events {
+		events[i] = eventFromPb(res.Events[i])
+	}
+
+	slog.Info("Calendar retrieved successfully")
+	return sendCalendar(ctx, "calendar.ics", util.EventsCalendar("FAF events", events))
+}
+
+func (ctrl *EventController) GetCalendarFeedURL(ctx *fiber.Ctx) error {
+	user_id, err := util.CurrentUserID(ctx)
+	if err != nil {
+		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	slog.Info("Calendar feed URL retrieved successfully")
+	return ctx.Status(http.StatusOK).JSON(fiber.Map{"url": os.Getenv("APP_URL") + "/calendar/" + util.CalendarToken(user_id) + ".ics"})
+}
+
+func (ctrl *EventController) GetUserCalendar(ctx *fiber.Ctx) error {
+	user_id, err := util.ParseCalendarToken(ctx.Params("token"))
+	if err != nil {
+		slog.Errorf("Error parsing calendar token: %v", err.Error())
+		return ctx.Status(http.StatusNotFound).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	c, cancel := context.WithTimeout(context.Background(), time.Duration(5)*time.Second)
+	defer cancel()
+
+	res, err := ctrl.client.GetUserEvents(c, &pb.GetUserEventsRequest{
+		UserId: int32(user_id),
+	})
+
+	if err != nil {
+		slog.Errorf("Error retrieving events user registered for: %v", err.Error())
+		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	events := make([]models.Event, len(res.Events))
+
+	for i := range events {
+		events[i] = eventFromPb(res.Events[i])
+	}
+
+	slog.Info("User calendar retrieved successfully")
+	return sendCalendar(ctx, "my-events.ics", util.EventsCalendar("My FAF events", events))
+}
+
+func sendCalendar(ctx *fiber.Ctx, fileName string, calendar string) error {
+	ctx.Set(fiber.HeaderContentType, "text/calendar; charset=utf-8")
+	ctx.Set(fiber.HeaderContentDisposition, `inline; filename="`+fileName+`"`)
+	return ctx.Status(http.StatusOK).SendString(calendar)
+}
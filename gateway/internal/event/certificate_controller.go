@@ -0,0 +1,132 @@
+package event
+
+import (
+	"context"
+	"encoding/base64"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/catness812/faf-hub-backend/gateway/internal/event/pb"
+	pb2 "github.com/catness812/faf-hub-backend/gateway/internal/notification/pb"
+	"github.com/catness812/faf-hub-backend/gateway/internal/util"
+	"github.com/gofiber/fiber/v2"
+	"github.com/gookit/slog"
+)
+
+func (ctrl *EventController) GetUserCertificate(ctx *fiber.Ctx) error {
+	sid := ctx.Params("id")
+	event_id, err := strconv.Atoi(sid)
+	if err != nil {
+		slog.Errorf("Error converting string to int: %v", err)
+		return err
+	}
+
+	user_id, err := util.CurrentUserID(ctx)
+	if err != nil {
+		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	c, cancel := context.WithTimeout(context.Background(), time.Duration(5)*time.Second)
+	defer cancel()
+
+	res, err := ctrl.client.GetUserCertificate(c, &pb.GetUserCertificateRequest{
+		EventId: int32(event_id),
+		UserId:  int32(user_id),
+	})
+
+	if err != nil {
+		slog.Errorf("Error retrieving certificate: %v", err.Error())
+		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	slog.Info("Certificate retrieved successfully")
+	ctx.Set(fiber.HeaderContentType, "application/pdf")
+	ctx.Set(fiber.HeaderContentDisposition, `attachment; filename="certificate-`+res.Certificate.Code+`.pdf"`)
+	return ctx.Status(http.StatusOK).Send(res.Pdf)
+}
+
+func (ctrl *EventController) VerifyCertificate(ctx *fiber.Ctx) error {
+	code := strings.ToUpper(ctx.Params("code"))
+
+	c, cancel := context.WithTimeout(context.Background(), time.Duration(5)*time.Second)
+	defer cancel()
+
+	res, err := ctrl.client.VerifyCertificate(c, &pb.VerifyCertificateRequest{
+		Code: code,
+	})
+
+	if err != nil {
+		slog.Errorf("Error verifying certificate: %v", err.Error())
+		return ctx.Status(http.StatusNotFound).JSON(fiber.Map{"valid": false, "error": err.Error()})
+	}
+
+	slog.Info("Certificate verified successfully")
+	return ctx.Status(http.StatusOK).JSON(fiber.Map{
+		"valid": true,
+		"certificate": fiber.Map{
+			"code":        res.Certificate.Code,
+			"first_name":  res.Certificate.FirstName,
+			"last_name":   res.Certificate.LastName,
+			"event_id":    res.Certificate.EventId,
+			"event_name":  res.Certificate.EventName,
+			"event_start": res.Certificate.EventStart.AsTime(),
+			"event_end":   res.Certificate.EventEnd.AsTime(),
+			"issued_at":   res.Certificate.IssuedAt.AsTime(),
+		},
+	})
+}
+
+func (ctrl *EventController) SendCertificates(ctx *fiber.Ctx) error {
+	sid := ctx.Params("id")
+	event_id, err := strconv.Atoi(sid)
+	if err != nil {
+		slog.Errorf("Error converting string to int: %v", err)
+		return err
+	}
+
+	c, cancel := context.WithTimeout(context.Background(), time.Duration(5)*time.Second)
+	defer cancel()
+
+	res, err := ctrl.client.IssueCertificates(c, &pb.IssueCertificatesRequest{
+		EventId: int32(event_id),
+	})
+
+	if err != nil {
+		slog.Errorf("Error issuing certificates: %v", err.Error())
+		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	sent := 0
+	for _, certificate := range res.Certificates {
+		if err := ctrl.sendCertificate(certificate.Code); err != nil {
+			slog.Errorf("Error sending certificate %s: %v", certificate.Code, err.Error())
+			continue
+		}
+		sent++
+	}
+
+	slog.Infof("Sent %d certificates", sent)
+	return ctx.Status(http.StatusOK).JSON(fiber.Map{"message": res.Message, "issued": len(res.Certificates), "sent": sent})
+}
+
+func (ctrl *EventController) sendCertificate(code string) error {
+	c, cancel := context.WithTimeout(context.Background(), time.Duration(5)*time.Second)
+	defer cancel()
+
+	res, err := ctrl.client.GetCertificate(c, &pb.GetCertificateRequest{
+		Code: code,
+	})
+	if err != nil {
+		return err
+	}
+
+	body := res.Certificate.Email + ";" + util.MessageSafe(res.Certificate.EventName) + ";" + res.Certificate.Code + ";" + base64.StdEncoding.EncodeToString(res.Pdf)
+
+	_, err = ctrl.notificationClient.Publish(c, &pb2.PublishRequest{
+		QueueName: "certificate",
+		Body:      body,
+	})
+	return err
+}
@@ -2,36 +2,44 @@ package event
 
 import (
 	"context"
+	"encoding/base64"
+	"fmt"
 	"net/http"
 	"strconv"
 	"strings"
 	"time"
 
+	pb3 "github.com/catness812/faf-hub-backend/gateway/internal/content/pb"
 	"github.com/catness812/faf-hub-backend/gateway/internal/event/pb"
+	"github.com/catness812/faf-hub-backend/gateway/internal/media"
 	pb2 "github.com/catness812/faf-hub-backend/gateway/internal/notification/pb"
 	"github.com/catness812/faf-hub-backend/gateway/internal/util"
 	"github.com/catness812/faf-hub-backend/gateway/models"
 	"github.com/gofiber/fiber/v2"
-	"github.com/golang/protobuf/ptypes/empty"
 	"github.com/gookit/slog"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type IRedisService interface {
 	GetNewsletterEmails() ([]string, error)
+	AllowAttempt(key string, limit int64, window time.Duration) (bool, error)
 }
 
 type EventController struct {
 	client             pb.EventServiceClient
 	notificationClient pb2.NotificationServiceClient
+	contentClient      pb3.ContentServiceClient
 	redisSvc           IRedisService
+	mediaLibrary       media.CoverLibrary
 }
 
-func NewEventController(client pb.EventServiceClient, notificationClient pb2.NotificationServiceClient, redisSvc IRedisService) *EventController {
+func NewEventController(client pb.EventServiceClient, notificationClient pb2.NotificationServiceClient, contentClient pb3.ContentServiceClient, redisSvc IRedisService, mediaLibrary media.CoverLibrary) *EventController {
 	return &EventController{
 		client:             client,
 		notificationClient: notificationClient,
+		contentClient:      contentClient,
 		redisSvc:           redisSvc,
+		mediaLibrary:       mediaLibrary,
 	}
 }
 
@@ -43,19 +51,16 @@ func (ctrl *EventController) CreateEvent(ctx *fiber.Ctx) error {
 		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
 	}
 
+	if err := media.ApplyCover(ctrl.mediaLibrary, event.CoverMediaID, &event.Cover); err != nil {
+		slog.Errorf("Error resolving cover media: %v", err.Error())
+		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
 	c, cancel := context.WithTimeout(context.Background(), time.Duration(5)*time.Second)
 	defer cancel()
 
 	res, err := ctrl.client.CreateEvent(c, &pb.CreateEventRequest{
-		Event: &pb.Event{
-			Name:     event.Name,
-			Start:    timestamppb.New(event.StartDateTime),
-			End:      timestamppb.New(event.EndDateTime),
-			Location: event.Location,
-			Deadline: timestamppb.New(event.ApplicationDeadline),
-			Cover:    event.Cover,
-			Desc:     event.Description,
-		},
+		Event: eventToPb(event),
 	})
 
 	if err != nil {
@@ -76,6 +81,15 @@ func (ctrl *EventController) CreateEvent(ctx *fiber.Ctx) error {
 	})
 	if err != nil {
 		slog.Errorf("Error publishing newsletter emails: %v", err.Error())
+	} else if len(emails) > 0 {
+		_, err = ctrl.client.RecordNewsletterSend(c, &pb.RecordNewsletterSendRequest{
+			EventId: res.EventId,
+			Kind:    "announcement",
+			Emails:  emails,
+		})
+		if err != nil {
+			slog.Errorf("Error recording newsletter send: %v", err.Error())
+		}
 	}
 
 	slog.Info("Event created successfully")
@@ -95,20 +109,17 @@ func (ctrl *EventController) EditEvent(ctx *fiber.Ctx) error {
 		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
 	}
 
+	if err := media.ApplyCover(ctrl.mediaLibrary, event.Event.CoverMediaID, &event.Event.Cover); err != nil {
+		slog.Errorf("Error resolving cover media: %v", err.Error())
+		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
 	c, cancel := context.WithTimeout(context.Background(), time.Duration(5)*time.Second)
 	defer cancel()
 
+	event.Event.ID = event.EventID
 	res, err := ctrl.client.EditEvent(c, &pb.EditEventRequest{
-		Event: &pb.Event{
-			EventId:  int32(event.EventID),
-			Name:     event.Event.Name,
-			Start:    timestamppb.New(event.Event.StartDateTime),
-			End:      timestamppb.New(event.Event.EndDateTime),
-			Location: event.Event.Location,
-			Deadline: timestamppb.New(event.Event.ApplicationDeadline),
-			Cover:    event.Event.Cover,
-			Desc:     event.Event.Description,
-		},
+		Event: eventToPb(event.Event),
 	})
 
 	if err != nil {
@@ -144,6 +155,44 @@ func (ctrl *EventController) DeleteEvent(ctx *fiber.Ctx) error {
 	return ctx.Status(http.StatusOK).JSON(fiber.Map{"message": res.Message})
 }
 
+func (ctrl *EventController) CancelEvent(ctx *fiber.Ctx) error {
+	type Cancellation struct {
+		Reason string `json:"reason"`
+	}
+
+	var cancellation Cancellation
+
+	sid := ctx.Params("id")
+	id, err := strconv.Atoi(sid)
+	if err != nil {
+		slog.Errorf("Error converting string to int: %v", err)
+		return err
+	}
+
+	if len(ctx.Body()) > 0 {
+		if err := ctx.BodyParser(&cancellation); err != nil {
+			slog.Errorf("Invalid request format: %v", err)
+			return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+		}
+	}
+
+	c, cancel := context.WithTimeout(context.Background(), time.Duration(5)*time.Second)
+	defer cancel()
+
+	res, err := ctrl.client.CancelEvent(c, &pb.CancelEventRequest{
+		EventId: int32(id),
+		Reason:  cancellation.Reason,
+	})
+
+	if err != nil {
+		slog.Errorf("Error cancelling event: %v", err.Error())
+		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	slog.Info("Event cancelled successfully")
+	return ctx.Status(http.StatusOK).JSON(fiber.Map{"message": res.Message, "notified": res.Notified})
+}
+
 func (ctrl *EventController) GetEvent(ctx *fiber.Ctx) error {
 	sid := ctx.Params("id")
 	id, err := strconv.Atoi(sid)
@@ -164,16 +213,7 @@ func (ctrl *EventController) GetEvent(ctx *fiber.Ctx) error {
 		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
 	}
 
-	event := models.Event{
-		Name:                res.Event.Name,
-		StartDateTime:       res.Event.Start.AsTime(),
-		EndDateTime:         res.Event.End.AsTime(),
-		Location:            res.Event.Location,
-		ApplicationDeadline: res.Event.Deadline.AsTime(),
-		Cover:               res.Event.Cover,
-		Description:         res.Event.Desc,
-	}
-	event.ID = uint(res.Event.EventId)
+	event := eventFromPb(res.Event)
 
 	slog.Info("Event retrieved successfully")
 	return ctx.Status(http.StatusOK).JSON(fiber.Map{"event": event})
@@ -183,7 +223,31 @@ func (ctrl *EventController) GetAllEvents(ctx *fiber.Ctx) error {
 	c, cancel := context.WithTimeout(context.Background(), time.Duration(5)*time.Second)
 	defer cancel()
 
-	res, err := ctrl.client.GetEvents(c, &empty.Empty{})
+	req := &pb.GetEventsRequest{
+		Page:     int32(ctx.QueryInt("page", 1)),
+		PageSize: int32(ctx.QueryInt("page_size", 0)),
+		Cursor:   ctx.Query("cursor"),
+		When:     ctx.Query("when"),
+		Location: ctx.Query("location"),
+		Query:    ctx.Query("q"),
+		Category: ctx.Query("category"),
+		Sort:     ctx.Query("sort"),
+	}
+	if tags := ctx.Query("tags"); tags != "" {
+		req.Tags = strings.Split(tags, ",")
+	}
+
+	var err error
+	if req.From, err = parseQueryTime(ctx.Query("from")); err != nil {
+		slog.Errorf("Error parsing from date: %v", err.Error())
+		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+	if req.To, err = parseQueryTime(ctx.Query("to")); err != nil {
+		slog.Errorf("Error parsing to date: %v", err.Error())
+		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	res, err := ctrl.client.GetEvents(c, req)
 
 	if err != nil {
 		slog.Errorf("Error retrieving events: %v", err.Error())
@@ -193,19 +257,58 @@ func (ctrl *EventController) GetAllEvents(ctx *fiber.Ctx) error {
 	events := make([]models.Event, len(res.Events))
 
 	for i := range events {
-		events[i] = models.Event{
-			Name:                res.Events[i].Name,
-			StartDateTime:       res.Events[i].Start.AsTime(),
-			EndDateTime:         res.Events[i].End.AsTime(),
-			Location:            res.Events[i].Location,
-			ApplicationDeadline: res.Events[i].Deadline.AsTime(),
-			Cover:               res.Events[i].Cover,
-			Description:         res.Events[i].Desc,
-		}
-		events[i].ID = uint(res.Events[i].EventId)
+		events[i] = eventFromPb(res.Events[i])
 	}
 
+	totalPages := (res.Total + int64(res.PageSize) - 1) / int64(res.PageSize)
+
 	slog.Info("Events retrieved successfully")
+	return ctx.Status(http.StatusOK).JSON(fiber.Map{
+		"events": events,
+		"pagination": fiber.Map{
+			"page":        res.Page,
+			"page_size":   res.PageSize,
+			"total":       res.Total,
+			"total_pages": totalPages,
+			"next_cursor": res.NextCursor,
+		},
+	})
+}
+
+func (ctrl *EventController) GetRecommendedEvents(ctx *fiber.Ctx) error {
+	user_id, err := util.CurrentUserID(ctx)
+	if err != nil {
+		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	c, cancel := context.WithTimeout(context.Background(), time.Duration(5)*time.Second)
+	defer cancel()
+
+	res, err := ctrl.client.GetRecommendedEvents(c, &pb.GetRecommendedEventsRequest{
+		UserId: int32(user_id),
+		Limit:  int32(ctx.QueryInt("limit", 0)),
+	})
+
+	if err != nil {
+		slog.Errorf("Error retrieving recommended events: %v", err.Error())
+		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	type RecommendedEvent struct {
+		models.Event
+		Score int32 `json:"score"`
+	}
+
+	events := make([]RecommendedEvent, len(res.Events))
+
+	for i := range events {
+		events[i] = RecommendedEvent{
+			Event: eventFromPb(res.Events[i].Event),
+			Score: res.Events[i].Score,
+		}
+	}
+
+	slog.Info("Recommended events retrieved successfully")
 	return ctx.Status(http.StatusOK).JSON(fiber.Map{"events": events})
 }
 
@@ -253,10 +356,34 @@ func (ctrl *EventController) RegisterForEvent(ctx *fiber.Ctx) error {
 		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
 	}
 
+	ctrl.publishTicket(c, event_id, reg.Email, res.Ticket)
+
 	slog.Info("Event registration completed successfully")
 	return ctx.Status(http.StatusOK).JSON(fiber.Map{"message": res.Message})
 }
 
+// publishTicket emails the ticket together with a calendar invite for the
+// event. Failures are only logged since the registration is already saved.
+func (ctrl *EventController) publishTicket(c context.Context, event_id int, email string, ticket string) {
+	eventRes, err := ctrl.client.GetEvent(c, &pb.GetEventRequest{
+		EventId: int32(event_id),
+	})
+	if err != nil {
+		slog.Errorf("Error retrieving event: %v", err.Error())
+		return
+	}
+
+	event := eventFromPb(eventRes.Event)
+	invite := base64.StdEncoding.EncodeToString([]byte(util.EventsCalendar(event.Name, []models.Event{event})))
+	_, err = ctrl.notificationClient.Publish(c, &pb2.PublishRequest{
+		QueueName: "ticket",
+		Body:      email + ";" + util.MessageSafe(event.Name) + ";" + ticket + ";" + invite,
+	})
+	if err != nil {
+		slog.Errorf("Error publishing event ticket: %v", err.Error())
+	}
+}
+
 func (ctrl *EventController) GetEventRegistrations(ctx *fiber.Ctx) error {
 	sid := ctx.Params("id")
 	event_id, err := strconv.Atoi(sid)
@@ -269,7 +396,11 @@ func (ctrl *EventController) GetEventRegistrations(ctx *fiber.Ctx) error {
 	defer cancel()
 
 	res, err := ctrl.client.GetEventRegistrations(c, &pb.GetEventRegistrationsRequest{
-		EventId: int32(event_id),
+		EventId:  int32(event_id),
+		Page:     int32(ctx.QueryInt("page", 1)),
+		PageSize: int32(ctx.QueryInt("page_size", 0)),
+		Query:    ctx.Query("q"),
+		Status:   ctx.Query("status"),
 	})
 
 	if err != nil {
@@ -277,8 +408,58 @@ func (ctrl *EventController) GetEventRegistrations(ctx *fiber.Ctx) error {
 		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
 	}
 
+	registrations := make([]models.Registration, len(res.Registrations))
+
+	for i := range registrations {
+		registrations[i] = registrationFromPb(res.Registrations[i])
+	}
+
+	totalPages := (res.Total + int64(res.PageSize) - 1) / int64(res.PageSize)
+
 	slog.Info("Event registrations retrieved successfully")
-	return ctx.Status(http.StatusOK).JSON(fiber.Map{"registrations": res.Registrations})
+	return ctx.Status(http.StatusOK).JSON(fiber.Map{
+		"registrations": registrations,
+		"pagination": fiber.Map{
+			"page":        res.Page,
+			"page_size":   res.PageSize,
+			"total":       res.Total,
+			"total_pages": totalPages,
+		},
+	})
+}
+
+func (ctrl *EventController) ExportEventRegistrations(ctx *fiber.Ctx) error {
+	sid := ctx.Params("id")
+	event_id, err := strconv.Atoi(sid)
+	if err != nil {
+		slog.Errorf("Error converting string to int: %v", err)
+		return err
+	}
+
+	c, cancel := context.WithTimeout(context.Background(), time.Duration(10)*time.Second)
+	defer cancel()
+
+	res, err := ctrl.client.ExportEventRegistrations(c, &pb.ExportEventRegistrationsRequest{
+		EventId: int32(event_id),
+		Format:  ctx.Query("format", "csv"),
+		Query:   ctx.Query("q"),
+		Status:  ctx.Query("status"),
+	})
+
+	if err != nil {
+		slog.Errorf("Error exporting event registrations: %v", err.Error())
+		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	contentType := "text/csv"
+	if strings.HasSuffix(res.FileName, ".xlsx") {
+		contentType = "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+	}
+
+	slog.Info("Event registrations exported successfully")
+	ctx.Set(fiber.HeaderContentType, contentType)
+	ctx.Set(fiber.HeaderContentDisposition, `attachment; filename="`+res.FileName+`"`)
+	return ctx.Status(http.StatusOK).Send(res.File)
 }
 
 func (ctrl EventController) GetUserEventRegistration(ctx *fiber.Ctx) error {
@@ -332,16 +513,7 @@ func (ctrl EventController) GetUserEvents(ctx *fiber.Ctx) error {
 	events := make([]models.Event, len(res.Events))
 
 	for i := range events {
-		events[i] = models.Event{
-			Name:                res.Events[i].Name,
-			StartDateTime:       res.Events[i].Start.AsTime(),
-			EndDateTime:         res.Events[i].End.AsTime(),
-			Location:            res.Events[i].Location,
-			ApplicationDeadline: res.Events[i].Deadline.AsTime(),
-			Cover:               res.Events[i].Cover,
-			Description:         res.Events[i].Desc,
-		}
-		events[i].ID = uint(res.Events[i].EventId)
+		events[i] = eventFromPb(res.Events[i])
 	}
 
 	slog.Info("Events user registered for retrieved successfully")
@@ -390,40 +562,118 @@ func (ctrl EventController) EditRegistration(ctx *fiber.Ctx) error {
 	return ctx.Status(http.StatusOK).JSON(fiber.Map{"message": res.Message})
 }
 
-func (ctrl EventController) LeaveFeedback(ctx *fiber.Ctx) error {
-	type Feedback struct {
-		EventID  uint   `json:"event_id"`
-		Feedback string `json:"feedback"`
+// eventFromPb returns the times in the event's timezone, so they are
+// serialized as ISO-8601 with the matching offset.
+func eventFromPb(event *pb.Event) models.Event {
+	loc := eventLocation(event.Timezone)
+	newEvent := models.Event{
+		Name:                event.Name,
+		StartDateTime:       event.Start.AsTime().In(loc),
+		EndDateTime:         event.End.AsTime().In(loc),
+		Timezone:            event.Timezone,
+		Location:            event.Location,
+		ApplicationDeadline: event.Deadline.AsTime().In(loc),
+		Cover:               event.Cover,
+		CoverMediaID:        event.CoverMediaId,
+		CoverURLs:           media.CoverURLs(event.CoverMediaId),
+		Description:         event.Desc,
+		MinTeamSize:         int(event.MinTeamSize),
+		MaxTeamSize:         int(event.MaxTeamSize),
+		CancelledAt:         timeOrNil(event.CancelledAt),
+		CancellationReason:  event.CancellationReason,
+		Category:            event.Category,
+		Tags:                event.Tags,
+		AllowGuests:         event.AllowGuests,
+	}
+	if event.SeriesId != 0 {
+		seriesID := uint(event.SeriesId)
+		newEvent.SeriesID = &seriesID
+	}
+	if event.RoomId != 0 {
+		roomID := uint(event.RoomId)
+		newEvent.RoomID = &roomID
+	}
+	newEvent.ID = uint(event.EventId)
+	return newEvent
+}
+
+func eventToPb(event models.Event) *pb.Event {
+	return &pb.Event{
+		EventId:      int32(event.ID),
+		Name:         event.Name,
+		Start:        timestamppb.New(event.StartDateTime),
+		End:          timestamppb.New(event.EndDateTime),
+		Timezone:     event.Timezone,
+		Location:     event.Location,
+		Deadline:     timestamppb.New(event.ApplicationDeadline),
+		Cover:        event.Cover,
+		CoverMediaId: event.CoverMediaID,
+		Desc:         event.Description,
+		MinTeamSize:  int32(event.MinTeamSize),
+		MaxTeamSize:  int32(event.MaxTeamSize),
+		Category:     event.Category,
+		Tags:         event.Tags,
+		RoomId:       int32(uintOrZero(event.RoomID)),
+		AllowGuests:  event.AllowGuests,
 	}
+}
 
-	var feedback Feedback
+func registrationFromPb(reg *pb.EventRegistration) models.Registration {
+	return models.Registration{
+		EventID:         uint(reg.EventId),
+		UserID:          uint(reg.UserId),
+		FirstName:       reg.FirstName,
+		LastName:        reg.LastName,
+		Email:           reg.Email,
+		PhoneNumber:     int(reg.PhoneNumber),
+		AcademicGroup:   reg.AcademicGroup,
+		TeamMembers:     reg.TeamMembers,
+		ShirtSize:       reg.ShirtSize,
+		FoodPreferences: reg.FoodPref,
+		Motivation:      reg.Motivation,
+		Questions:       reg.Questions,
+		Feedback:        reg.Feedback,
+		Status:          reg.Status,
+		CheckedInAt:     timeOrNil(reg.CheckedInAt),
+		Guest:           reg.Guest,
+	}
+}
 
-	if err := ctx.BodyParser(&feedback); err != nil {
-		slog.Errorf("Invalid request format: %v", err)
-		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+func eventLocation(timezone string) *time.Location {
+	if loc, err := time.LoadLocation(timezone); err == nil && timezone != "" {
+		return loc
 	}
+	if loc, err := time.LoadLocation(models.DefaultTimezone); err == nil {
+		return loc
+	}
+	return time.UTC
+}
 
-	user_id, err := util.CurrentUserID(ctx)
+// parseQueryTime accepts either a full RFC3339 timestamp or a plain date.
+func parseQueryTime(value string) (*timestamppb.Timestamp, error) {
+	if value == "" {
+		return nil, nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
 	if err != nil {
-		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+		if t, err = time.Parse("2006-01-02", value); err != nil {
+			return nil, fmt.Errorf("invalid date %s, expected YYYY-MM-DD or RFC3339", value)
+		}
 	}
+	return timestamppb.New(t), nil
+}
 
-	c, cancel := context.WithTimeout(context.Background(), time.Duration(5)*time.Second)
-	defer cancel()
-
-	_, err = ctrl.client.EditRegistration(c, &pb.EditRegistrationRequest{
-		Registration: &pb.EventRegistration{
-			EventId:  int32(feedback.EventID),
-			UserId:   int32(user_id),
-			Feedback: feedback.Feedback,
-		},
-	})
-
-	if err != nil {
-		slog.Errorf("Error updating user event registration: %v", err.Error())
-		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+func uintOrZero(n *uint) uint {
+	if n == nil {
+		return 0
 	}
+	return *n
+}
 
-	slog.Info("Event feedback added successfully")
-	return ctx.Status(http.StatusOK).JSON(fiber.Map{"message": "event feedback added successfully"})
+func timeOrNil(t *timestamppb.Timestamp) *time.Time {
+	if t == nil {
+		return nil
+	}
+	newTime := t.AsTime()
+	return &newTime
 }
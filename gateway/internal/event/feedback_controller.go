@@ -0,0 +1,108 @@
+package event
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/catness812/faf-hub-backend/gateway/internal/event/pb"
+	"github.com/catness812/faf-hub-backend/gateway/internal/util"
+	"github.com/catness812/faf-hub-backend/gateway/models"
+	"github.com/gofiber/fiber/v2"
+	"github.com/gookit/slog"
+)
+
+func (ctrl EventController) LeaveFeedback(ctx *fiber.Ctx) error {
+	type Feedback struct {
+		EventID            uint   `json:"event_id"`
+		ContentRating      int    `json:"content_rating"`
+		OrganizationRating int    `json:"organization_rating"`
+		VenueRating        int    `json:"venue_rating"`
+		Comment            string `json:"comment"`
+		Anonymous          bool   `json:"anonymous"`
+	}
+
+	var feedback Feedback
+
+	if err := ctx.BodyParser(&feedback); err != nil {
+		slog.Errorf("Invalid request format: %v", err)
+		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	user_id, err := util.CurrentUserID(ctx)
+	if err != nil {
+		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	c, cancel := context.WithTimeout(context.Background(), time.Duration(5)*time.Second)
+	defer cancel()
+
+	res, err := ctrl.client.SubmitFeedback(c, &pb.SubmitFeedbackRequest{
+		EventId:            int32(feedback.EventID),
+		UserId:             int32(user_id),
+		ContentRating:      int32(feedback.ContentRating),
+		OrganizationRating: int32(feedback.OrganizationRating),
+		VenueRating:        int32(feedback.VenueRating),
+		Comment:            feedback.Comment,
+		Anonymous:          feedback.Anonymous,
+	})
+
+	if err != nil {
+		slog.Errorf("Error saving event feedback: %v", err.Error())
+		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	slog.Info("Event feedback added successfully")
+	return ctx.Status(http.StatusOK).JSON(fiber.Map{"message": res.Message})
+}
+
+func (ctrl *EventController) GetEventFeedback(ctx *fiber.Ctx) error {
+	sid := ctx.Params("id")
+	event_id, err := strconv.Atoi(sid)
+	if err != nil {
+		slog.Errorf("Error converting string to int: %v", err)
+		return err
+	}
+
+	c, cancel := context.WithTimeout(context.Background(), time.Duration(5)*time.Second)
+	defer cancel()
+
+	res, err := ctrl.client.GetEventFeedback(c, &pb.GetEventFeedbackRequest{
+		EventId: int32(event_id),
+	})
+
+	if err != nil {
+		slog.Errorf("Error retrieving event feedback: %v", err.Error())
+		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	summary := models.FeedbackSummary{
+		EventID:             uint(res.EventId),
+		Responses:           res.Responses,
+		AvgContent:          res.AvgContent,
+		AvgOrganization:     res.AvgOrganization,
+		AvgVenue:            res.AvgVenue,
+		ContentRatings:      countsFromPb(res.ContentRatings),
+		OrganizationRatings: countsFromPb(res.OrganizationRatings),
+		VenueRatings:        countsFromPb(res.VenueRatings),
+	}
+
+	entries := make([]models.FeedbackEntry, len(res.Entries))
+
+	for i, entry := range res.Entries {
+		entries[i] = models.FeedbackEntry{
+			ContentRating:      int(entry.ContentRating),
+			OrganizationRating: int(entry.OrganizationRating),
+			VenueRating:        int(entry.VenueRating),
+			Comment:            entry.Comment,
+			Anonymous:          entry.Anonymous,
+			FirstName:          entry.FirstName,
+			LastName:           entry.LastName,
+			SubmittedAt:        entry.SubmittedAt.AsTime(),
+		}
+	}
+
+	slog.Info("Event feedback retrieved successfully")
+	return ctx.Status(http.StatusOK).JSON(fiber.Map{"summary": summary, "feedback": entries})
+}
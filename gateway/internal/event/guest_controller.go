@@ -0,0 +1,175 @@
+package event
+
+import (
+	"context"
+	"net/http"
+	"net/mail"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/catness812/faf-hub-backend/gateway/internal/event/pb"
+	pb2 "github.com/catness812/faf-hub-backend/gateway/internal/notification/pb"
+	"github.com/catness812/faf-hub-backend/gateway/internal/util"
+	"github.com/catness812/faf-hub-backend/gateway/models"
+	"github.com/gofiber/fiber/v2"
+	"github.com/gookit/slog"
+)
+
+func (ctrl *EventController) SetGuestRegistration(ctx *fiber.Ctx) error {
+	type GuestSettings struct {
+		AllowGuests bool `json:"allow_guests"`
+	}
+
+	var settings GuestSettings
+
+	sid := ctx.Params("id")
+	event_id, err := strconv.Atoi(sid)
+	if err != nil {
+		slog.Errorf("Error converting string to int: %v", err)
+		return err
+	}
+
+	if err := ctx.BodyParser(&settings); err != nil {
+		slog.Errorf("Invalid request format: %v", err)
+		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	c, cancel := context.WithTimeout(context.Background(), time.Duration(5)*time.Second)
+	defer cancel()
+
+	res, err := ctrl.client.SetGuestRegistration(c, &pb.SetGuestRegistrationRequest{
+		EventId:     int32(event_id),
+		AllowGuests: settings.AllowGuests,
+	})
+
+	if err != nil {
+		slog.Errorf("Error updating guest registrations: %v", err.Error())
+		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	slog.Info("Guest registrations updated successfully")
+	return ctx.Status(http.StatusOK).JSON(fiber.Map{"message": res.Message})
+}
+
+// Guest registrations need no account, so they are limited per email and per
+// client address to keep the endpoint from being used to send mail.
+const (
+	guestRegisterWindow      = time.Hour
+	guestRegisterEmailLimit  = 5
+	guestRegisterClientLimit = 20
+)
+
+func (ctrl *EventController) RegisterGuest(ctx *fiber.Ctx) error {
+	var reg models.Registration
+
+	sid := ctx.Params("id")
+	event_id, err := strconv.Atoi(sid)
+	if err != nil {
+		slog.Errorf("Error converting string to int: %v", err)
+		return err
+	}
+
+	if err := ctx.BodyParser(&reg); err != nil {
+		slog.Errorf("Invalid request format: %v", err)
+		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	// the same normalization as the event service, so the limit can't be
+	// dodged by writing the email differently
+	addr, err := mail.ParseAddress(strings.TrimSpace(reg.Email))
+	if err != nil || strings.Contains(addr.Address, ";") {
+		slog.Errorf("Invalid guest email: %q", reg.Email)
+		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": "invalid email address"})
+	}
+	reg.Email = strings.ToLower(addr.Address)
+
+	limits := []struct {
+		key   string
+		limit int64
+	}{
+		{"guest_register:email:" + reg.Email, guestRegisterEmailLimit},
+		{"guest_register:client:" + ctx.IP(), guestRegisterClientLimit},
+	}
+	for _, l := range limits {
+		allowed, err := ctrl.redisSvc.AllowAttempt(l.key, l.limit, guestRegisterWindow)
+		if err != nil {
+			return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+		}
+		if !allowed {
+			slog.Error("Too many guest registration attempts")
+			return ctx.Status(http.StatusTooManyRequests).JSON(fiber.Map{"error": "too many registration attempts, please try again later"})
+		}
+	}
+
+	c, cancel := context.WithTimeout(context.Background(), time.Duration(5)*time.Second)
+	defer cancel()
+
+	res, err := ctrl.client.RegisterGuest(c, &pb.RegisterGuestRequest{
+		Registration: &pb.EventRegistration{
+			EventId:       int32(event_id),
+			FirstName:     reg.FirstName,
+			LastName:      reg.LastName,
+			Email:         reg.Email,
+			PhoneNumber:   int32(reg.PhoneNumber),
+			AcademicGroup: reg.AcademicGroup,
+			TeamMembers:   reg.TeamMembers,
+			ShirtSize:     reg.ShirtSize,
+			FoodPref:      reg.FoodPreferences,
+			Motivation:    reg.Motivation,
+			Questions:     reg.Questions,
+		}})
+
+	if err != nil {
+		slog.Errorf("Error registering guest: %v", err.Error())
+		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	eventRes, err := ctrl.client.GetEvent(c, &pb.GetEventRequest{
+		EventId: int32(event_id),
+	})
+	if err != nil {
+		slog.Errorf("Error retrieving event: %v", err.Error())
+		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	link := os.Getenv("APP_URL") + "/event/guest/confirm/" + res.Token
+	_, err = ctrl.notificationClient.Publish(c, &pb2.PublishRequest{
+		QueueName: "guest_confirmation",
+		Body:      reg.Email + ";" + util.MessageSafe(eventRes.Event.Name) + ";" + link,
+	})
+	if err != nil {
+		slog.Errorf("Error publishing guest confirmation: %v", err.Error())
+		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	slog.Info("Guest registration saved successfully")
+	return ctx.Status(http.StatusOK).JSON(fiber.Map{"message": res.Message})
+}
+
+// ConfirmGuestRegistration is the magic link emailed to guests. The ticket is
+// emailed once, when the registration gets confirmed, but opening the link
+// again still returns it.
+func (ctrl *EventController) ConfirmGuestRegistration(ctx *fiber.Ctx) error {
+	token := ctx.Params("token")
+
+	c, cancel := context.WithTimeout(context.Background(), time.Duration(5)*time.Second)
+	defer cancel()
+
+	res, err := ctrl.client.ConfirmGuestRegistration(c, &pb.ConfirmGuestRegistrationRequest{
+		Token: token,
+	})
+
+	if err != nil {
+		slog.Errorf("Error confirming guest registration: %v", err.Error())
+		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	if res.Confirmed {
+		ctrl.publishTicket(c, int(res.Registration.EventId), res.Registration.Email, res.Ticket)
+	}
+
+	slog.Info("Guest registration confirmed successfully")
+	return ctx.Status(http.StatusOK).JSON(fiber.Map{"message": res.Message, "event_id": res.Registration.EventId, "ticket": res.Ticket})
+}
@@ -0,0 +1,536 @@
+package event
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	pb3 "github.com/catness812/faf-hub-backend/gateway/internal/content/pb"
+	"github.com/catness812/faf-hub-backend/gateway/internal/event/pb"
+	"github.com/catness812/faf-hub-backend/gateway/internal/util"
+	"github.com/catness812/faf-hub-backend/gateway/models"
+	"github.com/gofiber/fiber/v2"
+	"github.com/gookit/slog"
+)
+
+const defaultPublishedWinners = 3
+
+func (ctrl *EventController) SubmitProject(ctx *fiber.Ctx) error {
+	var submission models.Submission
+
+	user_id, err := util.CurrentUserID(ctx)
+	if err != nil {
+		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	if err := ctx.BodyParser(&submission); err != nil {
+		slog.Errorf("Invalid request format: %v", err)
+		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	c, cancel := context.WithTimeout(context.Background(), time.Duration(5)*time.Second)
+	defer cancel()
+
+	res, err := ctrl.client.SubmitProject(c, &pb.SubmitProjectRequest{
+		Submission: &pb.Submission{
+			EventId:     int32(submission.EventID),
+			Title:       submission.Title,
+			Desc:        submission.Description,
+			RepoUrl:     submission.RepoURL,
+			DemoUrl:     submission.DemoURL,
+			SubmittedBy: int32(user_id),
+		},
+	})
+
+	if err != nil {
+		slog.Errorf("Error submitting project: %v", err.Error())
+		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	slog.Info("Project submitted successfully")
+	return ctx.Status(http.StatusOK).JSON(fiber.Map{"message": res.Message, "submission_id": res.SubmissionId})
+}
+
+func (ctrl *EventController) GetEventSubmissions(ctx *fiber.Ctx) error {
+	sid := ctx.Params("id")
+	event_id, err := strconv.Atoi(sid)
+	if err != nil {
+		slog.Errorf("Error converting string to int: %v", err)
+		return err
+	}
+
+	c, cancel := context.WithTimeout(context.Background(), time.Duration(5)*time.Second)
+	defer cancel()
+
+	res, err := ctrl.client.GetEventSubmissions(c, &pb.GetEventSubmissionsRequest{
+		EventId: int32(event_id),
+	})
+
+	if err != nil {
+		slog.Errorf("Error retrieving submissions: %v", err.Error())
+		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	submissions := make([]models.Submission, len(res.Submissions))
+	for i, submission := range res.Submissions {
+		submissions[i] = submissionFromPb(submission)
+	}
+
+	slog.Info("Submissions retrieved successfully")
+	return ctx.Status(http.StatusOK).JSON(submissions)
+}
+
+func (ctrl *EventController) AddJudge(ctx *fiber.Ctx) error {
+	var judge models.Judge
+
+	sid := ctx.Params("id")
+	event_id, err := strconv.Atoi(sid)
+	if err != nil {
+		slog.Errorf("Error converting string to int: %v", err)
+		return err
+	}
+
+	user_id, err := util.CurrentUserID(ctx)
+	if err != nil {
+		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	if err := ctx.BodyParser(&judge); err != nil {
+		slog.Errorf("Invalid request format: %v", err)
+		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	c, cancel := context.WithTimeout(context.Background(), time.Duration(5)*time.Second)
+	defer cancel()
+
+	res, err := ctrl.client.AddJudge(c, &pb.AddJudgeRequest{
+		EventId: int32(event_id),
+		UserId:  int32(judge.UserID),
+		AddedBy: int32(user_id),
+	})
+
+	if err != nil {
+		slog.Errorf("Error adding judge: %v", err.Error())
+		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	slog.Info("Judge added successfully")
+	return ctx.Status(http.StatusOK).JSON(fiber.Map{"message": res.Message})
+}
+
+func (ctrl *EventController) RemoveJudge(ctx *fiber.Ctx) error {
+	sid := ctx.Params("id")
+	event_id, err := strconv.Atoi(sid)
+	if err != nil {
+		slog.Errorf("Error converting string to int: %v", err)
+		return err
+	}
+
+	suid := ctx.Params("user_id")
+	user_id, err := strconv.Atoi(suid)
+	if err != nil {
+		slog.Errorf("Error converting string to int: %v", err)
+		return err
+	}
+
+	c, cancel := context.WithTimeout(context.Background(), time.Duration(5)*time.Second)
+	defer cancel()
+
+	res, err := ctrl.client.RemoveJudge(c, &pb.RemoveJudgeRequest{
+		EventId: int32(event_id),
+		UserId:  int32(user_id),
+	})
+
+	if err != nil {
+		slog.Errorf("Error removing judge: %v", err.Error())
+		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	slog.Info("Judge removed successfully")
+	return ctx.Status(http.StatusOK).JSON(fiber.Map{"message": res.Message})
+}
+
+func (ctrl *EventController) GetEventJudges(ctx *fiber.Ctx) error {
+	sid := ctx.Params("id")
+	event_id, err := strconv.Atoi(sid)
+	if err != nil {
+		slog.Errorf("Error converting string to int: %v", err)
+		return err
+	}
+
+	c, cancel := context.WithTimeout(context.Background(), time.Duration(5)*time.Second)
+	defer cancel()
+
+	res, err := ctrl.client.GetEventJudges(c, &pb.GetEventJudgesRequest{
+		EventId: int32(event_id),
+	})
+
+	if err != nil {
+		slog.Errorf("Error retrieving judges: %v", err.Error())
+		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	judges := make([]models.Judge, len(res.Judges))
+	for i, judge := range res.Judges {
+		judges[i] = models.Judge{
+			EventID: uint(judge.EventId),
+			UserID:  uint(judge.UserId),
+			AddedBy: uint(judge.AddedBy),
+			AddedAt: judge.AddedAt.AsTime(),
+		}
+	}
+
+	slog.Info("Judges retrieved successfully")
+	return ctx.Status(http.StatusOK).JSON(judges)
+}
+
+func (ctrl *EventController) CreateCriterion(ctx *fiber.Ctx) error {
+	var criterion models.Criterion
+
+	sid := ctx.Params("id")
+	event_id, err := strconv.Atoi(sid)
+	if err != nil {
+		slog.Errorf("Error converting string to int: %v", err)
+		return err
+	}
+
+	if err := ctx.BodyParser(&criterion); err != nil {
+		slog.Errorf("Invalid request format: %v", err)
+		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	c, cancel := context.WithTimeout(context.Background(), time.Duration(5)*time.Second)
+	defer cancel()
+
+	criterion.EventID = uint(event_id)
+	res, err := ctrl.client.CreateCriterion(c, &pb.CreateCriterionRequest{
+		Criterion: criterionToPb(criterion),
+	})
+
+	if err != nil {
+		slog.Errorf("Error creating criterion: %v", err.Error())
+		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	slog.Info("Criterion created successfully")
+	return ctx.Status(http.StatusOK).JSON(fiber.Map{"message": res.Message, "criterion_id": res.CriterionId})
+}
+
+func (ctrl *EventController) EditCriterion(ctx *fiber.Ctx) error {
+	var criterion models.Criterion
+
+	sid := ctx.Params("id")
+	event_id, err := strconv.Atoi(sid)
+	if err != nil {
+		slog.Errorf("Error converting string to int: %v", err)
+		return err
+	}
+
+	scid := ctx.Params("criterion_id")
+	criterion_id, err := strconv.Atoi(scid)
+	if err != nil {
+		slog.Errorf("Error converting string to int: %v", err)
+		return err
+	}
+
+	if err := ctx.BodyParser(&criterion); err != nil {
+		slog.Errorf("Invalid request format: %v", err)
+		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	c, cancel := context.WithTimeout(context.Background(), time.Duration(5)*time.Second)
+	defer cancel()
+
+	criterion.ID = uint(criterion_id)
+	criterion.EventID = uint(event_id)
+	res, err := ctrl.client.EditCriterion(c, &pb.EditCriterionRequest{
+		Criterion: criterionToPb(criterion),
+	})
+
+	if err != nil {
+		slog.Errorf("Error updating criterion: %v", err.Error())
+		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	slog.Info("Criterion updated successfully")
+	return ctx.Status(http.StatusOK).JSON(fiber.Map{"message": res.Message})
+}
+
+func (ctrl *EventController) DeleteCriterion(ctx *fiber.Ctx) error {
+	sid := ctx.Params("id")
+	event_id, err := strconv.Atoi(sid)
+	if err != nil {
+		slog.Errorf("Error converting string to int: %v", err)
+		return err
+	}
+
+	scid := ctx.Params("criterion_id")
+	criterion_id, err := strconv.Atoi(scid)
+	if err != nil {
+		slog.Errorf("Error converting string to int: %v", err)
+		return err
+	}
+
+	c, cancel := context.WithTimeout(context.Background(), time.Duration(5)*time.Second)
+	defer cancel()
+
+	res, err := ctrl.client.DeleteCriterion(c, &pb.DeleteCriterionRequest{
+		EventId:     int32(event_id),
+		CriterionId: int32(criterion_id),
+	})
+
+	if err != nil {
+		slog.Errorf("Error deleting criterion: %v", err.Error())
+		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	slog.Info("Criterion deleted successfully")
+	return ctx.Status(http.StatusOK).JSON(fiber.Map{"message": res.Message})
+}
+
+func (ctrl *EventController) GetEventCriteria(ctx *fiber.Ctx) error {
+	sid := ctx.Params("id")
+	event_id, err := strconv.Atoi(sid)
+	if err != nil {
+		slog.Errorf("Error converting string to int: %v", err)
+		return err
+	}
+
+	c, cancel := context.WithTimeout(context.Background(), time.Duration(5)*time.Second)
+	defer cancel()
+
+	res, err := ctrl.client.GetEventCriteria(c, &pb.GetEventCriteriaRequest{
+		EventId: int32(event_id),
+	})
+
+	if err != nil {
+		slog.Errorf("Error retrieving criteria: %v", err.Error())
+		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	criteria := make([]models.Criterion, len(res.Criteria))
+	for i, criterion := range res.Criteria {
+		criteria[i] = models.Criterion{
+			ID:          uint(criterion.CriterionId),
+			EventID:     uint(criterion.EventId),
+			Name:        criterion.Name,
+			Description: criterion.Desc,
+			Weight:      criterion.Weight,
+			MaxScore:    int(criterion.MaxScore),
+		}
+	}
+
+	slog.Info("Criteria retrieved successfully")
+	return ctx.Status(http.StatusOK).JSON(criteria)
+}
+
+func (ctrl *EventController) ScoreSubmission(ctx *fiber.Ctx) error {
+	type ScoreRequest struct {
+		Scores []models.Score `json:"scores"`
+	}
+
+	var req ScoreRequest
+
+	sid := ctx.Params("id")
+	submission_id, err := strconv.Atoi(sid)
+	if err != nil {
+		slog.Errorf("Error converting string to int: %v", err)
+		return err
+	}
+
+	user_id, err := util.CurrentUserID(ctx)
+	if err != nil {
+		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	if err := ctx.BodyParser(&req); err != nil {
+		slog.Errorf("Invalid request format: %v", err)
+		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	scores := make([]*pb.Score, len(req.Scores))
+	for i, score := range req.Scores {
+		scores[i] = &pb.Score{
+			CriterionId: int32(score.CriterionID),
+			Value:       int32(score.Value),
+			Comment:     score.Comment,
+		}
+	}
+
+	c, cancel := context.WithTimeout(context.Background(), time.Duration(5)*time.Second)
+	defer cancel()
+
+	res, err := ctrl.client.ScoreSubmission(c, &pb.ScoreSubmissionRequest{
+		SubmissionId: int32(submission_id),
+		JudgeId:      int32(user_id),
+		Scores:       scores,
+	})
+
+	if err != nil {
+		slog.Errorf("Error scoring submission: %v", err.Error())
+		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	slog.Info("Submission scored successfully")
+	return ctx.Status(http.StatusOK).JSON(fiber.Map{"message": res.Message})
+}
+
+func (ctrl *EventController) GetLeaderboard(ctx *fiber.Ctx) error {
+	sid := ctx.Params("id")
+	event_id, err := strconv.Atoi(sid)
+	if err != nil {
+		slog.Errorf("Error converting string to int: %v", err)
+		return err
+	}
+
+	c, cancel := context.WithTimeout(context.Background(), time.Duration(5)*time.Second)
+	defer cancel()
+
+	res, err := ctrl.client.GetLeaderboard(c, &pb.GetLeaderboardRequest{
+		EventId: int32(event_id),
+	})
+
+	if err != nil {
+		slog.Errorf("Error retrieving leaderboard: %v", err.Error())
+		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	slog.Info("Leaderboard retrieved successfully")
+	return ctx.Status(http.StatusOK).JSON(leaderboardFromPb(res.Entries))
+}
+
+// PublishWinners posts the top ranked submissions of an event to the content
+// provider as project content. Submissions that were already published or
+// have not been scored yet are skipped.
+func (ctrl *EventController) PublishWinners(ctx *fiber.Ctx) error {
+	type PublishRequest struct {
+		Top int `json:"top"`
+	}
+
+	var req PublishRequest
+
+	sid := ctx.Params("id")
+	event_id, err := strconv.Atoi(sid)
+	if err != nil {
+		slog.Errorf("Error converting string to int: %v", err)
+		return err
+	}
+
+	if len(ctx.Body()) > 0 {
+		if err := ctx.BodyParser(&req); err != nil {
+			slog.Errorf("Invalid request format: %v", err)
+			return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+		}
+	}
+	if req.Top <= 0 {
+		req.Top = defaultPublishedWinners
+	}
+
+	c, cancel := context.WithTimeout(context.Background(), time.Duration(10)*time.Second)
+	defer cancel()
+
+	res, err := ctrl.client.GetLeaderboard(c, &pb.GetLeaderboardRequest{
+		EventId: int32(event_id),
+	})
+
+	if err != nil {
+		slog.Errorf("Error retrieving leaderboard: %v", err.Error())
+		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	published := []models.Submission{}
+	for _, entry := range leaderboardFromPb(res.Entries) {
+		if entry.Rank > req.Top {
+			break
+		}
+		if entry.Submission.ContentID != 0 || entry.Judges == 0 {
+			continue
+		}
+
+		contentRes, err := ctrl.contentClient.PostContent(c, &pb3.PostContentRequest{
+			Content: &pb3.Content{
+				Type:    "project",
+				Name:    entry.Submission.Title,
+				Authors: entry.Submission.TeamName,
+				Text:    projectText(entry),
+			},
+		})
+		if err != nil {
+			slog.Errorf("Error publishing submission %d: %v", entry.Submission.ID, err.Error())
+			return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+		}
+
+		_, err = ctrl.client.SetSubmissionContent(c, &pb.SetSubmissionContentRequest{
+			SubmissionId: int32(entry.Submission.ID),
+			ContentId:    contentRes.ContentId,
+		})
+		if err != nil {
+			slog.Errorf("Error linking submission %d to content: %v", entry.Submission.ID, err.Error())
+			// remove the post, so a retry doesn't publish the project twice
+			if _, err := ctrl.contentClient.DeleteContent(c, &pb3.DeleteContentRequest{ContentId: contentRes.ContentId}); err != nil {
+				slog.Errorf("Error deleting unlinked content %d: %v", contentRes.ContentId, err.Error())
+			}
+			return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+		}
+
+		entry.Submission.ContentID = uint(contentRes.ContentId)
+		published = append(published, entry.Submission)
+	}
+
+	slog.Info("Winning projects published successfully")
+	return ctx.Status(http.StatusOK).JSON(fiber.Map{"message": "winning projects published successfully", "published": published})
+}
+
+func projectText(entry models.LeaderboardEntry) string {
+	lines := []string{entry.Submission.Description}
+	if entry.Submission.RepoURL != "" {
+		lines = append(lines, "Repository: "+entry.Submission.RepoURL)
+	}
+	if entry.Submission.DemoURL != "" {
+		lines = append(lines, "Demo: "+entry.Submission.DemoURL)
+	}
+	lines = append(lines, "Placed #"+strconv.Itoa(entry.Rank)+" with a score of "+strconv.FormatFloat(entry.Score, 'f', 2, 64)+".")
+	return strings.Join(lines, "\n\n")
+}
+
+func criterionToPb(criterion models.Criterion) *pb.Criterion {
+	return &pb.Criterion{
+		CriterionId: int32(criterion.ID),
+		EventId:     int32(criterion.EventID),
+		Name:        criterion.Name,
+		Desc:        criterion.Description,
+		Weight:      criterion.Weight,
+		MaxScore:    int32(criterion.MaxScore),
+	}
+}
+
+func submissionFromPb(submission *pb.Submission) models.Submission {
+	return models.Submission{
+		ID:          uint(submission.SubmissionId),
+		EventID:     uint(submission.EventId),
+		TeamID:      uint(submission.TeamId),
+		TeamName:    submission.TeamName,
+		Title:       submission.Title,
+		Description: submission.Desc,
+		RepoURL:     submission.RepoUrl,
+		DemoURL:     submission.DemoUrl,
+		SubmittedBy: uint(submission.SubmittedBy),
+		SubmittedAt: submission.SubmittedAt.AsTime(),
+		ContentID:   uint(submission.ContentId),
+	}
+}
+
+func leaderboardFromPb(entries []*pb.LeaderboardEntry) []models.LeaderboardEntry {
+	leaderboard := make([]models.LeaderboardEntry, len(entries))
+	for i, entry := range entries {
+		leaderboard[i] = models.LeaderboardEntry{
+			Rank:       int(entry.Rank),
+			Submission: submissionFromPb(entry.Submission),
+			Score:      entry.Score,
+			Judges:     int(entry.Judges),
+		}
+	}
+	return leaderboard
+}
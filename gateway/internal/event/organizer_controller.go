@@ -0,0 +1,151 @@
+package event
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/catness812/faf-hub-backend/gateway/internal/event/pb"
+	"github.com/catness812/faf-hub-backend/gateway/internal/util"
+	"github.com/catness812/faf-hub-backend/gateway/models"
+	"github.com/gofiber/fiber/v2"
+	"github.com/gookit/slog"
+)
+
+func (ctrl *EventController) AddEventOrganizer(ctx *fiber.Ctx) error {
+	type Organizer struct {
+		UserID uint `json:"user_id"`
+	}
+
+	var organizer Organizer
+
+	sid := ctx.Params("id")
+	event_id, err := strconv.Atoi(sid)
+	if err != nil {
+		slog.Errorf("Error converting string to int: %v", err)
+		return err
+	}
+
+	if err := ctx.BodyParser(&organizer); err != nil {
+		slog.Errorf("Invalid request format: %v", err)
+		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	user_id, err := util.CurrentUserID(ctx)
+	if err != nil {
+		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	c, cancel := context.WithTimeout(context.Background(), time.Duration(5)*time.Second)
+	defer cancel()
+
+	res, err := ctrl.client.AddEventOrganizer(c, &pb.AddEventOrganizerRequest{
+		EventId: int32(event_id),
+		UserId:  int32(organizer.UserID),
+		AddedBy: int32(user_id),
+	})
+
+	if err != nil {
+		slog.Errorf("Error adding event organizer: %v", err.Error())
+		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	slog.Info("Event organizer added successfully")
+	return ctx.Status(http.StatusOK).JSON(fiber.Map{"message": res.Message})
+}
+
+func (ctrl *EventController) RemoveEventOrganizer(ctx *fiber.Ctx) error {
+	sid := ctx.Params("id")
+	event_id, err := strconv.Atoi(sid)
+	if err != nil {
+		slog.Errorf("Error converting string to int: %v", err)
+		return err
+	}
+
+	suid := ctx.Params("user_id")
+	user_id, err := strconv.Atoi(suid)
+	if err != nil {
+		slog.Errorf("Error converting string to int: %v", err)
+		return err
+	}
+
+	c, cancel := context.WithTimeout(context.Background(), time.Duration(5)*time.Second)
+	defer cancel()
+
+	res, err := ctrl.client.RemoveEventOrganizer(c, &pb.RemoveEventOrganizerRequest{
+		EventId: int32(event_id),
+		UserId:  int32(user_id),
+	})
+
+	if err != nil {
+		slog.Errorf("Error removing event organizer: %v", err.Error())
+		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	slog.Info("Event organizer removed successfully")
+	return ctx.Status(http.StatusOK).JSON(fiber.Map{"message": res.Message})
+}
+
+func (ctrl *EventController) GetEventOrganizers(ctx *fiber.Ctx) error {
+	sid := ctx.Params("id")
+	event_id, err := strconv.Atoi(sid)
+	if err != nil {
+		slog.Errorf("Error converting string to int: %v", err)
+		return err
+	}
+
+	c, cancel := context.WithTimeout(context.Background(), time.Duration(5)*time.Second)
+	defer cancel()
+
+	res, err := ctrl.client.GetEventOrganizers(c, &pb.GetEventOrganizersRequest{
+		EventId: int32(event_id),
+	})
+
+	if err != nil {
+		slog.Errorf("Error retrieving event organizers: %v", err.Error())
+		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	organizers := make([]models.EventOrganizer, len(res.Organizers))
+
+	for i, organizer := range res.Organizers {
+		organizers[i] = models.EventOrganizer{
+			EventID: uint(organizer.EventId),
+			UserID:  uint(organizer.UserId),
+			AddedBy: uint(organizer.AddedBy),
+			AddedAt: organizer.AddedAt.AsTime(),
+		}
+	}
+
+	slog.Info("Event organizers retrieved successfully")
+	return ctx.Status(http.StatusOK).JSON(fiber.Map{"organizers": organizers})
+}
+
+func (ctrl *EventController) GetOrganizedEvents(ctx *fiber.Ctx) error {
+	user_id, err := util.CurrentUserID(ctx)
+	if err != nil {
+		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	c, cancel := context.WithTimeout(context.Background(), time.Duration(5)*time.Second)
+	defer cancel()
+
+	res, err := ctrl.client.GetOrganizedEvents(c, &pb.GetOrganizedEventsRequest{
+		UserId: int32(user_id),
+	})
+
+	if err != nil {
+		slog.Errorf("Error retrieving organized events: %v", err.Error())
+		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	events := make([]models.Event, len(res.Events))
+
+	for i := range events {
+		events[i] = eventFromPb(res.Events[i])
+	}
+
+	slog.Info("Organized events retrieved successfully")
+	return ctx.Status(http.StatusOK).JSON(fiber.Map{"events": events})
+}
@@ -0,0 +1,61 @@
+package event
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/catness812/faf-hub-backend/gateway/internal/event/pb"
+	"github.com/catness812/faf-hub-backend/gateway/models"
+	"github.com/gofiber/fiber/v2"
+	"github.com/gookit/slog"
+)
+
+func (ctrl *EventController) GetEventReport(ctx *fiber.Ctx) error {
+	sid := ctx.Params("id")
+	event_id, err := strconv.Atoi(sid)
+	if err != nil {
+		slog.Errorf("Error converting string to int: %v", err)
+		return err
+	}
+
+	c, cancel := context.WithTimeout(context.Background(), time.Duration(5)*time.Second)
+	defer cancel()
+
+	res, err := ctrl.client.GetEventReport(c, &pb.GetEventReportRequest{
+		EventId: int32(event_id),
+	})
+
+	if err != nil {
+		slog.Errorf("Error retrieving event report: %v", err.Error())
+		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	report := models.EventReport{
+		EventID:          uint(res.EventId),
+		Registrations:    res.Registrations,
+		ByStatus:         countsFromPb(res.ByStatus),
+		ShirtSizes:       countsFromPb(res.ShirtSizes),
+		FoodPreferences:  countsFromPb(res.FoodPreferences),
+		AcademicGroups:   countsFromPb(res.AcademicGroups),
+		Teams:            res.Teams,
+		CompleteTeams:    res.CompleteTeams,
+		TeamMembers:      res.TeamMembers,
+		SoloParticipants: res.SoloParticipants,
+	}
+
+	slog.Info("Event report retrieved successfully")
+	return ctx.Status(http.StatusOK).JSON(fiber.Map{"report": report})
+}
+
+func countsFromPb(counts []*pb.Count) []models.Count {
+	res := make([]models.Count, len(counts))
+	for i := range res {
+		res[i] = models.Count{
+			Value: counts[i].Value,
+			Count: counts[i].Count,
+		}
+	}
+	return res
+}
@@ -9,14 +9,103 @@ import (
 func RegisterEventRoutes(r *fiber.App, eventCtrl *EventController, eventClient pb.EventServiceClient) {
 	route := r.Group("/event")
 	route.Post("/create", middleware.JWTAuth(), middleware.CheckAdmin(), middleware.CheckIfVerified(), eventCtrl.CreateEvent)
-	route.Post("/edit", middleware.JWTAuth(), middleware.CheckAdmin(), middleware.CheckIfVerified(), eventCtrl.EditEvent)
+	route.Post("/edit", middleware.JWTAuth(), middleware.CheckIfVerified(), middleware.CheckEventOrganizer(eventClient, middleware.EventIDFromBody), eventCtrl.EditEvent)
 	route.Delete("/delete/:id", middleware.JWTAuth(), middleware.CheckAdmin(), middleware.CheckIfVerified(), eventCtrl.DeleteEvent)
 	route.Get("/all", eventCtrl.GetAllEvents)
+	route.Get("/search", eventCtrl.SearchEvents)
+	route.Get("/recommended", middleware.JWTAuth(), middleware.CheckIfUser(), eventCtrl.GetRecommendedEvents)
+	route.Get("/organized", middleware.JWTAuth(), eventCtrl.GetOrganizedEvents)
 	route.Get("/registered", middleware.JWTAuth(), middleware.CheckIfUser(), eventCtrl.GetUserEvents)
+	route.Get("/calendar/feed", middleware.JWTAuth(), middleware.CheckIfUser(), eventCtrl.GetCalendarFeedURL)
+	route.Post("/series/create", middleware.JWTAuth(), middleware.CheckAdmin(), middleware.CheckIfVerified(), eventCtrl.CreateEventSeries)
+	route.Get("/series/:id", eventCtrl.GetEventSeries)
+	route.Get("/series/:id/attendance", middleware.JWTAuth(), middleware.CheckAdmin(), middleware.CheckIfVerified(), eventCtrl.GetSeriesAttendance)
+	route.Post("/series/:id/register", middleware.JWTAuth(), middleware.CheckIfUser(), middleware.CheckIfVerified(), eventCtrl.RegisterForSeries)
+	route.Delete("/series/occurrence/:id", middleware.JWTAuth(), middleware.CheckAdmin(), middleware.CheckIfVerified(), eventCtrl.CancelOccurrence)
+	route.Post("/template/create", middleware.JWTAuth(), middleware.CheckAdmin(), middleware.CheckIfVerified(), eventCtrl.CreateEventTemplate)
+	route.Get("/templates", middleware.JWTAuth(), middleware.CheckAdmin(), middleware.CheckIfVerified(), eventCtrl.GetEventTemplates)
+	route.Delete("/template/delete/:id", middleware.JWTAuth(), middleware.CheckAdmin(), middleware.CheckIfVerified(), eventCtrl.DeleteEventTemplate)
+	route.Post("/template/:id/use", middleware.JWTAuth(), middleware.CheckAdmin(), middleware.CheckIfVerified(), eventCtrl.CreateEventFromTemplate)
+	route.Post("/venue/create", middleware.JWTAuth(), middleware.CheckAdmin(), middleware.CheckIfVerified(), eventCtrl.CreateVenue)
+	route.Get("/venues", eventCtrl.GetVenues)
+	route.Delete("/venue/delete/:id", middleware.JWTAuth(), middleware.CheckAdmin(), middleware.CheckIfVerified(), eventCtrl.DeleteVenue)
+	route.Post("/room/create", middleware.JWTAuth(), middleware.CheckAdmin(), middleware.CheckIfVerified(), eventCtrl.CreateRoom)
+	route.Post("/room/edit", middleware.JWTAuth(), middleware.CheckAdmin(), middleware.CheckIfVerified(), eventCtrl.EditRoom)
+	route.Delete("/room/delete/:id", middleware.JWTAuth(), middleware.CheckAdmin(), middleware.CheckIfVerified(), eventCtrl.DeleteRoom)
+	route.Get("/room/:id/availability", eventCtrl.GetRoomAvailability)
+	route.Post("/speaker/create", middleware.JWTAuth(), middleware.CheckAdmin(), middleware.CheckIfVerified(), eventCtrl.CreateSpeaker)
+	route.Post("/speaker/edit", middleware.JWTAuth(), middleware.CheckAdmin(), middleware.CheckIfVerified(), eventCtrl.EditSpeaker)
+	route.Delete("/speaker/delete/:id", middleware.JWTAuth(), middleware.CheckAdmin(), middleware.CheckIfVerified(), eventCtrl.DeleteSpeaker)
+	route.Post("/session/create", middleware.JWTAuth(), middleware.CheckAdmin(), middleware.CheckIfVerified(), eventCtrl.CreateSession)
+	route.Post("/session/edit", middleware.JWTAuth(), middleware.CheckAdmin(), middleware.CheckIfVerified(), eventCtrl.EditSession)
+	route.Delete("/session/delete/:id", middleware.JWTAuth(), middleware.CheckAdmin(), middleware.CheckIfVerified(), eventCtrl.DeleteSession)
+	route.Post("/session/:id/bookmark", middleware.JWTAuth(), middleware.CheckIfUser(), eventCtrl.BookmarkSession)
+	route.Delete("/session/:id/bookmark", middleware.JWTAuth(), middleware.CheckIfUser(), eventCtrl.RemoveBookmark)
+	route.Post("/volunteer/shifts/:id/signup", middleware.JWTAuth(), middleware.CheckIfUser(), middleware.CheckIfVerified(), eventCtrl.SignUpForShift)
+	route.Delete("/volunteer/shifts/:id/signup", middleware.JWTAuth(), middleware.CheckIfUser(), eventCtrl.CancelShiftSignup)
+	route.Post("/guest/register/:id", eventCtrl.RegisterGuest)
+	route.Get("/guest/confirm/:token", eventCtrl.ConfirmGuestRegistration)
+	route.Post("/submission", middleware.JWTAuth(), middleware.CheckIfUser(), middleware.CheckIfVerified(), eventCtrl.SubmitProject)
+	route.Post("/submission/:id/score", middleware.JWTAuth(), middleware.CheckIfVerified(), eventCtrl.ScoreSubmission)
+	route.Post("/team/create", middleware.JWTAuth(), middleware.CheckIfUser(), middleware.CheckIfVerified(), eventCtrl.CreateTeam)
+	route.Post("/team/invite", middleware.JWTAuth(), middleware.CheckIfUser(), middleware.CheckIfVerified(), eventCtrl.InviteTeamMember)
+	route.Post("/team/respond", middleware.JWTAuth(), middleware.CheckIfUser(), eventCtrl.RespondTeamInvite)
+	route.Post("/team/transfer", middleware.JWTAuth(), middleware.CheckIfUser(), eventCtrl.TransferTeamCaptain)
+	route.Delete("/team/disband/:id", middleware.JWTAuth(), middleware.CheckIfUser(), eventCtrl.DisbandTeam)
+	route.Get("/team/invites", middleware.JWTAuth(), middleware.CheckIfUser(), eventCtrl.GetUserTeamInvites)
+	route.Post("/team/matching", middleware.JWTAuth(), middleware.CheckIfUser(), middleware.CheckIfVerified(), eventCtrl.OptInTeamMatching)
+	route.Delete("/team/matching/:id", middleware.JWTAuth(), middleware.CheckIfUser(), eventCtrl.OptOutTeamMatching)
+	route.Post("/teams/:id/match", middleware.JWTAuth(), middleware.CheckAdmin(), middleware.CheckIfVerified(), eventCtrl.MatchTeams)
+	route.Get("/teams/:id", middleware.JWTAuth(), middleware.CheckAdmin(), middleware.CheckIfVerified(), eventCtrl.GetEventTeams)
+	route.Get("/:id/ics", eventCtrl.GetEventCalendar)
+	route.Post("/:id/cancel", middleware.JWTAuth(), middleware.CheckIfVerified(), middleware.CheckEventOrganizer(eventClient, middleware.EventIDFromParams), eventCtrl.CancelEvent)
+	route.Post("/:id/guests", middleware.JWTAuth(), middleware.CheckIfVerified(), middleware.CheckEventOrganizer(eventClient, middleware.EventIDFromParams), eventCtrl.SetGuestRegistration)
+	route.Post("/:id/duplicate", middleware.JWTAuth(), middleware.CheckAdmin(), middleware.CheckIfVerified(), eventCtrl.DuplicateEvent)
+	route.Get("/:id/ticket", middleware.JWTAuth(), middleware.CheckIfUser(), eventCtrl.GetTicket)
+	route.Post("/:id/check-in", middleware.JWTAuth(), middleware.CheckIfVerified(), middleware.CheckEventOrganizer(eventClient, middleware.EventIDFromParams), eventCtrl.CheckIn)
+	route.Get("/:id/certificate", middleware.JWTAuth(), middleware.CheckIfUser(), eventCtrl.GetUserCertificate)
+	route.Post("/:id/certificates/send", middleware.JWTAuth(), middleware.CheckAdmin(), middleware.CheckIfVerified(), eventCtrl.SendCertificates)
+	route.Get("/:id/feedback", middleware.JWTAuth(), middleware.CheckAdmin(), middleware.CheckIfVerified(), eventCtrl.GetEventFeedback)
+	route.Get("/:id/report", middleware.JWTAuth(), middleware.CheckAdmin(), middleware.CheckIfVerified(), eventCtrl.GetEventReport)
+	route.Get("/:id/agenda", eventCtrl.GetEventAgenda)
+	route.Get("/:id/agenda/my", middleware.JWTAuth(), middleware.CheckIfUser(), eventCtrl.GetUserAgenda)
+	route.Get("/:id/organizers", middleware.JWTAuth(), middleware.CheckIfVerified(), middleware.CheckEventOrganizer(eventClient, middleware.EventIDFromParams), eventCtrl.GetEventOrganizers)
+	route.Post("/:id/organizers", middleware.JWTAuth(), middleware.CheckAdmin(), middleware.CheckIfVerified(), eventCtrl.AddEventOrganizer)
+	route.Delete("/:id/organizers/:user_id", middleware.JWTAuth(), middleware.CheckAdmin(), middleware.CheckIfVerified(), eventCtrl.RemoveEventOrganizer)
+	route.Get("/:id/volunteer/shifts", eventCtrl.GetVolunteerShifts)
+	route.Get("/:id/volunteer/roster", middleware.JWTAuth(), middleware.CheckIfVerified(), middleware.CheckEventOrganizer(eventClient, middleware.EventIDFromParams), eventCtrl.GetVolunteerRoster)
+	route.Post("/:id/volunteer/roles", middleware.JWTAuth(), middleware.CheckIfVerified(), middleware.CheckEventOrganizer(eventClient, middleware.EventIDFromParams), eventCtrl.CreateVolunteerRole)
+	route.Delete("/:id/volunteer/roles/:role_id", middleware.JWTAuth(), middleware.CheckIfVerified(), middleware.CheckEventOrganizer(eventClient, middleware.EventIDFromParams), eventCtrl.DeleteVolunteerRole)
+	route.Post("/:id/volunteer/shifts", middleware.JWTAuth(), middleware.CheckIfVerified(), middleware.CheckEventOrganizer(eventClient, middleware.EventIDFromParams), eventCtrl.CreateVolunteerShift)
+	route.Post("/:id/volunteer/shifts/:shift_id", middleware.JWTAuth(), middleware.CheckIfVerified(), middleware.CheckEventOrganizer(eventClient, middleware.EventIDFromParams), eventCtrl.EditVolunteerShift)
+	route.Delete("/:id/volunteer/shifts/:shift_id", middleware.JWTAuth(), middleware.CheckIfVerified(), middleware.CheckEventOrganizer(eventClient, middleware.EventIDFromParams), eventCtrl.DeleteVolunteerShift)
+	route.Get("/:id/submissions", eventCtrl.GetEventSubmissions)
+	route.Get("/:id/judges", middleware.JWTAuth(), middleware.CheckIfVerified(), middleware.CheckEventOrganizer(eventClient, middleware.EventIDFromParams), eventCtrl.GetEventJudges)
+	route.Post("/:id/judges", middleware.JWTAuth(), middleware.CheckIfVerified(), middleware.CheckEventOrganizer(eventClient, middleware.EventIDFromParams), eventCtrl.AddJudge)
+	route.Delete("/:id/judges/:user_id", middleware.JWTAuth(), middleware.CheckIfVerified(), middleware.CheckEventOrganizer(eventClient, middleware.EventIDFromParams), eventCtrl.RemoveJudge)
+	route.Get("/:id/criteria", eventCtrl.GetEventCriteria)
+	route.Post("/:id/criteria", middleware.JWTAuth(), middleware.CheckIfVerified(), middleware.CheckEventOrganizer(eventClient, middleware.EventIDFromParams), eventCtrl.CreateCriterion)
+	route.Post("/:id/criteria/:criterion_id", middleware.JWTAuth(), middleware.CheckIfVerified(), middleware.CheckEventOrganizer(eventClient, middleware.EventIDFromParams), eventCtrl.EditCriterion)
+	route.Delete("/:id/criteria/:criterion_id", middleware.JWTAuth(), middleware.CheckIfVerified(), middleware.CheckEventOrganizer(eventClient, middleware.EventIDFromParams), eventCtrl.DeleteCriterion)
+	route.Get("/:id/leaderboard", middleware.JWTAuth(), middleware.CheckIfVerified(), middleware.CheckEventOrganizer(eventClient, middleware.EventIDFromParams), eventCtrl.GetLeaderboard)
+	route.Post("/:id/leaderboard/publish", middleware.JWTAuth(), middleware.CheckAdmin(), middleware.CheckIfVerified(), eventCtrl.PublishWinners)
+	route.Get("/:id/team", middleware.JWTAuth(), middleware.CheckIfUser(), eventCtrl.GetUserTeam)
 	route.Get("/:id/registration", middleware.JWTAuth(), middleware.CheckIfUser(), eventCtrl.GetUserEventRegistration)
 	route.Get("/:id", eventCtrl.GetEvent)
 	route.Post("/register/:id", middleware.JWTAuth(), middleware.CheckIfUser(), middleware.CheckIfVerified(), eventCtrl.RegisterForEvent)
-	route.Get("/registrations/:id", middleware.JWTAuth(), middleware.CheckAdmin(), middleware.CheckIfVerified(), eventCtrl.GetEventRegistrations)
+	route.Get("/registrations/:id", middleware.JWTAuth(), middleware.CheckIfVerified(), middleware.CheckEventOrganizer(eventClient, middleware.EventIDFromParams), eventCtrl.GetEventRegistrations)
+	route.Get("/registrations/:id/export", middleware.JWTAuth(), middleware.CheckIfVerified(), middleware.CheckEventOrganizer(eventClient, middleware.EventIDFromParams), eventCtrl.ExportEventRegistrations)
 	route.Post("/registration/edit", middleware.JWTAuth(), middleware.CheckIfUser(), eventCtrl.EditRegistration)
 	route.Post("/feedback", middleware.JWTAuth(), middleware.CheckIfUser(), eventCtrl.LeaveFeedback)
+
+	certificateRoute := r.Group("/certificates")
+	certificateRoute.Get("/:code/verify", eventCtrl.VerifyCertificate)
+
+	analyticsRoute := r.Group("/analytics", middleware.JWTAuth(), middleware.CheckAdmin(), middleware.CheckIfVerified())
+	analyticsRoute.Get("/overview", eventCtrl.GetAnalyticsOverview)
+	analyticsRoute.Get("/events/:id", eventCtrl.GetEventAnalytics)
+
+	r.Get("/calendar.ics", eventCtrl.GetCalendar)
+	calendarRoute := r.Group("/calendar")
+	calendarRoute.Get("/:token", eventCtrl.GetUserCalendar)
 }
@@ -0,0 +1,53 @@
+package event
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/catness812/faf-hub-backend/gateway/internal/event/pb"
+	"github.com/catness812/faf-hub-backend/gateway/models"
+	"github.com/gofiber/fiber/v2"
+	"github.com/gookit/slog"
+)
+
+func (ctrl *EventController) SearchEvents(ctx *fiber.Ctx) error {
+	c, cancel := context.WithTimeout(context.Background(), time.Duration(5)*time.Second)
+	defer cancel()
+
+	res, err := ctrl.client.SearchEvents(c, &pb.SearchEventsRequest{
+		Query:    ctx.Query("q"),
+		Language: ctx.Query("lang"),
+		Page:     int32(ctx.QueryInt("page", 1)),
+		PageSize: int32(ctx.QueryInt("page_size", 0)),
+	})
+
+	if err != nil {
+		slog.Errorf("Error searching events: %v", err.Error())
+		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	results := make([]models.EventSearchResult, len(res.Results))
+	for i, result := range res.Results {
+		results[i] = models.EventSearchResult{
+			Event:         eventFromPb(result.Event),
+			Rank:          result.Rank,
+			NameHighlight: result.NameHighlight,
+			DescHighlight: result.DescHighlight,
+		}
+	}
+
+	totalPages := (res.Total + int64(res.PageSize) - 1) / int64(res.PageSize)
+
+	slog.Info("Events searched successfully")
+	return ctx.Status(http.StatusOK).JSON(fiber.Map{
+		"results":  results,
+		"language": res.Language,
+		"pagination": fiber.Map{
+			"page":        res.Page,
+			"page_size":   res.PageSize,
+			"total":       res.Total,
+			"total_pages": totalPages,
+		},
+	})
+}
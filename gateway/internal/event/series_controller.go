@@ -0,0 +1,243 @@
+package event
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/catness812/faf-hub-backend/gateway/internal/event/pb"
+	"github.com/catness812/faf-hub-backend/gateway/internal/media"
+	"github.com/catness812/faf-hub-backend/gateway/internal/util"
+	"github.com/catness812/faf-hub-backend/gateway/models"
+	"github.com/gofiber/fiber/v2"
+	"github.com/gookit/slog"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (ctrl *EventController) CreateEventSeries(ctx *fiber.Ctx) error {
+	type Series struct {
+		models.EventSeries
+		Start time.Time `json:"start"`
+		End   time.Time `json:"end"`
+	}
+
+	var series Series
+
+	if err := ctx.BodyParser(&series); err != nil {
+		slog.Errorf("Invalid request format: %v", err)
+		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	if err := media.ApplyCover(ctrl.mediaLibrary, series.CoverMediaID, &series.Cover); err != nil {
+		slog.Errorf("Error resolving cover media: %v", err.Error())
+		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	c, cancel := context.WithTimeout(context.Background(), time.Duration(5)*time.Second)
+	defer cancel()
+
+	res, err := ctrl.client.CreateEventSeries(c, &pb.CreateEventSeriesRequest{
+		Series: &pb.EventSeries{
+			Name:         series.Name,
+			Desc:         series.Description,
+			Location:     series.Location,
+			Cover:        series.Cover,
+			CoverMediaId: series.CoverMediaID,
+			Timezone:     series.Timezone,
+			Rrule:        series.RRule,
+		},
+		Start: timestamppb.New(series.Start),
+		End:   timestamppb.New(series.End),
+	})
+
+	if err != nil {
+		slog.Errorf("Error creating event series: %v", err.Error())
+		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	slog.Info("Event series created successfully")
+	return ctx.Status(http.StatusOK).JSON(fiber.Map{"message": res.Message, "series_id": res.SeriesId, "occurrences": res.Occurrences})
+}
+
+func (ctrl *EventController) GetEventSeries(ctx *fiber.Ctx) error {
+	sid := ctx.Params("id")
+	series_id, err := strconv.Atoi(sid)
+	if err != nil {
+		slog.Errorf("Error converting string to int: %v", err)
+		return err
+	}
+
+	c, cancel := context.WithTimeout(context.Background(), time.Duration(5)*time.Second)
+	defer cancel()
+
+	res, err := ctrl.client.GetEventSeries(c, &pb.GetEventSeriesRequest{
+		SeriesId: int32(series_id),
+	})
+
+	if err != nil {
+		slog.Errorf("Error retrieving event series: %v", err.Error())
+		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	series := models.EventSeries{
+		ID:           uint(res.Series.SeriesId),
+		Name:         res.Series.Name,
+		Description:  res.Series.Desc,
+		Location:     res.Series.Location,
+		Cover:        res.Series.Cover,
+		CoverMediaID: res.Series.CoverMediaId,
+		Timezone:     res.Series.Timezone,
+		RRule:        res.Series.Rrule,
+		Occurrences:  make([]models.Event, len(res.Series.Occurrences)),
+	}
+
+	for i := range series.Occurrences {
+		series.Occurrences[i] = eventFromPb(res.Series.Occurrences[i])
+	}
+
+	slog.Info("Event series retrieved successfully")
+	return ctx.Status(http.StatusOK).JSON(fiber.Map{"series": series})
+}
+
+func (ctrl *EventController) CancelOccurrence(ctx *fiber.Ctx) error {
+	type Cancellation struct {
+		Reason string `json:"reason"`
+	}
+
+	var cancellation Cancellation
+
+	sid := ctx.Params("id")
+	event_id, err := strconv.Atoi(sid)
+	if err != nil {
+		slog.Errorf("Error converting string to int: %v", err)
+		return err
+	}
+
+	if len(ctx.Body()) > 0 {
+		if err := ctx.BodyParser(&cancellation); err != nil {
+			slog.Errorf("Invalid request format: %v", err)
+			return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+		}
+	}
+
+	c, cancel := context.WithTimeout(context.Background(), time.Duration(5)*time.Second)
+	defer cancel()
+
+	res, err := ctrl.client.CancelOccurrence(c, &pb.CancelOccurrenceRequest{
+		EventId: int32(event_id),
+		Reason:  cancellation.Reason,
+	})
+
+	if err != nil {
+		slog.Errorf("Error cancelling occurrence: %v", err.Error())
+		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	slog.Info("Occurrence cancelled successfully")
+	return ctx.Status(http.StatusOK).JSON(fiber.Map{"message": res.Message, "notified": res.Notified})
+}
+
+func (ctrl *EventController) RegisterForSeries(ctx *fiber.Ctx) error {
+	type SeriesRegistration struct {
+		models.Registration
+		AllOccurrences bool   `json:"all_occurrences"`
+		EventIDs       []uint `json:"event_ids"`
+	}
+
+	var reg SeriesRegistration
+
+	sid := ctx.Params("id")
+	series_id, err := strconv.Atoi(sid)
+	if err != nil {
+		slog.Errorf("Error converting string to int: %v", err)
+		return err
+	}
+
+	user_id, err := util.CurrentUserID(ctx)
+	if err != nil {
+		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	if err := ctx.BodyParser(&reg); err != nil {
+		slog.Errorf("Invalid request format: %v", err)
+		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	eventIDs := make([]int32, len(reg.EventIDs))
+	for i, id := range reg.EventIDs {
+		eventIDs[i] = int32(id)
+	}
+
+	c, cancel := context.WithTimeout(context.Background(), time.Duration(5)*time.Second)
+	defer cancel()
+
+	res, err := ctrl.client.RegisterForSeries(c, &pb.RegisterForSeriesRequest{
+		SeriesId: int32(series_id),
+		Registration: &pb.EventRegistration{
+			UserId:        int32(user_id),
+			FirstName:     reg.FirstName,
+			LastName:      reg.LastName,
+			Email:         reg.Email,
+			PhoneNumber:   int32(reg.PhoneNumber),
+			AcademicGroup: reg.AcademicGroup,
+			TeamMembers:   reg.TeamMembers,
+			ShirtSize:     reg.ShirtSize,
+			FoodPref:      reg.FoodPreferences,
+			Motivation:    reg.Motivation,
+			Questions:     reg.Questions,
+		},
+		AllOccurrences: reg.AllOccurrences,
+		EventIds:       eventIDs,
+	})
+
+	if err != nil {
+		slog.Errorf("Error registering for event series: %v", err.Error())
+		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	for _, ticket := range res.Tickets {
+		tc, tcancel := context.WithTimeout(context.Background(), time.Duration(5)*time.Second)
+		ctrl.publishTicket(tc, int(ticket.EventId), reg.Email, ticket.Ticket)
+		tcancel()
+	}
+
+	slog.Info("Event series registration completed successfully")
+	return ctx.Status(http.StatusOK).JSON(fiber.Map{"message": res.Message, "registered": res.Registered})
+}
+
+func (ctrl *EventController) GetSeriesAttendance(ctx *fiber.Ctx) error {
+	sid := ctx.Params("id")
+	series_id, err := strconv.Atoi(sid)
+	if err != nil {
+		slog.Errorf("Error converting string to int: %v", err)
+		return err
+	}
+
+	c, cancel := context.WithTimeout(context.Background(), time.Duration(5)*time.Second)
+	defer cancel()
+
+	res, err := ctrl.client.GetSeriesAttendance(c, &pb.GetSeriesAttendanceRequest{
+		SeriesId: int32(series_id),
+	})
+
+	if err != nil {
+		slog.Errorf("Error retrieving series attendance: %v", err.Error())
+		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	attendance := make([]models.OccurrenceAttendance, len(res.Occurrences))
+
+	for i, a := range res.Occurrences {
+		attendance[i] = models.OccurrenceAttendance{
+			EventID:       uint(a.EventId),
+			StartDateTime: a.Start.AsTime(),
+			CancelledAt:   timeOrNil(a.CancelledAt),
+			Registered:    a.Registered,
+			Attended:      a.Attended,
+		}
+	}
+
+	slog.Info("Series attendance retrieved successfully")
+	return ctx.Status(http.StatusOK).JSON(fiber.Map{"occurrences": attendance})
+}
@@ -0,0 +1,405 @@
+package event
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/catness812/faf-hub-backend/gateway/internal/event/pb"
+	"github.com/catness812/faf-hub-backend/gateway/internal/util"
+	"github.com/catness812/faf-hub-backend/gateway/models"
+	"github.com/gofiber/fiber/v2"
+	"github.com/gookit/slog"
+)
+
+func (ctrl *EventController) CreateTeam(ctx *fiber.Ctx) error {
+	type NewTeam struct {
+		EventID uint   `json:"event_id"`
+		Name    string `json:"name"`
+	}
+
+	var team NewTeam
+
+	if err := ctx.BodyParser(&team); err != nil {
+		slog.Errorf("Invalid request format: %v", err)
+		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	user_id, err := util.CurrentUserID(ctx)
+	if err != nil {
+		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	c, cancel := context.WithTimeout(context.Background(), time.Duration(5)*time.Second)
+	defer cancel()
+
+	res, err := ctrl.client.CreateTeam(c, &pb.CreateTeamRequest{
+		EventId: int32(team.EventID),
+		UserId:  int32(user_id),
+		Name:    team.Name,
+	})
+
+	if err != nil {
+		slog.Errorf("Error creating team: %v", err.Error())
+		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	slog.Info("Team created successfully")
+	return ctx.Status(http.StatusOK).JSON(fiber.Map{"message": res.Message, "team_id": res.TeamId})
+}
+
+func (ctrl *EventController) InviteTeamMember(ctx *fiber.Ctx) error {
+	type Invite struct {
+		TeamID uint   `json:"team_id"`
+		UserID uint   `json:"user_id"`
+		Email  string `json:"email"`
+	}
+
+	var invite Invite
+
+	if err := ctx.BodyParser(&invite); err != nil {
+		slog.Errorf("Invalid request format: %v", err)
+		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	user_id, err := util.CurrentUserID(ctx)
+	if err != nil {
+		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	c, cancel := context.WithTimeout(context.Background(), time.Duration(5)*time.Second)
+	defer cancel()
+
+	res, err := ctrl.client.InviteTeamMember(c, &pb.InviteTeamMemberRequest{
+		TeamId:       int32(invite.TeamID),
+		UserId:       int32(user_id),
+		InviteeId:    int32(invite.UserID),
+		InviteeEmail: invite.Email,
+	})
+
+	if err != nil {
+		slog.Errorf("Error inviting team member: %v", err.Error())
+		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	slog.Info("Team member invited successfully")
+	return ctx.Status(http.StatusOK).JSON(fiber.Map{"message": res.Message})
+}
+
+func (ctrl *EventController) RespondTeamInvite(ctx *fiber.Ctx) error {
+	type Response struct {
+		MemberID uint `json:"member_id"`
+		Accept   bool `json:"accept"`
+	}
+
+	var response Response
+
+	if err := ctx.BodyParser(&response); err != nil {
+		slog.Errorf("Invalid request format: %v", err)
+		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	user_id, err := util.CurrentUserID(ctx)
+	if err != nil {
+		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	c, cancel := context.WithTimeout(context.Background(), time.Duration(5)*time.Second)
+	defer cancel()
+
+	res, err := ctrl.client.RespondTeamInvite(c, &pb.RespondTeamInviteRequest{
+		MemberId: int32(response.MemberID),
+		UserId:   int32(user_id),
+		Accept:   response.Accept,
+	})
+
+	if err != nil {
+		slog.Errorf("Error responding to team invite: %v", err.Error())
+		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	slog.Info("Team invite answered successfully")
+	return ctx.Status(http.StatusOK).JSON(fiber.Map{"message": res.Message})
+}
+
+func (ctrl *EventController) TransferTeamCaptain(ctx *fiber.Ctx) error {
+	type Transfer struct {
+		TeamID uint `json:"team_id"`
+		UserID uint `json:"user_id"`
+	}
+
+	var transfer Transfer
+
+	if err := ctx.BodyParser(&transfer); err != nil {
+		slog.Errorf("Invalid request format: %v", err)
+		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	user_id, err := util.CurrentUserID(ctx)
+	if err != nil {
+		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	c, cancel := context.WithTimeout(context.Background(), time.Duration(5)*time.Second)
+	defer cancel()
+
+	res, err := ctrl.client.TransferTeamCaptain(c, &pb.TransferTeamCaptainRequest{
+		TeamId:       int32(transfer.TeamID),
+		UserId:       int32(user_id),
+		NewCaptainId: int32(transfer.UserID),
+	})
+
+	if err != nil {
+		slog.Errorf("Error transferring team captain: %v", err.Error())
+		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	slog.Info("Team captain transferred successfully")
+	return ctx.Status(http.StatusOK).JSON(fiber.Map{"message": res.Message})
+}
+
+func (ctrl *EventController) DisbandTeam(ctx *fiber.Ctx) error {
+	sid := ctx.Params("id")
+	team_id, err := strconv.Atoi(sid)
+	if err != nil {
+		slog.Errorf("Error converting string to int: %v", err)
+		return err
+	}
+
+	user_id, err := util.CurrentUserID(ctx)
+	if err != nil {
+		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	c, cancel := context.WithTimeout(context.Background(), time.Duration(5)*time.Second)
+	defer cancel()
+
+	res, err := ctrl.client.DisbandTeam(c, &pb.DisbandTeamRequest{
+		TeamId: int32(team_id),
+		UserId: int32(user_id),
+	})
+
+	if err != nil {
+		slog.Errorf("Error disbanding team: %v", err.Error())
+		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	slog.Info("Team disbanded successfully")
+	return ctx.Status(http.StatusOK).JSON(fiber.Map{"message": res.Message})
+}
+
+func (ctrl *EventController) GetUserTeam(ctx *fiber.Ctx) error {
+	sid := ctx.Params("id")
+	event_id, err := strconv.Atoi(sid)
+	if err != nil {
+		slog.Errorf("Error converting string to int: %v", err)
+		return err
+	}
+
+	user_id, err := util.CurrentUserID(ctx)
+	if err != nil {
+		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	c, cancel := context.WithTimeout(context.Background(), time.Duration(5)*time.Second)
+	defer cancel()
+
+	res, err := ctrl.client.GetUserTeam(c, &pb.GetUserTeamRequest{
+		EventId: int32(event_id),
+		UserId:  int32(user_id),
+	})
+
+	if err != nil {
+		slog.Errorf("Error retrieving user team: %v", err.Error())
+		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	slog.Info("User team retrieved successfully")
+	return ctx.Status(http.StatusOK).JSON(fiber.Map{"team": teamFromPb(res.Team)})
+}
+
+func (ctrl *EventController) GetUserTeamInvites(ctx *fiber.Ctx) error {
+	user_id, err := util.CurrentUserID(ctx)
+	if err != nil {
+		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	c, cancel := context.WithTimeout(context.Background(), time.Duration(5)*time.Second)
+	defer cancel()
+
+	res, err := ctrl.client.GetUserTeamInvites(c, &pb.GetUserTeamInvitesRequest{
+		UserId: int32(user_id),
+	})
+
+	if err != nil {
+		slog.Errorf("Error retrieving team invites: %v", err.Error())
+		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	invites := make([]models.TeamMember, len(res.Invites))
+
+	for i := range invites {
+		invites[i] = teamMemberFromPb(res.Invites[i])
+	}
+
+	slog.Info("Team invites retrieved successfully")
+	return ctx.Status(http.StatusOK).JSON(fiber.Map{"invites": invites})
+}
+
+func (ctrl *EventController) GetEventTeams(ctx *fiber.Ctx) error {
+	sid := ctx.Params("id")
+	event_id, err := strconv.Atoi(sid)
+	if err != nil {
+		slog.Errorf("Error converting string to int: %v", err)
+		return err
+	}
+
+	c, cancel := context.WithTimeout(context.Background(), time.Duration(5)*time.Second)
+	defer cancel()
+
+	res, err := ctrl.client.GetEventTeams(c, &pb.GetEventTeamsRequest{
+		EventId: int32(event_id),
+	})
+
+	if err != nil {
+		slog.Errorf("Error retrieving event teams: %v", err.Error())
+		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	teams := make([]models.Team, len(res.Teams))
+
+	for i := range teams {
+		teams[i] = teamFromPb(res.Teams[i])
+	}
+
+	solo := make([]models.Registration, len(res.SoloParticipants))
+
+	for i := range solo {
+		solo[i] = registrationFromPb(res.SoloParticipants[i])
+	}
+
+	slog.Info("Event teams retrieved successfully")
+	return ctx.Status(http.StatusOK).JSON(fiber.Map{"teams": teams, "solo_participants": solo})
+}
+
+func (ctrl *EventController) OptInTeamMatching(ctx *fiber.Ctx) error {
+	var profile models.MatchingProfile
+
+	if err := ctx.BodyParser(&profile); err != nil {
+		slog.Errorf("Invalid request format: %v", err)
+		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	user_id, err := util.CurrentUserID(ctx)
+	if err != nil {
+		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	c, cancel := context.WithTimeout(context.Background(), time.Duration(5)*time.Second)
+	defer cancel()
+
+	res, err := ctrl.client.OptInTeamMatching(c, &pb.OptInTeamMatchingRequest{
+		EventId:   int32(profile.EventID),
+		UserId:    int32(user_id),
+		Skills:    profile.Skills,
+		Interests: profile.Interests,
+	})
+
+	if err != nil {
+		slog.Errorf("Error opting in to team matching: %v", err.Error())
+		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	slog.Info("Opted in to team matching successfully")
+	return ctx.Status(http.StatusOK).JSON(fiber.Map{"message": res.Message})
+}
+
+func (ctrl *EventController) OptOutTeamMatching(ctx *fiber.Ctx) error {
+	sid := ctx.Params("id")
+	event_id, err := strconv.Atoi(sid)
+	if err != nil {
+		slog.Errorf("Error converting string to int: %v", err)
+		return err
+	}
+
+	user_id, err := util.CurrentUserID(ctx)
+	if err != nil {
+		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	c, cancel := context.WithTimeout(context.Background(), time.Duration(5)*time.Second)
+	defer cancel()
+
+	res, err := ctrl.client.OptOutTeamMatching(c, &pb.OptOutTeamMatchingRequest{
+		EventId: int32(event_id),
+		UserId:  int32(user_id),
+	})
+
+	if err != nil {
+		slog.Errorf("Error opting out of team matching: %v", err.Error())
+		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	slog.Info("Opted out of team matching successfully")
+	return ctx.Status(http.StatusOK).JSON(fiber.Map{"message": res.Message})
+}
+
+func (ctrl *EventController) MatchTeams(ctx *fiber.Ctx) error {
+	sid := ctx.Params("id")
+	event_id, err := strconv.Atoi(sid)
+	if err != nil {
+		slog.Errorf("Error converting string to int: %v", err)
+		return err
+	}
+
+	c, cancel := context.WithTimeout(context.Background(), time.Duration(5)*time.Second)
+	defer cancel()
+
+	res, err := ctrl.client.MatchTeams(c, &pb.MatchTeamsRequest{
+		EventId: int32(event_id),
+	})
+
+	if err != nil {
+		slog.Errorf("Error matching teams: %v", err.Error())
+		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	teams := make([]models.Team, len(res.Teams))
+
+	for i := range teams {
+		teams[i] = teamFromPb(res.Teams[i])
+	}
+
+	slog.Info("Teams matched successfully")
+	return ctx.Status(http.StatusOK).JSON(fiber.Map{"message": res.Message, "teams": teams})
+}
+
+func teamFromPb(team *pb.Team) models.Team {
+	members := make([]models.TeamMember, len(team.Members))
+	for i := range members {
+		members[i] = teamMemberFromPb(team.Members[i])
+	}
+
+	newTeam := models.Team{
+		EventID:   uint(team.EventId),
+		Name:      team.Name,
+		CaptainID: uint(team.CaptainId),
+		Members:   members,
+		Complete:  team.Complete,
+		Matched:   team.Matched,
+	}
+	newTeam.ID = uint(team.TeamId)
+	return newTeam
+}
+
+func teamMemberFromPb(member *pb.TeamMember) models.TeamMember {
+	newMember := models.TeamMember{
+		TeamID: uint(member.TeamId),
+		UserID: uint(member.UserId),
+		Email:  member.Email,
+		Status: member.Status,
+	}
+	newMember.ID = uint(member.MemberId)
+	return newMember
+}
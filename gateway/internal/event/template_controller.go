@@ -0,0 +1,200 @@
+package event
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/catness812/faf-hub-backend/gateway/internal/event/pb"
+	"github.com/catness812/faf-hub-backend/gateway/internal/media"
+	"github.com/catness812/faf-hub-backend/gateway/models"
+	"github.com/gofiber/fiber/v2"
+	"github.com/gookit/slog"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (ctrl *EventController) DuplicateEvent(ctx *fiber.Ctx) error {
+	type Duplicate struct {
+		Start time.Time `json:"start"`
+		Name  string    `json:"name"`
+	}
+
+	var duplicate Duplicate
+
+	sid := ctx.Params("id")
+	event_id, err := strconv.Atoi(sid)
+	if err != nil {
+		slog.Errorf("Error converting string to int: %v", err)
+		return err
+	}
+
+	if err := ctx.BodyParser(&duplicate); err != nil {
+		slog.Errorf("Invalid request format: %v", err)
+		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	c, cancel := context.WithTimeout(context.Background(), time.Duration(5)*time.Second)
+	defer cancel()
+
+	res, err := ctrl.client.DuplicateEvent(c, &pb.DuplicateEventRequest{
+		EventId: int32(event_id),
+		Start:   timestamppb.New(duplicate.Start),
+		Name:    duplicate.Name,
+	})
+
+	if err != nil {
+		slog.Errorf("Error duplicating event: %v", err.Error())
+		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	slog.Info("Event duplicated successfully")
+	return ctx.Status(http.StatusOK).JSON(fiber.Map{"message": res.Message, "event_id": res.EventId})
+}
+
+func (ctrl *EventController) CreateEventTemplate(ctx *fiber.Ctx) error {
+	type Template struct {
+		models.EventTemplate
+		EventID uint `json:"event_id"`
+	}
+
+	var template Template
+
+	if err := ctx.BodyParser(&template); err != nil {
+		slog.Errorf("Invalid request format: %v", err)
+		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	if err := media.ApplyCover(ctrl.mediaLibrary, template.CoverMediaID, &template.Cover); err != nil {
+		slog.Errorf("Error resolving cover media: %v", err.Error())
+		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	c, cancel := context.WithTimeout(context.Background(), time.Duration(5)*time.Second)
+	defer cancel()
+
+	res, err := ctrl.client.CreateEventTemplate(c, &pb.CreateEventTemplateRequest{
+		Template: &pb.EventTemplate{
+			Name:                  template.Name,
+			EventName:             template.EventName,
+			Desc:                  template.Description,
+			Location:              template.Location,
+			Cover:                 template.Cover,
+			CoverMediaId:          template.CoverMediaID,
+			Timezone:              template.Timezone,
+			Category:              template.Category,
+			Tags:                  template.Tags,
+			MinTeamSize:           int32(template.MinTeamSize),
+			MaxTeamSize:           int32(template.MaxTeamSize),
+			DurationMinutes:       int32(template.DurationMinutes),
+			DeadlineMinutesBefore: int32(template.DeadlineMinutesBefore),
+		},
+		EventId: int32(template.EventID),
+	})
+
+	if err != nil {
+		slog.Errorf("Error creating event template: %v", err.Error())
+		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	slog.Info("Event template created successfully")
+	return ctx.Status(http.StatusOK).JSON(fiber.Map{"message": res.Message, "template_id": res.TemplateId})
+}
+
+func (ctrl *EventController) GetEventTemplates(ctx *fiber.Ctx) error {
+	c, cancel := context.WithTimeout(context.Background(), time.Duration(5)*time.Second)
+	defer cancel()
+
+	res, err := ctrl.client.GetEventTemplates(c, &pb.GetEventTemplatesRequest{})
+
+	if err != nil {
+		slog.Errorf("Error retrieving event templates: %v", err.Error())
+		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	templates := make([]models.EventTemplate, len(res.Templates))
+
+	for i, template := range res.Templates {
+		templates[i] = models.EventTemplate{
+			ID:                    uint(template.TemplateId),
+			Name:                  template.Name,
+			EventName:             template.EventName,
+			Description:           template.Desc,
+			Location:              template.Location,
+			Cover:                 template.Cover,
+			CoverMediaID:          template.CoverMediaId,
+			Timezone:              template.Timezone,
+			Category:              template.Category,
+			Tags:                  template.Tags,
+			MinTeamSize:           int(template.MinTeamSize),
+			MaxTeamSize:           int(template.MaxTeamSize),
+			DurationMinutes:       int(template.DurationMinutes),
+			DeadlineMinutesBefore: int(template.DeadlineMinutesBefore),
+		}
+	}
+
+	slog.Info("Event templates retrieved successfully")
+	return ctx.Status(http.StatusOK).JSON(fiber.Map{"templates": templates})
+}
+
+func (ctrl *EventController) DeleteEventTemplate(ctx *fiber.Ctx) error {
+	sid := ctx.Params("id")
+	template_id, err := strconv.Atoi(sid)
+	if err != nil {
+		slog.Errorf("Error converting string to int: %v", err)
+		return err
+	}
+
+	c, cancel := context.WithTimeout(context.Background(), time.Duration(5)*time.Second)
+	defer cancel()
+
+	res, err := ctrl.client.DeleteEventTemplate(c, &pb.DeleteEventTemplateRequest{
+		TemplateId: int32(template_id),
+	})
+
+	if err != nil {
+		slog.Errorf("Error deleting event template: %v", err.Error())
+		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	slog.Info("Event template deleted successfully")
+	return ctx.Status(http.StatusOK).JSON(fiber.Map{"message": res.Message})
+}
+
+func (ctrl *EventController) CreateEventFromTemplate(ctx *fiber.Ctx) error {
+	type FromTemplate struct {
+		Start time.Time `json:"start"`
+		Name  string    `json:"name"`
+	}
+
+	var fromTemplate FromTemplate
+
+	sid := ctx.Params("id")
+	template_id, err := strconv.Atoi(sid)
+	if err != nil {
+		slog.Errorf("Error converting string to int: %v", err)
+		return err
+	}
+
+	if err := ctx.BodyParser(&fromTemplate); err != nil {
+		slog.Errorf("Invalid request format: %v", err)
+		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	c, cancel := context.WithTimeout(context.Background(), time.Duration(5)*time.Second)
+	defer cancel()
+
+	res, err := ctrl.client.CreateEventFromTemplate(c, &pb.CreateEventFromTemplateRequest{
+		TemplateId: int32(template_id),
+		Start:      timestamppb.New(fromTemplate.Start),
+		Name:       fromTemplate.Name,
+	})
+
+	if err != nil {
+		slog.Errorf("Error creating event from template: %v", err.Error())
+		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	slog.Info("Event created from template successfully")
+	return ctx.Status(http.StatusOK).JSON(fiber.Map{"message": res.Message, "event_id": res.EventId})
+}
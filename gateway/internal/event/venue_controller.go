@@ -0,0 +1,233 @@
+package event
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/catness812/faf-hub-backend/gateway/internal/event/pb"
+	"github.com/catness812/faf-hub-backend/gateway/models"
+	"github.com/gofiber/fiber/v2"
+	"github.com/gookit/slog"
+)
+
+func (ctrl *EventController) CreateVenue(ctx *fiber.Ctx) error {
+	var venue models.Venue
+
+	if err := ctx.BodyParser(&venue); err != nil {
+		slog.Errorf("Invalid request format: %v", err)
+		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	c, cancel := context.WithTimeout(context.Background(), time.Duration(5)*time.Second)
+	defer cancel()
+
+	res, err := ctrl.client.CreateVenue(c, &pb.CreateVenueRequest{
+		Venue: &pb.Venue{
+			Name:    venue.Name,
+			Address: venue.Address,
+		},
+	})
+
+	if err != nil {
+		slog.Errorf("Error creating venue: %v", err.Error())
+		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	slog.Info("Venue created successfully")
+	return ctx.Status(http.StatusOK).JSON(fiber.Map{"message": res.Message, "venue_id": res.VenueId})
+}
+
+func (ctrl *EventController) GetVenues(ctx *fiber.Ctx) error {
+	c, cancel := context.WithTimeout(context.Background(), time.Duration(5)*time.Second)
+	defer cancel()
+
+	res, err := ctrl.client.GetVenues(c, &pb.GetVenuesRequest{})
+
+	if err != nil {
+		slog.Errorf("Error retrieving venues: %v", err.Error())
+		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	venues := make([]models.Venue, len(res.Venues))
+
+	for i, venue := range res.Venues {
+		venues[i] = models.Venue{
+			ID:      uint(venue.VenueId),
+			Name:    venue.Name,
+			Address: venue.Address,
+			Rooms:   make([]models.Room, len(venue.Rooms)),
+		}
+		for j, room := range venue.Rooms {
+			venues[i].Rooms[j] = roomFromPb(room)
+		}
+	}
+
+	slog.Info("Venues retrieved successfully")
+	return ctx.Status(http.StatusOK).JSON(fiber.Map{"venues": venues})
+}
+
+func (ctrl *EventController) DeleteVenue(ctx *fiber.Ctx) error {
+	sid := ctx.Params("id")
+	venue_id, err := strconv.Atoi(sid)
+	if err != nil {
+		slog.Errorf("Error converting string to int: %v", err)
+		return err
+	}
+
+	c, cancel := context.WithTimeout(context.Background(), time.Duration(5)*time.Second)
+	defer cancel()
+
+	res, err := ctrl.client.DeleteVenue(c, &pb.DeleteVenueRequest{
+		VenueId: int32(venue_id),
+	})
+
+	if err != nil {
+		slog.Errorf("Error deleting venue: %v", err.Error())
+		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	slog.Info("Venue deleted successfully")
+	return ctx.Status(http.StatusOK).JSON(fiber.Map{"message": res.Message})
+}
+
+func (ctrl *EventController) CreateRoom(ctx *fiber.Ctx) error {
+	var room models.Room
+
+	if err := ctx.BodyParser(&room); err != nil {
+		slog.Errorf("Invalid request format: %v", err)
+		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	c, cancel := context.WithTimeout(context.Background(), time.Duration(5)*time.Second)
+	defer cancel()
+
+	res, err := ctrl.client.CreateRoom(c, &pb.CreateRoomRequest{
+		Room: roomToPb(room),
+	})
+
+	if err != nil {
+		slog.Errorf("Error creating room: %v", err.Error())
+		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	slog.Info("Room created successfully")
+	return ctx.Status(http.StatusOK).JSON(fiber.Map{"message": res.Message, "room_id": res.RoomId})
+}
+
+func (ctrl *EventController) EditRoom(ctx *fiber.Ctx) error {
+	var room models.Room
+
+	if err := ctx.BodyParser(&room); err != nil {
+		slog.Errorf("Invalid request format: %v", err)
+		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	c, cancel := context.WithTimeout(context.Background(), time.Duration(5)*time.Second)
+	defer cancel()
+
+	res, err := ctrl.client.EditRoom(c, &pb.EditRoomRequest{
+		Room: roomToPb(room),
+	})
+
+	if err != nil {
+		slog.Errorf("Error updating room: %v", err.Error())
+		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	slog.Info("Room updated successfully")
+	return ctx.Status(http.StatusOK).JSON(fiber.Map{"message": res.Message})
+}
+
+func (ctrl *EventController) DeleteRoom(ctx *fiber.Ctx) error {
+	sid := ctx.Params("id")
+	room_id, err := strconv.Atoi(sid)
+	if err != nil {
+		slog.Errorf("Error converting string to int: %v", err)
+		return err
+	}
+
+	c, cancel := context.WithTimeout(context.Background(), time.Duration(5)*time.Second)
+	defer cancel()
+
+	res, err := ctrl.client.DeleteRoom(c, &pb.DeleteRoomRequest{
+		RoomId: int32(room_id),
+	})
+
+	if err != nil {
+		slog.Errorf("Error deleting room: %v", err.Error())
+		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	slog.Info("Room deleted successfully")
+	return ctx.Status(http.StatusOK).JSON(fiber.Map{"message": res.Message})
+}
+
+func (ctrl *EventController) GetRoomAvailability(ctx *fiber.Ctx) error {
+	sid := ctx.Params("id")
+	room_id, err := strconv.Atoi(sid)
+	if err != nil {
+		slog.Errorf("Error converting string to int: %v", err)
+		return err
+	}
+
+	req := &pb.GetRoomAvailabilityRequest{
+		RoomId: int32(room_id),
+	}
+	if req.From, err = parseQueryTime(ctx.Query("from")); err != nil {
+		slog.Errorf("Error parsing from date: %v", err.Error())
+		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+	if req.To, err = parseQueryTime(ctx.Query("to")); err != nil {
+		slog.Errorf("Error parsing to date: %v", err.Error())
+		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	c, cancel := context.WithTimeout(context.Background(), time.Duration(5)*time.Second)
+	defer cancel()
+
+	res, err := ctrl.client.GetRoomAvailability(c, req)
+
+	if err != nil {
+		slog.Errorf("Error retrieving room availability: %v", err.Error())
+		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	bookings := make([]models.Event, len(res.Bookings))
+	for i := range bookings {
+		bookings[i] = eventFromPb(res.Bookings[i])
+	}
+
+	free := make([]models.TimeSlot, len(res.Free))
+	for i, slot := range res.Free {
+		free[i] = models.TimeSlot{
+			Start: slot.Start.AsTime(),
+			End:   slot.End.AsTime(),
+		}
+	}
+
+	slog.Info("Room availability retrieved successfully")
+	return ctx.Status(http.StatusOK).JSON(fiber.Map{"room": roomFromPb(res.Room), "bookings": bookings, "free": free})
+}
+
+func roomFromPb(room *pb.Room) models.Room {
+	return models.Room{
+		ID:        uint(room.RoomId),
+		VenueID:   uint(room.VenueId),
+		VenueName: room.VenueName,
+		Name:      room.Name,
+		Capacity:  int(room.Capacity),
+		Equipment: room.Equipment,
+	}
+}
+
+func roomToPb(room models.Room) *pb.Room {
+	return &pb.Room{
+		RoomId:    int32(room.ID),
+		VenueId:   int32(room.VenueID),
+		Name:      room.Name,
+		Capacity:  int32(room.Capacity),
+		Equipment: room.Equipment,
+	}
+}
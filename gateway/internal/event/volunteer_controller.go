@@ -0,0 +1,362 @@
+package event
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/catness812/faf-hub-backend/gateway/internal/event/pb"
+	"github.com/catness812/faf-hub-backend/gateway/internal/util"
+	"github.com/catness812/faf-hub-backend/gateway/models"
+	"github.com/gofiber/fiber/v2"
+	"github.com/gookit/slog"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (ctrl *EventController) CreateVolunteerRole(ctx *fiber.Ctx) error {
+	var role models.VolunteerRole
+
+	sid := ctx.Params("id")
+	event_id, err := strconv.Atoi(sid)
+	if err != nil {
+		slog.Errorf("Error converting string to int: %v", err)
+		return err
+	}
+
+	if err := ctx.BodyParser(&role); err != nil {
+		slog.Errorf("Invalid request format: %v", err)
+		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	c, cancel := context.WithTimeout(context.Background(), time.Duration(5)*time.Second)
+	defer cancel()
+
+	res, err := ctrl.client.CreateVolunteerRole(c, &pb.CreateVolunteerRoleRequest{
+		Role: &pb.VolunteerRole{
+			EventId: int32(event_id),
+			Name:    role.Name,
+			Desc:    role.Description,
+		},
+	})
+
+	if err != nil {
+		slog.Errorf("Error creating volunteer role: %v", err.Error())
+		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	slog.Info("Volunteer role created successfully")
+	return ctx.Status(http.StatusOK).JSON(fiber.Map{"message": res.Message, "role_id": res.RoleId})
+}
+
+func (ctrl *EventController) DeleteVolunteerRole(ctx *fiber.Ctx) error {
+	sid := ctx.Params("id")
+	event_id, err := strconv.Atoi(sid)
+	if err != nil {
+		slog.Errorf("Error converting string to int: %v", err)
+		return err
+	}
+
+	srid := ctx.Params("role_id")
+	role_id, err := strconv.Atoi(srid)
+	if err != nil {
+		slog.Errorf("Error converting string to int: %v", err)
+		return err
+	}
+
+	c, cancel := context.WithTimeout(context.Background(), time.Duration(5)*time.Second)
+	defer cancel()
+
+	res, err := ctrl.client.DeleteVolunteerRole(c, &pb.DeleteVolunteerRoleRequest{
+		EventId: int32(event_id),
+		RoleId:  int32(role_id),
+	})
+
+	if err != nil {
+		slog.Errorf("Error deleting volunteer role: %v", err.Error())
+		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	slog.Info("Volunteer role deleted successfully")
+	return ctx.Status(http.StatusOK).JSON(fiber.Map{"message": res.Message})
+}
+
+func (ctrl *EventController) CreateVolunteerShift(ctx *fiber.Ctx) error {
+	var shift models.VolunteerShift
+
+	sid := ctx.Params("id")
+	event_id, err := strconv.Atoi(sid)
+	if err != nil {
+		slog.Errorf("Error converting string to int: %v", err)
+		return err
+	}
+
+	if err := ctx.BodyParser(&shift); err != nil {
+		slog.Errorf("Invalid request format: %v", err)
+		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	c, cancel := context.WithTimeout(context.Background(), time.Duration(5)*time.Second)
+	defer cancel()
+
+	shift.EventID = uint(event_id)
+	res, err := ctrl.client.CreateVolunteerShift(c, &pb.CreateVolunteerShiftRequest{
+		Shift: shiftToPb(shift),
+	})
+
+	if err != nil {
+		slog.Errorf("Error creating volunteer shift: %v", err.Error())
+		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	slog.Info("Volunteer shift created successfully")
+	return ctx.Status(http.StatusOK).JSON(fiber.Map{"message": res.Message, "shift_id": res.ShiftId})
+}
+
+func (ctrl *EventController) EditVolunteerShift(ctx *fiber.Ctx) error {
+	var shift models.VolunteerShift
+
+	sid := ctx.Params("id")
+	event_id, err := strconv.Atoi(sid)
+	if err != nil {
+		slog.Errorf("Error converting string to int: %v", err)
+		return err
+	}
+
+	sshid := ctx.Params("shift_id")
+	shift_id, err := strconv.Atoi(sshid)
+	if err != nil {
+		slog.Errorf("Error converting string to int: %v", err)
+		return err
+	}
+
+	if err := ctx.BodyParser(&shift); err != nil {
+		slog.Errorf("Invalid request format: %v", err)
+		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	c, cancel := context.WithTimeout(context.Background(), time.Duration(5)*time.Second)
+	defer cancel()
+
+	shift.ID = uint(shift_id)
+	shift.EventID = uint(event_id)
+	res, err := ctrl.client.EditVolunteerShift(c, &pb.EditVolunteerShiftRequest{
+		Shift: shiftToPb(shift),
+	})
+
+	if err != nil {
+		slog.Errorf("Error updating volunteer shift: %v", err.Error())
+		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	slog.Info("Volunteer shift updated successfully")
+	return ctx.Status(http.StatusOK).JSON(fiber.Map{"message": res.Message})
+}
+
+func (ctrl *EventController) DeleteVolunteerShift(ctx *fiber.Ctx) error {
+	sid := ctx.Params("id")
+	event_id, err := strconv.Atoi(sid)
+	if err != nil {
+		slog.Errorf("Error converting string to int: %v", err)
+		return err
+	}
+
+	sshid := ctx.Params("shift_id")
+	shift_id, err := strconv.Atoi(sshid)
+	if err != nil {
+		slog.Errorf("Error converting string to int: %v", err)
+		return err
+	}
+
+	c, cancel := context.WithTimeout(context.Background(), time.Duration(5)*time.Second)
+	defer cancel()
+
+	res, err := ctrl.client.DeleteVolunteerShift(c, &pb.DeleteVolunteerShiftRequest{
+		EventId: int32(event_id),
+		ShiftId: int32(shift_id),
+	})
+
+	if err != nil {
+		slog.Errorf("Error deleting volunteer shift: %v", err.Error())
+		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	slog.Info("Volunteer shift deleted successfully")
+	return ctx.Status(http.StatusOK).JSON(fiber.Map{"message": res.Message})
+}
+
+func (ctrl *EventController) GetVolunteerShifts(ctx *fiber.Ctx) error {
+	sid := ctx.Params("id")
+	event_id, err := strconv.Atoi(sid)
+	if err != nil {
+		slog.Errorf("Error converting string to int: %v", err)
+		return err
+	}
+
+	c, cancel := context.WithTimeout(context.Background(), time.Duration(5)*time.Second)
+	defer cancel()
+
+	res, err := ctrl.client.GetVolunteerShifts(c, &pb.GetVolunteerShiftsRequest{
+		EventId: int32(event_id),
+	})
+
+	if err != nil {
+		slog.Errorf("Error retrieving volunteer shifts: %v", err.Error())
+		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	slog.Info("Volunteer shifts retrieved successfully")
+	return ctx.Status(http.StatusOK).JSON(fiber.Map{"roles": rolesFromPb(res.Roles), "shifts": shiftsFromPb(res.Shifts)})
+}
+
+func (ctrl *EventController) GetVolunteerRoster(ctx *fiber.Ctx) error {
+	sid := ctx.Params("id")
+	event_id, err := strconv.Atoi(sid)
+	if err != nil {
+		slog.Errorf("Error converting string to int: %v", err)
+		return err
+	}
+
+	c, cancel := context.WithTimeout(context.Background(), time.Duration(5)*time.Second)
+	defer cancel()
+
+	res, err := ctrl.client.GetVolunteerRoster(c, &pb.GetVolunteerRosterRequest{
+		EventId: int32(event_id),
+	})
+
+	if err != nil {
+		slog.Errorf("Error retrieving volunteer roster: %v", err.Error())
+		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	slog.Info("Volunteer roster retrieved successfully")
+	return ctx.Status(http.StatusOK).JSON(fiber.Map{"roles": rolesFromPb(res.Roles), "shifts": shiftsFromPb(res.Shifts)})
+}
+
+func (ctrl *EventController) SignUpForShift(ctx *fiber.Ctx) error {
+	var signup models.VolunteerSignup
+
+	sid := ctx.Params("id")
+	shift_id, err := strconv.Atoi(sid)
+	if err != nil {
+		slog.Errorf("Error converting string to int: %v", err)
+		return err
+	}
+
+	user_id, err := util.CurrentUserID(ctx)
+	if err != nil {
+		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	if err := ctx.BodyParser(&signup); err != nil {
+		slog.Errorf("Invalid request format: %v", err)
+		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	c, cancel := context.WithTimeout(context.Background(), time.Duration(5)*time.Second)
+	defer cancel()
+
+	res, err := ctrl.client.SignUpForShift(c, &pb.SignUpForShiftRequest{
+		Signup: &pb.VolunteerSignup{
+			ShiftId: int32(shift_id),
+			UserId:  int32(user_id),
+			Name:    signup.Name,
+			Email:   signup.Email,
+		},
+	})
+
+	if err != nil {
+		slog.Errorf("Error signing up for volunteer shift: %v", err.Error())
+		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	slog.Info("Signed up for volunteer shift successfully")
+	return ctx.Status(http.StatusOK).JSON(fiber.Map{"message": res.Message})
+}
+
+func (ctrl *EventController) CancelShiftSignup(ctx *fiber.Ctx) error {
+	sid := ctx.Params("id")
+	shift_id, err := strconv.Atoi(sid)
+	if err != nil {
+		slog.Errorf("Error converting string to int: %v", err)
+		return err
+	}
+
+	user_id, err := util.CurrentUserID(ctx)
+	if err != nil {
+		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	c, cancel := context.WithTimeout(context.Background(), time.Duration(5)*time.Second)
+	defer cancel()
+
+	res, err := ctrl.client.CancelShiftSignup(c, &pb.CancelShiftSignupRequest{
+		ShiftId: int32(shift_id),
+		UserId:  int32(user_id),
+	})
+
+	if err != nil {
+		slog.Errorf("Error cancelling volunteer signup: %v", err.Error())
+		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	slog.Info("Volunteer signup cancelled successfully")
+	return ctx.Status(http.StatusOK).JSON(fiber.Map{"message": res.Message})
+}
+
+func shiftToPb(shift models.VolunteerShift) *pb.VolunteerShift {
+	newShift := &pb.VolunteerShift{
+		ShiftId: int32(shift.ID),
+		EventId: int32(shift.EventID),
+		RoleId:  int32(shift.RoleID),
+		Desc:    shift.Description,
+		Slots:   int32(shift.Slots),
+	}
+	if !shift.StartTime.IsZero() {
+		newShift.Start = timestamppb.New(shift.StartTime)
+	}
+	if !shift.EndTime.IsZero() {
+		newShift.End = timestamppb.New(shift.EndTime)
+	}
+	return newShift
+}
+
+func rolesFromPb(roles []*pb.VolunteerRole) []models.VolunteerRole {
+	newRoles := make([]models.VolunteerRole, len(roles))
+	for i, role := range roles {
+		newRoles[i] = models.VolunteerRole{
+			ID:          uint(role.RoleId),
+			EventID:     uint(role.EventId),
+			Name:        role.Name,
+			Description: role.Desc,
+		}
+	}
+	return newRoles
+}
+
+func shiftsFromPb(shifts []*pb.VolunteerShift) []models.VolunteerShift {
+	newShifts := make([]models.VolunteerShift, len(shifts))
+	for i, shift := range shifts {
+		newShifts[i] = models.VolunteerShift{
+			ID:          uint(shift.ShiftId),
+			EventID:     uint(shift.EventId),
+			RoleID:      uint(shift.RoleId),
+			RoleName:    shift.RoleName,
+			Description: shift.Desc,
+			StartTime:   shift.Start.AsTime(),
+			EndTime:     shift.End.AsTime(),
+			Slots:       int(shift.Slots),
+			Taken:       int(shift.Taken),
+		}
+		for _, signup := range shift.Signups {
+			newShifts[i].Signups = append(newShifts[i].Signups, models.VolunteerSignup{
+				ShiftID:    uint(signup.ShiftId),
+				UserID:     uint(signup.UserId),
+				Name:       signup.Name,
+				Email:      signup.Email,
+				SignedUpAt: signup.SignedUpAt.AsTime(),
+			})
+		}
+	}
+	return newShifts
+}
@@ -0,0 +1,78 @@
+package media
+
+import (
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/gookit/slog"
+)
+
+type MediaController struct {
+	library *Library
+}
+
+func NewMediaController(library *Library) *MediaController {
+	return &MediaController{
+		library: library,
+	}
+}
+
+func (ctrl *MediaController) UploadMedia(ctx *fiber.Ctx) error {
+	header, err := ctx.FormFile("file")
+	if err != nil {
+		slog.Errorf("Invalid request format: %v", err)
+		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	file, err := header.Open()
+	if err != nil {
+		slog.Errorf("Error opening uploaded file: %v", err)
+		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+	defer file.Close()
+
+	// read one byte past the limit so oversized files are rejected
+	data, err := io.ReadAll(io.LimitReader(file, ctrl.library.maxSize+1))
+	if err != nil {
+		slog.Errorf("Error reading uploaded file: %v", err)
+		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	media, err := ctrl.library.Upload(data)
+	if err != nil {
+		slog.Errorf("Error uploading media: %v", err.Error())
+		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	slog.Info("Media uploaded successfully")
+	return ctx.Status(http.StatusOK).JSON(fiber.Map{"message": "media uploaded successfully", "media": media})
+}
+
+// GetMedia serves a variant of the media. Files never change once uploaded,
+// so they can be cached forever.
+func (ctrl *MediaController) GetMedia(ctx *fiber.Ctx) error {
+	data, err := ctrl.library.Load(ctx.Params("id"), ctx.Params("variant"))
+	if errors.Is(err, ErrNotFound) {
+		return ctx.Status(http.StatusNotFound).JSON(fiber.Map{"error": err.Error()})
+	}
+	if err != nil {
+		slog.Errorf("Error retrieving media: %v", err.Error())
+		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	ctx.Set(fiber.HeaderContentType, http.DetectContentType(data))
+	ctx.Set(fiber.HeaderCacheControl, "public, max-age=31536000, immutable")
+	return ctx.Status(http.StatusOK).Send(data)
+}
+
+func (ctrl *MediaController) DeleteMedia(ctx *fiber.Ctx) error {
+	if err := ctrl.library.Delete(ctx.Params("id")); err != nil {
+		slog.Errorf("Error deleting media: %v", err.Error())
+		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	slog.Info("Media deleted successfully")
+	return ctx.Status(http.StatusOK).JSON(fiber.Map{"message": "media deleted successfully"})
+}
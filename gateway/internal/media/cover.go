@@ -0,0 +1,43 @@
+package media
+
+import (
+	"fmt"
+	"net/url"
+)
+
+// CoverLibrary resolves uploaded media to the cover links of events and
+// content.
+type CoverLibrary interface {
+	CoverURL(mediaID string) (string, error)
+}
+
+// ApplyCover points the cover at the uploaded media, so rows keep a short link
+// instead of the image itself. Covers given directly must be image links,
+// since inlined images bloat every row and response they are part of.
+func ApplyCover(library CoverLibrary, mediaID string, cover *string) error {
+	if mediaID != "" {
+		link, err := library.CoverURL(mediaID)
+		if err != nil {
+			return err
+		}
+		*cover = link
+		return nil
+	}
+
+	if *cover == "" {
+		return nil
+	}
+	u, err := url.Parse(*cover)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("cover must be an image link or a cover_media_id")
+	}
+	return nil
+}
+
+// CoverURLs returns the links of every variant of the cover media, if any.
+func CoverURLs(mediaID string) map[string]string {
+	if mediaID == "" {
+		return nil
+	}
+	return URLs(mediaID)
+}
@@ -0,0 +1,89 @@
+package media
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/color"
+	_ "image/gif"
+	"image/jpeg"
+	_ "image/png"
+
+	"golang.org/x/image/draw"
+	_ "golang.org/x/image/webp"
+)
+
+// maxPixels stops images that are small on disk but huge once decoded.
+const maxPixels = 40_000_000
+
+const jpegQuality = 85
+
+type variant struct {
+	name   string
+	width  int
+	height int // 0 keeps the aspect ratio, otherwise the image is cropped
+}
+
+var variants = []variant{
+	{name: "large", width: 1600},
+	{name: "medium", width: 800},
+	{name: "thumb", width: 320, height: 320},
+}
+
+// processImage validates the upload and renders every variant as JPEG. It
+// returns the variants by name along with the size of the original.
+func processImage(data []byte) (map[string][]byte, int, int, error) {
+	config, format, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, 0, 0, fmt.Errorf("file is not a supported image")
+	}
+	if config.Width*config.Height > maxPixels {
+		return nil, 0, 0, fmt.Errorf("image can't be larger than %d megapixels", maxPixels/1_000_000)
+	}
+
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, 0, 0, fmt.Errorf("could not decode %s image: %v", format, err)
+	}
+
+	rendered := make(map[string][]byte, len(variants))
+	for _, v := range variants {
+		var buf bytes.Buffer
+		if err := jpeg.Encode(&buf, resize(img, v), &jpeg.Options{Quality: jpegQuality}); err != nil {
+			return nil, 0, 0, err
+		}
+		rendered[v.name] = buf.Bytes()
+	}
+
+	return rendered, config.Width, config.Height, nil
+}
+
+// resize scales the image down to the variant width, never up. Variants with
+// a height are cropped around the center first. Transparent areas end up
+// white since JPEG has no alpha channel.
+func resize(img image.Image, v variant) image.Image {
+	src := img.Bounds()
+	if v.height > 0 {
+		src = centerCrop(src, v.width, v.height)
+	}
+
+	width := min(v.width, src.Dx())
+	height := max(src.Dy()*width/src.Dx(), 1)
+
+	dst := image.NewRGBA(image.Rect(0, 0, width, height))
+	draw.Draw(dst, dst.Bounds(), image.NewUniform(color.White), image.Point{}, draw.Src)
+	draw.CatmullRom.Scale(dst, dst.Bounds(), img, src, draw.Over, nil)
+	return dst
+}
+
+func centerCrop(r image.Rectangle, width int, height int) image.Rectangle {
+	w, h := r.Dx(), r.Dy()
+	if w*height > h*width {
+		w = h * width / height
+	} else {
+		h = w * height / width
+	}
+	x := r.Min.X + (r.Dx()-w)/2
+	y := r.Min.Y + (r.Dy()-h)/2
+	return image.Rect(x, y, x+w, y+h)
+}
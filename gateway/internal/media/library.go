@@ -0,0 +1,165 @@
+package media
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"os"
+	"regexp"
+	"strconv"
+
+	"github.com/gookit/slog"
+)
+
+const (
+	// Cover is the variant events and content link as their cover.
+	Cover = "large"
+
+	defaultMaxSizeMB = 5
+)
+
+var allowedTypes = map[string]bool{
+	"image/jpeg": true,
+	"image/png":  true,
+	"image/gif":  true,
+	"image/webp": true,
+}
+
+var mediaIDPattern = regexp.MustCompile(`^[0-9a-f]{32}$`)
+
+type Media struct {
+	ID     string            `json:"media_id"`
+	Width  int               `json:"width"`
+	Height int               `json:"height"`
+	URLs   map[string]string `json:"urls"`
+}
+
+// Library validates and processes uploads and keeps them in the storage.
+type Library struct {
+	storage Storage
+	maxSize int64
+}
+
+func NewLibrary(storage Storage) *Library {
+	return &Library{
+		storage: storage,
+		maxSize: MaxUploadSize(),
+	}
+}
+
+// MaxUploadSize reads MEDIA_MAX_SIZE_MB, defaulting to 5 MB.
+func MaxUploadSize() int64 {
+	mb, err := strconv.Atoi(os.Getenv("MEDIA_MAX_SIZE_MB"))
+	if err != nil || mb <= 0 {
+		mb = defaultMaxSizeMB
+	}
+	return int64(mb) << 20
+}
+
+func (l *Library) Upload(data []byte) (Media, error) {
+	if int64(len(data)) > l.maxSize {
+		return Media{}, fmt.Errorf("file can't be larger than %d MB", l.maxSize>>20)
+	}
+	if contentType := http.DetectContentType(data); !allowedTypes[contentType] {
+		return Media{}, fmt.Errorf("file type %s isn't allowed, upload a jpeg, png, gif or webp image", contentType)
+	}
+
+	rendered, width, height, err := processImage(data)
+	if err != nil {
+		return Media{}, err
+	}
+
+	mediaID, err := newMediaID()
+	if err != nil {
+		return Media{}, err
+	}
+
+	// only the re-encoded variants are kept, so the metadata of the upload,
+	// like the location in EXIF, is never stored or served
+	for name, file := range rendered {
+		if err := l.storage.Save(mediaID, name, file); err != nil {
+			if err := l.storage.Delete(mediaID); err != nil {
+				slog.Errorf("Could not clean up media %s: %v", mediaID, err)
+			}
+			return Media{}, err
+		}
+	}
+
+	return Media{
+		ID:     mediaID,
+		Width:  width,
+		Height: height,
+		URLs:   URLs(mediaID),
+	}, nil
+}
+
+func (l *Library) Load(mediaID string, variant string) ([]byte, error) {
+	if !ValidID(mediaID) || !validVariant(variant) {
+		return nil, ErrNotFound
+	}
+	return l.storage.Load(mediaID, variant)
+}
+
+// Exists reports whether the media was uploaded, so events and content only
+// reference media that can be served.
+func (l *Library) Exists(mediaID string) bool {
+	if !ValidID(mediaID) {
+		return false
+	}
+	exists, err := l.storage.Exists(mediaID, Cover)
+	if err != nil {
+		slog.Errorf("Could not check media %s: %v", mediaID, err)
+	}
+	return exists
+}
+
+// CoverURL checks that the media exists and returns the link of the variant
+// stored as the cover of events and content.
+func (l *Library) CoverURL(mediaID string) (string, error) {
+	if !l.Exists(mediaID) {
+		return "", ErrNotFound
+	}
+	return URL(mediaID, Cover), nil
+}
+
+func (l *Library) Delete(mediaID string) error {
+	if !l.Exists(mediaID) {
+		return ErrNotFound
+	}
+	return l.storage.Delete(mediaID)
+}
+
+// URLs returns the stable links of every variant.
+func URLs(mediaID string) map[string]string {
+	urls := make(map[string]string, len(variants))
+	for _, v := range variants {
+		urls[v.name] = URL(mediaID, v.name)
+	}
+	return urls
+}
+
+func URL(mediaID string, variant string) string {
+	return os.Getenv("APP_URL") + "/media/" + mediaID + "/" + variant
+}
+
+func ValidID(mediaID string) bool {
+	return mediaIDPattern.MatchString(mediaID)
+}
+
+func validVariant(name string) bool {
+	for _, v := range variants {
+		if v.name == name {
+			return true
+		}
+	}
+	return false
+}
+
+func newMediaID() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
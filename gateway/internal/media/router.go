@@ -0,0 +1,13 @@
+package media
+
+import (
+	"github.com/catness812/faf-hub-backend/gateway/internal/middleware"
+	"github.com/gofiber/fiber/v2"
+)
+
+func RegisterMediaRoutes(r *fiber.App, mediaCtrl *MediaController) {
+	route := r.Group("/media")
+	route.Post("/upload", middleware.JWTAuth(), middleware.CheckIfVerified(), mediaCtrl.UploadMedia)
+	route.Delete("/delete/:id", middleware.JWTAuth(), middleware.CheckAdmin(), middleware.CheckIfVerified(), mediaCtrl.DeleteMedia)
+	route.Get("/:id/:variant", mediaCtrl.GetMedia)
+}
@@ -0,0 +1,89 @@
+package media
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+var ErrNotFound = errors.New("media doesn't exist")
+
+// Storage keeps the files of every media under its id, one file per variant.
+type Storage interface {
+	Save(mediaID string, variant string, data []byte) error
+	Load(mediaID string, variant string) ([]byte, error)
+	Exists(mediaID string, variant string) (bool, error)
+	Delete(mediaID string) error
+}
+
+// NewStorage returns the backend picked by MEDIA_STORAGE. Only the local disk
+// is supported for now.
+func NewStorage(kind string) (Storage, error) {
+	switch kind {
+	case "", "local":
+		dir := os.Getenv("MEDIA_DIR")
+		if dir == "" {
+			dir = "./media"
+		}
+		return NewLocalStorage(dir)
+	}
+	return nil, fmt.Errorf("unsupported media storage %q", kind)
+}
+
+type LocalStorage struct {
+	dir string
+}
+
+func NewLocalStorage(dir string) (*LocalStorage, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+	return &LocalStorage{
+		dir: dir,
+	}, nil
+}
+
+// Save writes to a temporary file first so a variant is never served half
+// written.
+func (s *LocalStorage) Save(mediaID string, variant string, data []byte) error {
+	dir := filepath.Join(s.dir, mediaID)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(dir, variant+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), filepath.Join(dir, variant))
+}
+
+func (s *LocalStorage) Load(mediaID string, variant string) ([]byte, error) {
+	data, err := os.ReadFile(filepath.Join(s.dir, mediaID, variant))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, ErrNotFound
+	}
+	return data, err
+}
+
+func (s *LocalStorage) Exists(mediaID string, variant string) (bool, error) {
+	_, err := os.Stat(filepath.Join(s.dir, mediaID, variant))
+	if errors.Is(err, os.ErrNotExist) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+func (s *LocalStorage) Delete(mediaID string) error {
+	return os.RemoveAll(filepath.Join(s.dir, mediaID))
+}
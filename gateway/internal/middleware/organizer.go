@@ -0,0 +1,67 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/catness812/faf-hub-backend/gateway/internal/event/pb"
+	"github.com/catness812/faf-hub-backend/gateway/internal/util"
+	"github.com/gofiber/fiber/v2"
+	"github.com/gookit/slog"
+)
+
+// CheckEventOrganizer lets through admins and the organizers of the event
+// the request is about, which eventID reads from the request.
+func CheckEventOrganizer(client pb.EventServiceClient, eventID func(ctx *fiber.Ctx) (int, error)) fiber.Handler {
+	return func(ctx *fiber.Ctx) error {
+		admin, err := util.CheckAdmin(ctx)
+		if err != nil {
+			return ctx.Status(http.StatusUnauthorized).JSON(fiber.Map{"error": err.Error()})
+		}
+		if admin {
+			return ctx.Next()
+		}
+
+		id, err := eventID(ctx)
+		if err != nil {
+			return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": "invalid event id"})
+		}
+
+		userID, err := util.CurrentUserID(ctx)
+		if err != nil {
+			return ctx.Status(http.StatusUnauthorized).JSON(fiber.Map{"error": err.Error()})
+		}
+
+		c, cancel := context.WithTimeout(context.Background(), time.Duration(5)*time.Second)
+		defer cancel()
+
+		res, err := client.CheckEventOrganizer(c, &pb.CheckEventOrganizerRequest{
+			EventId: int32(id),
+			UserId:  int32(userID),
+		})
+		if err != nil {
+			slog.Errorf("Could not check event organizer: %v", err)
+			return ctx.Status(http.StatusUnauthorized).JSON(fiber.Map{"error": err.Error()})
+		}
+		if !res.Organizer {
+			return ctx.Status(http.StatusUnauthorized).JSON(fiber.Map{"error": "not an organizer of this event"})
+		}
+		return ctx.Next()
+	}
+}
+
+func EventIDFromParams(ctx *fiber.Ctx) (int, error) {
+	return strconv.Atoi(ctx.Params("id"))
+}
+
+func EventIDFromBody(ctx *fiber.Ctx) (int, error) {
+	var body struct {
+		EventID int `json:"event_id"`
+	}
+	if err := ctx.BodyParser(&body); err != nil {
+		return 0, err
+	}
+	return body.EventID, nil
+}
@@ -47,6 +47,21 @@ func (repo *RedisRepository) CheckKeyAndCompare(key string, value string) error
 	return fmt.Errorf("value isn't right")
 }
 
+// Increment counts up the key, which expires after ttl from its first
+// increment.
+func (repo *RedisRepository) Increment(key string, ttl time.Duration) (int64, error) {
+	count, err := repo.redisClient.Incr(context.Background(), key).Result()
+	if err != nil {
+		return 0, err
+	}
+	if count == 1 {
+		if err := repo.redisClient.Expire(context.Background(), key, ttl).Err(); err != nil {
+			return 0, err
+		}
+	}
+	return count, nil
+}
+
 func (repo *RedisRepository) Delete(key string) error {
 	if err := repo.redisClient.Del(context.Background(), key).Err(); err != nil {
 		return err
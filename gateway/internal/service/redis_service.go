@@ -2,6 +2,7 @@ package service
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/gookit/slog"
 )
@@ -12,6 +13,7 @@ type IRedisRepository interface {
 	SAdd(key string, value string) error
 	SRem(key string, value string) error
 	GetValuesFromSet(key string) ([]string, error)
+	Increment(key string, ttl time.Duration) (int64, error)
 	Delete(key string) error
 }
 
@@ -83,3 +85,14 @@ func (svc *RedisService) GetNewsletterEmails() ([]string, error) {
 	slog.Info("Successfully retrieved emails from Redis")
 	return emails, nil
 }
+
+// AllowAttempt counts an attempt under the key and reports whether it stays
+// within the limit of attempts per window.
+func (svc *RedisService) AllowAttempt(key string, limit int64, window time.Duration) (bool, error) {
+	count, err := svc.redisRepo.Increment("attempts:"+key, window)
+	if err != nil {
+		slog.Errorf("Could not count attempt in Redis: %v", err)
+		return false, err
+	}
+	return count <= limit, nil
+}
@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/catness812/faf-hub-backend/gateway/internal/config"
+	pb3 "github.com/catness812/faf-hub-backend/gateway/internal/event/pb"
 	pb2 "github.com/catness812/faf-hub-backend/gateway/internal/notification/pb"
 	"github.com/catness812/faf-hub-backend/gateway/internal/user/pb"
 	"github.com/catness812/faf-hub-backend/gateway/internal/util"
@@ -28,13 +29,15 @@ type IRedisService interface {
 type UserController struct {
 	client             pb.UserServiceClient
 	notificationClient pb2.NotificationServiceClient
+	eventClient        pb3.EventServiceClient
 	redisSvc           IRedisService
 }
 
-func NewUserController(client pb.UserServiceClient, notificationClient pb2.NotificationServiceClient, redisSvc IRedisService) *UserController {
+func NewUserController(client pb.UserServiceClient, notificationClient pb2.NotificationServiceClient, eventClient pb3.EventServiceClient, redisSvc IRedisService) *UserController {
 	return &UserController{
 		client:             client,
 		notificationClient: notificationClient,
+		eventClient:        eventClient,
 		redisSvc:           redisSvc,
 	}
 }
@@ -319,10 +322,51 @@ func (ctrl *UserController) CompleteVerification(ctx *fiber.Ctx) error {
 		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
 	}
 
+	// the email is verified now, so event registrations made with it as a
+	// guest belong to the user
+	if _, err := ctrl.eventClient.ClaimGuestRegistrations(c, &pb3.ClaimGuestRegistrationsRequest{
+		UserId: int32(id),
+		Email:  res.User.Email,
+	}); err != nil {
+		slog.Errorf("Error claiming guest registrations: %v", err.Error())
+	}
+
 	slog.Info("User verified successfully")
 	return ctx.Status(http.StatusOK).JSON(fiber.Map{"message": res2.Message})
 }
 
+func (ctrl *UserController) ClaimGuestRegistrations(ctx *fiber.Ctx) error {
+	id, err := util.CurrentUserID(ctx)
+	if err != nil {
+		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	c, cancel := context.WithTimeout(context.Background(), time.Duration(5)*time.Second)
+	defer cancel()
+
+	res, err := ctrl.client.GetUser(c, &pb.GetUserRequest{
+		UserId: int32(id),
+	})
+
+	if err != nil {
+		slog.Errorf("Error retrieving user: %v", err.Error())
+		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	res2, err := ctrl.eventClient.ClaimGuestRegistrations(c, &pb3.ClaimGuestRegistrationsRequest{
+		UserId: int32(id),
+		Email:  res.User.Email,
+	})
+
+	if err != nil {
+		slog.Errorf("Error claiming guest registrations: %v", err.Error())
+		return ctx.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	slog.Info("Guest registrations claimed successfully")
+	return ctx.Status(http.StatusOK).JSON(fiber.Map{"message": res2.Message, "claimed": res2.Claimed})
+}
+
 func (ctrl *UserController) Subscribe(ctx *fiber.Ctx) error {
 	id, err := util.CurrentUserID(ctx)
 	if err != nil {
@@ -19,4 +19,5 @@ func RegisterUserRoutes(r *fiber.App, userCtrl *UserController, userClient pb.Us
 	route.Get("/unsubscribe", middleware.JWTAuth(), middleware.CheckIfVerified(), userCtrl.Unsubscribe)
 	route.Get("/send-verification", middleware.JWTAuth(), userCtrl.SendVerification)
 	route.Post("/complete-verification", middleware.JWTAuth(), userCtrl.CompleteVerification)
+	route.Post("/claim-registrations", middleware.JWTAuth(), middleware.CheckIfVerified(), userCtrl.ClaimGuestRegistrations)
 }
@@ -0,0 +1,76 @@
+package util
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	ics "github.com/arran4/golang-ical"
+	"github.com/catness812/faf-hub-backend/gateway/models"
+	"github.com/gookit/slog"
+)
+
+// minSecretLength is the shortest signing secret accepted at startup.
+const minSecretLength = 32
+
+var calendarSecret []byte
+
+// LoadCalendarSecret reads CALENDAR_SECRET once at startup. Without it anyone
+// could sign a feed token for any user, so the gateway refuses to start.
+func LoadCalendarSecret() {
+	secret := os.Getenv("CALENDAR_SECRET")
+	if len(secret) < minSecretLength {
+		slog.Fatalf("CALENDAR_SECRET must be set to at least %d characters", minSecretLength)
+	}
+	calendarSecret = []byte(secret)
+}
+
+// EventsCalendar renders the events as an iCalendar document. Times are
+// written in UTC so calendar apps convert them to the reader's timezone.
+func EventsCalendar(name string, events []models.Event) string {
+	cal := ics.NewCalendarFor("FAF Hub")
+	cal.SetMethod(ics.MethodPublish)
+	cal.SetName(name)
+	cal.SetXWRCalName(name)
+	cal.SetRefreshInterval("PT1H")
+	cal.SetXPublishedTTL("PT1H")
+
+	now := time.Now()
+	for _, event := range events {
+		vevent := cal.AddEvent(fmt.Sprintf("event-%d@%s", event.ID, os.Getenv("APP_HOST")))
+		vevent.SetDtStampTime(now)
+		vevent.SetStartAt(event.StartDateTime)
+		vevent.SetEndAt(event.EndDateTime)
+		vevent.SetSummary(event.Name)
+		vevent.SetLocation(event.Location)
+		vevent.SetDescription(event.Description)
+	}
+
+	return cal.Serialize()
+}
+
+// CalendarToken signs the user id so the private calendar feed can be
+// subscribed to without a session cookie.
+func CalendarToken(userID int) string {
+	id := strconv.Itoa(userID)
+	return id + "-" + signCalendar(id)
+}
+
+func ParseCalendarToken(token string) (int, error) {
+	id, signature, found := strings.Cut(strings.TrimSuffix(token, ".ics"), "-")
+	if !found || !hmac.Equal([]byte(signature), []byte(signCalendar(id))) {
+		return 0, fmt.Errorf("invalid calendar token")
+	}
+	return strconv.Atoi(id)
+}
+
+func signCalendar(data string) string {
+	mac := hmac.New(sha256.New, calendarSecret)
+	mac.Write([]byte(data))
+	return hex.EncodeToString(mac.Sum(nil))
+}
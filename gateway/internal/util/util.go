@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"strings"
 	"time"
 
 	"github.com/catness812/faf-hub-backend/gateway/internal/user/pb"
@@ -116,6 +117,12 @@ func CheckVerified(ctx *fiber.Ctx) (bool, error) {
 	return res.Verified, nil
 }
 
+// MessageSafe drops the separator of the notification message format, so
+// user provided text can't shift the fields of a message.
+func MessageSafe(s string) string {
+	return strings.ReplaceAll(s, ";", ",")
+}
+
 func SetCookie(ctx *fiber.Ctx, jwt string) {
 	cookie := fiber.Cookie{
 		Name:     "jwt",
@@ -2,14 +2,17 @@ package main
 
 import (
 	"os"
+	_ "time/tzdata"
 
 	"github.com/catness812/faf-hub-backend/gateway/internal/config"
 	"github.com/catness812/faf-hub-backend/gateway/internal/content"
 	"github.com/catness812/faf-hub-backend/gateway/internal/event"
+	"github.com/catness812/faf-hub-backend/gateway/internal/media"
 	"github.com/catness812/faf-hub-backend/gateway/internal/notification"
 	"github.com/catness812/faf-hub-backend/gateway/internal/repository"
 	"github.com/catness812/faf-hub-backend/gateway/internal/service"
 	"github.com/catness812/faf-hub-backend/gateway/internal/user"
+	"github.com/catness812/faf-hub-backend/gateway/internal/util"
 	"github.com/catness812/faf-hub-backend/gateway/pkg/redis"
 	"github.com/gofiber/fiber/v2"
 	"github.com/gofiber/fiber/v2/middleware/cors"
@@ -26,10 +29,14 @@ func init() {
 }
 
 func main() {
+	util.LoadCalendarSecret()
 	redisDB := redis.Connect()
 	redisRepo := repository.NewRedisRepository(redisDB)
 	redisSvc := service.NewRedisService(redisRepo)
-	app := fiber.New()
+	app := fiber.New(fiber.Config{
+		// leave room for the multipart framing around the largest upload
+		BodyLimit: int(media.MaxUploadSize()) + 1<<20,
+	})
 
 	frontendURL := os.Getenv("FRONT_URL")
 	if frontendURL == "" {
@@ -50,13 +57,20 @@ func main() {
 func registerRoutes(r *fiber.App, redisSvc *service.RedisService) {
 	notificationClient := notification.InitNotificationServiceClient(os.Getenv("NOTIFICATION_SVC_PORT"))
 	userClient := user.InitUserServiceClient(os.Getenv("USER_SVC_PORT"))
-	userCtrl := user.NewUserController(userClient, notificationClient, redisSvc)
 	eventClient := event.InitEventServiceClient(os.Getenv("EVENT_SVC_PORT"))
-	eventCtrl := event.NewEventController(eventClient, notificationClient, redisSvc)
+	userCtrl := user.NewUserController(userClient, notificationClient, eventClient, redisSvc)
 	contentClient := content.InitContentServiceClient(os.Getenv("CONTENT_SVC_PORT"))
-	contentCtrl := content.NewContentController(contentClient)
+	storage, err := media.NewStorage(os.Getenv("MEDIA_STORAGE"))
+	if err != nil {
+		slog.Fatalf("Failed to set up media storage: %v", err)
+	}
+	mediaLibrary := media.NewLibrary(storage)
+	eventCtrl := event.NewEventController(eventClient, notificationClient, contentClient, redisSvc, mediaLibrary)
+	contentCtrl := content.NewContentController(contentClient, mediaLibrary)
+	mediaCtrl := media.NewMediaController(mediaLibrary)
 
 	user.RegisterUserRoutes(r, userCtrl, userClient)
 	event.RegisterEventRoutes(r, eventCtrl, eventClient)
 	content.RegisterContentRoutes(r, contentCtrl, contentClient)
+	media.RegisterMediaRoutes(r, mediaCtrl)
 }
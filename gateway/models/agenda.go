@@ -0,0 +1,25 @@
+package models
+
+import "time"
+
+type Speaker struct {
+	ID      uint   `json:"speaker_id"`
+	EventID uint   `json:"event_id"`
+	Name    string `json:"name"`
+	Title   string `json:"title"`
+	Bio     string `json:"bio"`
+	Photo   string `json:"photo"`
+	Links   string `json:"links"`
+}
+
+type Session struct {
+	ID          uint      `json:"session_id"`
+	EventID     uint      `json:"event_id"`
+	SpeakerID   uint      `json:"speaker_id"`
+	Title       string    `json:"title"`
+	Description string    `json:"desc"`
+	Room        string    `json:"room"`
+	StartTime   time.Time `json:"start"`
+	EndTime     time.Time `json:"end"`
+	Speaker     *Speaker  `json:"speaker,omitempty"`
+}
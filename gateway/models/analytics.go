@@ -0,0 +1,35 @@
+package models
+
+type RegistrationTimelinePoint struct {
+	DaysBeforeDeadline int   `json:"days_before_deadline"`
+	Registrations      int64 `json:"registrations"`
+	Cumulative         int64 `json:"cumulative"`
+}
+
+type EventAnalytics struct {
+	EventID              uint                        `json:"event_id"`
+	Registrations        int64                       `json:"registrations"`
+	Timeline             []RegistrationTimelinePoint `json:"timeline"`
+	NewsletterSends      int64                       `json:"newsletter_sends"`
+	NewsletterRecipients int64                       `json:"newsletter_recipients"`
+	NewsletterConverted  int64                       `json:"newsletter_converted"`
+	ConversionRate       float64                     `json:"conversion_rate"`
+	Confirmed            int64                       `json:"confirmed"`
+	CheckedIn            int64                       `json:"checked_in"`
+	AttendanceRate       float64                     `json:"attendance_rate"`
+	RepeatAttendees      int64                       `json:"repeat_attendees"`
+	FeedbackResponses    int64                       `json:"feedback_responses"`
+	AvgContent           float64                     `json:"avg_content"`
+	AvgOrganization      float64                     `json:"avg_organization"`
+	AvgVenue             float64                     `json:"avg_venue"`
+}
+
+type AnalyticsPeriod struct {
+	Period          string  `json:"period"`
+	Events          int64   `json:"events"`
+	Registrations   int64   `json:"registrations"`
+	CheckedIn       int64   `json:"checked_in"`
+	AttendanceRate  float64 `json:"attendance_rate"`
+	RepeatAttendees int64   `json:"repeat_attendees"`
+	AvgRating       float64 `json:"avg_rating"`
+}
@@ -0,0 +1,25 @@
+package models
+
+import "time"
+
+type FeedbackEntry struct {
+	ContentRating      int       `json:"content_rating"`
+	OrganizationRating int       `json:"organization_rating"`
+	VenueRating        int       `json:"venue_rating"`
+	Comment            string    `json:"comment"`
+	Anonymous          bool      `json:"anonymous"`
+	FirstName          string    `json:"first_name,omitempty"`
+	LastName           string    `json:"last_name,omitempty"`
+	SubmittedAt        time.Time `json:"submitted_at"`
+}
+
+type FeedbackSummary struct {
+	EventID             uint    `json:"event_id"`
+	Responses           int64   `json:"responses"`
+	AvgContent          float64 `json:"avg_content"`
+	AvgOrganization     float64 `json:"avg_organization"`
+	AvgVenue            float64 `json:"avg_venue"`
+	ContentRatings      []Count `json:"content_ratings"`
+	OrganizationRatings []Count `json:"organization_ratings"`
+	VenueRatings        []Count `json:"venue_ratings"`
+}
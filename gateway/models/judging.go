@@ -0,0 +1,46 @@
+package models
+
+import "time"
+
+type Submission struct {
+	ID          uint      `json:"submission_id"`
+	EventID     uint      `json:"event_id"`
+	TeamID      uint      `json:"team_id"`
+	TeamName    string    `json:"team_name"`
+	Title       string    `json:"title"`
+	Description string    `json:"desc"`
+	RepoURL     string    `json:"repo_url"`
+	DemoURL     string    `json:"demo_url"`
+	SubmittedBy uint      `json:"submitted_by"`
+	SubmittedAt time.Time `json:"submitted_at"`
+	ContentID   uint      `json:"content_id,omitempty"`
+}
+
+type Judge struct {
+	EventID uint      `json:"event_id"`
+	UserID  uint      `json:"user_id"`
+	AddedBy uint      `json:"added_by"`
+	AddedAt time.Time `json:"added_at"`
+}
+
+type Criterion struct {
+	ID          uint    `json:"criterion_id"`
+	EventID     uint    `json:"event_id"`
+	Name        string  `json:"name"`
+	Description string  `json:"desc"`
+	Weight      float64 `json:"weight"`
+	MaxScore    int     `json:"max_score"`
+}
+
+type Score struct {
+	CriterionID uint   `json:"criterion_id"`
+	Value       int    `json:"value"`
+	Comment     string `json:"comment"`
+}
+
+type LeaderboardEntry struct {
+	Rank       int        `json:"rank"`
+	Submission Submission `json:"submission"`
+	Score      float64    `json:"score"`
+	Judges     int        `json:"judges"`
+}
@@ -0,0 +1,10 @@
+package models
+
+import "time"
+
+type EventOrganizer struct {
+	EventID uint      `json:"event_id"`
+	UserID  uint      `json:"user_id"`
+	AddedBy uint      `json:"added_by"`
+	AddedAt time.Time `json:"added_at"`
+}
@@ -0,0 +1,8 @@
+package models
+
+type EventSearchResult struct {
+	Event         Event   `json:"event"`
+	Rank          float64 `json:"rank"`
+	NameHighlight string  `json:"name_highlight"`
+	DescHighlight string  `json:"desc_highlight"`
+}
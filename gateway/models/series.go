@@ -0,0 +1,23 @@
+package models
+
+import "time"
+
+type EventSeries struct {
+	ID           uint    `json:"series_id"`
+	Name         string  `json:"name"`
+	Description  string  `json:"desc"`
+	Location     string  `json:"location"`
+	Cover        string  `json:"cover"`
+	CoverMediaID string  `json:"cover_media_id"`
+	Timezone     string  `json:"timezone"`
+	RRule        string  `json:"rrule"`
+	Occurrences  []Event `json:"occurrences"`
+}
+
+type OccurrenceAttendance struct {
+	EventID       uint       `json:"event_id"`
+	StartDateTime time.Time  `json:"start"`
+	CancelledAt   *time.Time `json:"cancelled_at"`
+	Registered    int64      `json:"registered"`
+	Attended      int64      `json:"attended"`
+}
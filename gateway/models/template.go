@@ -0,0 +1,18 @@
+package models
+
+type EventTemplate struct {
+	ID                    uint     `json:"template_id"`
+	Name                  string   `json:"name"`
+	EventName             string   `json:"event_name"`
+	Description           string   `json:"desc"`
+	Location              string   `json:"location"`
+	Cover                 string   `json:"cover"`
+	CoverMediaID          string   `json:"cover_media_id"`
+	Timezone              string   `json:"timezone"`
+	Category              string   `json:"category"`
+	Tags                  []string `json:"tags"`
+	MinTeamSize           int      `json:"min_team_size"`
+	MaxTeamSize           int      `json:"max_team_size"`
+	DurationMinutes       int      `json:"duration_minutes"`
+	DeadlineMinutesBefore int      `json:"deadline_minutes_before"`
+}
@@ -0,0 +1,24 @@
+package models
+
+import "time"
+
+type Venue struct {
+	ID      uint   `json:"venue_id"`
+	Name    string `json:"name"`
+	Address string `json:"address"`
+	Rooms   []Room `json:"rooms"`
+}
+
+type Room struct {
+	ID        uint     `json:"room_id"`
+	VenueID   uint     `json:"venue_id"`
+	VenueName string   `json:"venue_name"`
+	Name      string   `json:"name"`
+	Capacity  int      `json:"capacity"`
+	Equipment []string `json:"equipment"`
+}
+
+type TimeSlot struct {
+	Start time.Time `json:"start"`
+	End   time.Time `json:"end"`
+}
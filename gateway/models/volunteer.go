@@ -0,0 +1,31 @@
+package models
+
+import "time"
+
+type VolunteerRole struct {
+	ID          uint   `json:"role_id"`
+	EventID     uint   `json:"event_id"`
+	Name        string `json:"name"`
+	Description string `json:"desc"`
+}
+
+type VolunteerShift struct {
+	ID          uint              `json:"shift_id"`
+	EventID     uint              `json:"event_id"`
+	RoleID      uint              `json:"role_id"`
+	RoleName    string            `json:"role_name"`
+	Description string            `json:"desc"`
+	StartTime   time.Time         `json:"start"`
+	EndTime     time.Time         `json:"end"`
+	Slots       int               `json:"slots"`
+	Taken       int               `json:"taken"`
+	Signups     []VolunteerSignup `json:"signups,omitempty"`
+}
+
+type VolunteerSignup struct {
+	ShiftID    uint      `json:"shift_id"`
+	UserID     uint      `json:"user_id"`
+	Name       string    `json:"name"`
+	Email      string    `json:"email"`
+	SignedUpAt time.Time `json:"signed_up_at"`
+}
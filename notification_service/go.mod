@@ -12,6 +12,7 @@ require (
 	github.com/aymerick/raymond v2.0.2+incompatible
 	github.com/grpc-ecosystem/go-grpc-middleware/providers/prometheus v1.0.1
 	github.com/prometheus/client_golang v1.19.1
+	github.com/skip2/go-qrcode v0.0.0-20200617195104-da1b6568686e
 	google.golang.org/grpc v1.64.0
 	google.golang.org/protobuf v1.34.1
 )
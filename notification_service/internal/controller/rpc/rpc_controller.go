@@ -13,6 +13,12 @@ import (
 type INotificationService interface {
 	SendNotificationMail(msg string)
 	SendVerificationMail(msg string)
+	SendTicketMail(msg string)
+	SendCertificateMail(msg string)
+	SendReminderMail(msg string)
+	SendEventUpdateMail(msg string)
+	SendEventChangedMail(msg string)
+	SendGuestConfirmationMail(msg string)
 }
 
 type Server struct {
@@ -65,6 +71,138 @@ func (s *Server) VerificationMail(name string) {
 	<-forever
 }
 
+func (s *Server) TicketMail(name string) {
+	q, err := s.Consumer.Channel.QueueDeclare(name, false, false, false, false, nil)
+	if err != nil {
+		slog.Fatalf("Failed to declare queue: %v", err)
+	}
+
+	msgs, err := s.Consumer.Channel.Consume(q.Name, "", true, false, false, false, nil)
+	if err != nil {
+		slog.Panic(err)
+	}
+
+	slog.Infof("Consumer '%s' started", name)
+	forever := make(chan bool)
+	go func() {
+		for msg := range msgs {
+			s.NotificationService.SendTicketMail(string(msg.Body))
+		}
+	}()
+
+	<-forever
+}
+
+func (s *Server) CertificateMail(name string) {
+	q, err := s.Consumer.Channel.QueueDeclare(name, false, false, false, false, nil)
+	if err != nil {
+		slog.Fatalf("Failed to declare queue: %v", err)
+	}
+
+	msgs, err := s.Consumer.Channel.Consume(q.Name, "", true, false, false, false, nil)
+	if err != nil {
+		slog.Panic(err)
+	}
+
+	slog.Infof("Consumer '%s' started", name)
+	forever := make(chan bool)
+	go func() {
+		for msg := range msgs {
+			s.NotificationService.SendCertificateMail(string(msg.Body))
+		}
+	}()
+
+	<-forever
+}
+
+func (s *Server) ReminderMail(name string) {
+	q, err := s.Consumer.Channel.QueueDeclare(name, false, false, false, false, nil)
+	if err != nil {
+		slog.Fatalf("Failed to declare queue: %v", err)
+	}
+
+	msgs, err := s.Consumer.Channel.Consume(q.Name, "", true, false, false, false, nil)
+	if err != nil {
+		slog.Panic(err)
+	}
+
+	slog.Infof("Consumer '%s' started", name)
+	forever := make(chan bool)
+	go func() {
+		for msg := range msgs {
+			s.NotificationService.SendReminderMail(string(msg.Body))
+		}
+	}()
+
+	<-forever
+}
+
+func (s *Server) EventUpdateMail(name string) {
+	q, err := s.Consumer.Channel.QueueDeclare(name, false, false, false, false, nil)
+	if err != nil {
+		slog.Fatalf("Failed to declare queue: %v", err)
+	}
+
+	msgs, err := s.Consumer.Channel.Consume(q.Name, "", true, false, false, false, nil)
+	if err != nil {
+		slog.Panic(err)
+	}
+
+	slog.Infof("Consumer '%s' started", name)
+	forever := make(chan bool)
+	go func() {
+		for msg := range msgs {
+			s.NotificationService.SendEventUpdateMail(string(msg.Body))
+		}
+	}()
+
+	<-forever
+}
+
+func (s *Server) EventChangedMail(name string) {
+	q, err := s.Consumer.Channel.QueueDeclare(name, false, false, false, false, nil)
+	if err != nil {
+		slog.Fatalf("Failed to declare queue: %v", err)
+	}
+
+	msgs, err := s.Consumer.Channel.Consume(q.Name, "", true, false, false, false, nil)
+	if err != nil {
+		slog.Panic(err)
+	}
+
+	slog.Infof("Consumer '%s' started", name)
+	forever := make(chan bool)
+	go func() {
+		for msg := range msgs {
+			s.NotificationService.SendEventChangedMail(string(msg.Body))
+		}
+	}()
+
+	<-forever
+}
+
+func (s *Server) GuestConfirmationMail(name string) {
+	q, err := s.Consumer.Channel.QueueDeclare(name, false, false, false, false, nil)
+	if err != nil {
+		slog.Fatalf("Failed to declare queue: %v", err)
+	}
+
+	msgs, err := s.Consumer.Channel.Consume(q.Name, "", true, false, false, false, nil)
+	if err != nil {
+		slog.Panic(err)
+	}
+
+	slog.Infof("Consumer '%s' started", name)
+	forever := make(chan bool)
+	go func() {
+		for msg := range msgs {
+			s.NotificationService.SendGuestConfirmationMail(string(msg.Body))
+		}
+	}()
+
+	<-forever
+}
+
 func (s *Server) Publish(_ context.Context, req *pb.PublishRequest) (*emptypb.Empty, error) {
 	if err := s.Consumer.Channel.Publish(
 		"",            // exchange
@@ -0,0 +1,7 @@
+package models
+
+type Attachment struct {
+	Name        string
+	ContentType string
+	Data        []byte
+}
@@ -1,9 +1,15 @@
 package repository
 
 import (
+	"bytes"
+	"encoding/base64"
 	"fmt"
+	"mime/multipart"
 	"net/smtp"
+	"net/textproto"
 	"os"
+
+	"github.com/catness812/faf-hub-backend/notification_service/internal/models"
 )
 
 type NotificationRepository struct {
@@ -28,3 +34,59 @@ func (repo *NotificationRepository) SendMail(to []string, subject, body string)
 
 	return nil
 }
+
+func (repo *NotificationRepository) SendMailWithAttachments(to []string, subject, body string, attachments []models.Attachment) error {
+	addr := os.Getenv("SMTP_HOST") + ":" + os.Getenv("SMTP_PORT")
+
+	var buf bytes.Buffer
+	writer := multipart.NewWriter(&buf)
+
+	fmt.Fprintf(&buf, "Subject: FAF Hub: %s\r\nMIME-version: 1.0;\r\nContent-Type: multipart/mixed; boundary=\"%s\"\r\n\r\n", subject, writer.Boundary())
+
+	part, err := writer.CreatePart(textproto.MIMEHeader{
+		"Content-Type": {"text/html; charset=\"UTF-8\""},
+	})
+	if err != nil {
+		return err
+	}
+	if _, err := part.Write([]byte(body)); err != nil {
+		return err
+	}
+
+	for _, attachment := range attachments {
+		part, err := writer.CreatePart(textproto.MIMEHeader{
+			"Content-Type":              {fmt.Sprintf("%s; name=\"%s\"", attachment.ContentType, attachment.Name)},
+			"Content-Disposition":       {fmt.Sprintf("attachment; filename=\"%s\"", attachment.Name)},
+			"Content-Transfer-Encoding": {"base64"},
+		})
+		if err != nil {
+			return err
+		}
+		if _, err := part.Write([]byte(encodeBase64Lines(attachment.Data))); err != nil {
+			return err
+		}
+	}
+
+	if err := writer.Close(); err != nil {
+		return err
+	}
+
+	err = smtp.SendMail(addr, repo.auth, os.Getenv("SMTP_MAIL"), to, buf.Bytes())
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// encodeBase64Lines wraps the encoded data at 76 characters as required by RFC 2045.
+func encodeBase64Lines(data []byte) string {
+	encoded := base64.StdEncoding.EncodeToString(data)
+	var buf bytes.Buffer
+	for len(encoded) > 76 {
+		buf.WriteString(encoded[:76] + "\r\n")
+		encoded = encoded[76:]
+	}
+	buf.WriteString(encoded)
+	return buf.String()
+}
@@ -1,14 +1,18 @@
 package service
 
 import (
+	"encoding/base64"
 	"strings"
 
+	"github.com/catness812/faf-hub-backend/notification_service/internal/models"
 	"github.com/catness812/faf-hub-backend/notification_service/internal/util"
 	"github.com/gookit/slog"
+	"github.com/skip2/go-qrcode"
 )
 
 type INotificationRepository interface {
 	SendMail(to []string, subject, body string) error
+	SendMailWithAttachments(to []string, subject, body string, attachments []models.Attachment) error
 }
 
 type NotificationService struct {
@@ -62,3 +66,183 @@ func (svc *NotificationService) SendVerificationMail(msg string) {
 
 	slog.Info("Successfully sent message")
 }
+
+func (svc *NotificationService) SendTicketMail(msg string) {
+	parts := strings.SplitN(msg, ";", 4)
+	if len(parts) < 3 {
+		slog.Errorf("Invalid message format: %s", msg)
+		return
+	}
+
+	to := []string{strings.TrimSpace(parts[0])}
+	eventName := parts[1]
+	ticket := strings.TrimSpace(parts[2])
+
+	png, err := qrcode.Encode(ticket, qrcode.Medium, 512)
+	if err != nil {
+		slog.Errorf("Failed to render ticket QR code: %v", err)
+		return
+	}
+
+	attachments := []models.Attachment{{
+		Name:        "ticket.png",
+		ContentType: "image/png",
+		Data:        png,
+	}}
+
+	// the calendar invite is optional so older messages are still delivered
+	if len(parts) == 4 {
+		invite, err := base64.StdEncoding.DecodeString(strings.TrimSpace(parts[3]))
+		if err != nil {
+			slog.Errorf("Invalid calendar attachment: %v", err)
+		} else {
+			attachments = append(attachments, models.Attachment{
+				Name:        "event.ics",
+				ContentType: "text/calendar; method=PUBLISH",
+				Data:        invite,
+			})
+		}
+	}
+
+	if err := svc.notificationRepository.SendMailWithAttachments(to, "Your ticket for "+eventName, util.FormatMailMessage(eventName, "ticket.html"), attachments); err != nil {
+		slog.Errorf("Failed to send message: %v", err)
+		return
+	}
+
+	slog.Info("Successfully sent message")
+}
+
+func (svc *NotificationService) SendCertificateMail(msg string) {
+	parts := strings.SplitN(msg, ";", 4)
+	if len(parts) != 4 {
+		slog.Errorf("Invalid message format: %s", msg)
+		return
+	}
+
+	to := []string{strings.TrimSpace(parts[0])}
+	eventName := parts[1]
+	code := strings.TrimSpace(parts[2])
+
+	pdf, err := base64.StdEncoding.DecodeString(strings.TrimSpace(parts[3]))
+	if err != nil {
+		slog.Errorf("Invalid certificate attachment: %v", err)
+		return
+	}
+
+	attachments := []models.Attachment{{
+		Name:        "certificate-" + code + ".pdf",
+		ContentType: "application/pdf",
+		Data:        pdf,
+	}}
+
+	if err := svc.notificationRepository.SendMailWithAttachments(to, "Your certificate for "+eventName, util.FormatMailMessage(eventName, "certificate.html"), attachments); err != nil {
+		slog.Errorf("Failed to send message: %v", err)
+		return
+	}
+
+	slog.Info("Successfully sent message")
+}
+
+func (svc *NotificationService) SendReminderMail(msg string) {
+	parts := strings.SplitN(msg, ";", 3)
+	if len(parts) != 3 {
+		slog.Errorf("Invalid message format: %s", msg)
+		return
+	}
+
+	recipients := strings.Trim(parts[0], "[]")
+	subject := parts[1]
+	body := parts[2]
+
+	to := strings.Split(recipients, ", ")
+	for i := range to {
+		to[i] = strings.TrimSpace(to[i])
+	}
+
+	if err := svc.notificationRepository.SendMail(to, subject, util.FormatMailMessage(body, "reminder.html")); err != nil {
+		slog.Errorf("Failed to send message: %v", err)
+		return
+	}
+
+	slog.Info("Successfully sent message")
+}
+
+func (svc *NotificationService) SendEventUpdateMail(msg string) {
+	parts := strings.SplitN(msg, ";", 3)
+	if len(parts) != 3 {
+		slog.Errorf("Invalid message format: %s", msg)
+		return
+	}
+
+	recipients := strings.Trim(parts[0], "[]")
+	subject := parts[1]
+	body := parts[2]
+
+	to := strings.Split(recipients, ", ")
+	for i := range to {
+		to[i] = strings.TrimSpace(to[i])
+	}
+
+	if err := svc.notificationRepository.SendMail(to, subject, util.FormatMailMessage(body, "event_update.html")); err != nil {
+		slog.Errorf("Failed to send message: %v", err)
+		return
+	}
+
+	slog.Info("Successfully sent message")
+}
+
+// SendEventChangedMail expects the first line of the body to introduce the
+// change and every following line to describe one changed detail.
+func (svc *NotificationService) SendEventChangedMail(msg string) {
+	parts := strings.SplitN(msg, ";", 3)
+	if len(parts) != 3 {
+		slog.Errorf("Invalid message format: %s", msg)
+		return
+	}
+
+	recipients := strings.Trim(parts[0], "[]")
+	subject := parts[1]
+	lines := strings.Split(parts[2], "\n")
+
+	to := strings.Split(recipients, ", ")
+	for i := range to {
+		to[i] = strings.TrimSpace(to[i])
+	}
+
+	body := util.FormatMailTemplate(map[string]interface{}{
+		"intro":   lines[0],
+		"changes": lines[1:],
+	}, "event_changed.html")
+
+	if err := svc.notificationRepository.SendMail(to, subject, body); err != nil {
+		slog.Errorf("Failed to send message: %v", err)
+		return
+	}
+
+	slog.Info("Successfully sent message")
+}
+
+// SendGuestConfirmationMail expects "email;event name;link", where the link
+// confirms the guest registration.
+func (svc *NotificationService) SendGuestConfirmationMail(msg string) {
+	parts := strings.SplitN(msg, ";", 3)
+	if len(parts) != 3 {
+		slog.Errorf("Invalid message format: %s", msg)
+		return
+	}
+
+	to := []string{strings.TrimSpace(parts[0])}
+	eventName := parts[1]
+
+	body := util.FormatMailTemplate(map[string]interface{}{
+		"event": eventName,
+		"link":  strings.TrimSpace(parts[2]),
+	}, "guest_confirmation.html")
+
+	if err := svc.notificationRepository.SendMail(to, "Confirm your registration for "+eventName, body); err != nil {
+		slog.Errorf("Failed to send message: %v", err)
+		return
+	}
+
+	slog.Info("Successfully sent message")
+}
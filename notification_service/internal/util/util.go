@@ -6,6 +6,12 @@ import (
 )
 
 func FormatMailMessage(data string, path string) string {
+	return FormatMailTemplate(map[string]interface{}{
+		"data": data,
+	}, path)
+}
+
+func FormatMailTemplate(ctx map[string]interface{}, path string) string {
 	reg, err := raymond.ParseFile("./templates/" + path)
 	if err != nil {
 		reg, err = raymond.ParseFile("../templates/" + path)
@@ -14,8 +20,5 @@ func FormatMailMessage(data string, path string) string {
 		}
 	}
 
-	ctx := map[string]interface{}{
-		"data": data,
-	}
 	return reg.MustExec(ctx)
 }
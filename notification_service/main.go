@@ -75,6 +75,12 @@ func grpcStart(notificationSvc rpc.INotificationService, consumer *controller.Co
 
 	go server.NotificationMail("notification")
 	go server.VerificationMail("verification")
+	go server.TicketMail("ticket")
+	go server.CertificateMail("certificate")
+	go server.ReminderMail("reminder")
+	go server.EventUpdateMail("event_update")
+	go server.EventChangedMail("event_changed")
+	go server.GuestConfirmationMail("guest_confirmation")
 
 	if err := s.Serve(lis); err != nil {
 		slog.Error(err)